@@ -1,15 +1,22 @@
 package main
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/traPtitech/traPortfolio/internal/handler"
+	"github.com/traPtitech/traPortfolio/internal/handler/graphql"
+	"github.com/traPtitech/traPortfolio/internal/handler/rpc"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/external"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/external/mock_external_e2e"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/repository"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/config"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/ogp"
+	urepository "github.com/traPtitech/traPortfolio/internal/usecases/repository"
 	"gorm.io/gorm"
 )
 
-func injectIntoAPIServer(c *config.Config, db *gorm.DB) (handler.API, error) {
+func injectIntoAPIServer(c *config.Config, db *gorm.DB, accountStatsRepo urepository.AccountStatsRepository) (handler.API, error) {
 	// external API
 	var (
 		portalAPI external.PortalAPI
@@ -49,15 +56,77 @@ func injectIntoAPIServer(c *config.Config, db *gorm.DB) (handler.API, error) {
 	contestRepo := repository.NewContestRepository(db, portalAPI)
 	groupRepo := repository.NewGroupRepository(db)
 
+	ogpRenderer, err := injectOGPRenderer(c.OGP)
+	if err != nil {
+		return handler.API{}, err
+	}
+
+	// TODO: 初期リリースではevent APIの機能を止めているため、GraphQLでもイベントを提供しない
+	graphQLHandler, err := handler.NewGraphQLHandler(graphql.NewResolver(userRepo, projectRepo, contestRepo, groupRepo, eventRepo, !c.IsProduction))
+	if err != nil {
+		return handler.API{}, err
+	}
+
 	// service, handler, API
 	api := handler.NewAPI(
 		handler.NewPingHandler(),
-		handler.NewUserHandler(userRepo, eventRepo),
+		handler.NewUserHandler(userRepo, eventRepo, accountStatsRepo),
 		handler.NewProjectHandler(projectRepo),
 		handler.NewEventHandler(eventRepo, userRepo),
 		handler.NewContestHandler(contestRepo),
 		handler.NewGroupHandler(groupRepo, userRepo),
+		handler.NewOGPHandler(userRepo, projectRepo, ogpRenderer, c.OGP.FrontendURL),
+		graphQLHandler,
+		handler.NewRPCHandler(rpc.NewServer(userRepo, projectRepo, contestRepo, groupRepo)),
 	)
 
 	return api, nil
 }
+
+// injectOGPRenderer フォントが設定されていればGoフォントに無い文字の描画に埋め込みの日本語フォントの代わりに使う
+func injectOGPRenderer(c config.OGPConfig) (*ogp.Renderer, error) {
+	var fallbackFont []byte
+	if c.FontPath != "" {
+		var err error
+		fallbackFont, err = os.ReadFile(c.FontPath)
+		if err != nil {
+			return nil, fmt.Errorf("read ogp font: %w", err)
+		}
+	}
+
+	return ogp.NewRenderer(fallbackFont)
+}
+
+// injectAccountStatsRepository 成績の定期的な取得でも使うためAPIサーバーとは別に用意する
+func injectAccountStatsRepository(c *config.Config, db *gorm.DB) (*repository.AccountStatsRepository, error) {
+	var competitiveAPI external.CompetitiveStatsAPI
+	if c.IsProduction {
+		var err error
+
+		competitiveAPI, err = external.NewCompetitiveStatsAPI(c.Competitive)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		competitiveAPI = mock_external_e2e.NewMockCompetitiveStatsAPI()
+	}
+
+	return repository.NewAccountStatsRepository(db, competitiveAPI), nil
+}
+
+// injectProjectGitHubRepository GitHubリポジトリの情報は定期的に取得するだけなのでAPIサーバーとは別に用意する
+func injectProjectGitHubRepository(c *config.Config, db *gorm.DB) (*repository.ProjectGitHubRepository, error) {
+	var githubAPI external.GitHubAPI
+	if c.IsProduction {
+		var err error
+
+		githubAPI, err = external.NewGitHubAPI(c.GitHub)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		githubAPI = mock_external_e2e.NewMockGitHubAPI()
+	}
+
+	return repository.NewProjectGitHubRepository(db, githubAPI), nil
+}
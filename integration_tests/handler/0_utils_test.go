@@ -12,12 +12,17 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/handler"
+	"github.com/traPtitech/traPortfolio/internal/handler/graphql"
+	"github.com/traPtitech/traPortfolio/internal/handler/rpc"
+	"github.com/traPtitech/traPortfolio/internal/handler/schema"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/external/mock_external_e2e"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/migration"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/repository"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/config"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/mockdata"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/ogp"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
 	"gorm.io/gorm"
 )
@@ -32,6 +37,7 @@ func injectIntoAPIServer(t *testing.T, c *config.Config, db *gorm.DB) (handler.A
 	portalAPI := mock_external_e2e.NewMockPortalAPI()
 	traQAPI := mock_external_e2e.NewMockTraQAPI()
 	knoqAPI := mock_external_e2e.NewMockKnoqAPI()
+	competitiveAPI := mock_external_e2e.NewMockCompetitiveStatsAPI()
 
 	// repository
 	userRepo := repository.NewUserRepository(db, portalAPI, traQAPI)
@@ -39,15 +45,29 @@ func injectIntoAPIServer(t *testing.T, c *config.Config, db *gorm.DB) (handler.A
 	eventRepo := repository.NewEventRepository(db, knoqAPI)
 	contestRepo := repository.NewContestRepository(db, portalAPI)
 	groupRepo := repository.NewGroupRepository(db)
+	accountStatsRepo := repository.NewAccountStatsRepository(db, competitiveAPI)
+
+	ogpRenderer, err := ogp.NewRenderer(nil)
+	if err != nil {
+		return handler.API{}, err
+	}
+
+	graphQLHandler, err := handler.NewGraphQLHandler(graphql.NewResolver(userRepo, projectRepo, contestRepo, groupRepo, eventRepo, true))
+	if err != nil {
+		return handler.API{}, err
+	}
 
 	// service, handler, API
 	api := handler.NewAPI(
 		handler.NewPingHandler(),
-		handler.NewUserHandler(userRepo, eventRepo),
+		handler.NewUserHandler(userRepo, eventRepo, accountStatsRepo),
 		handler.NewProjectHandler(projectRepo),
 		handler.NewEventHandler(eventRepo, userRepo),
 		handler.NewContestHandler(contestRepo),
 		handler.NewGroupHandler(groupRepo, userRepo),
+		handler.NewOGPHandler(userRepo, projectRepo, ogpRenderer, ""),
+		graphQLHandler,
+		handler.NewRPCHandler(rpc.NewServer(userRepo, projectRepo, contestRepo, groupRepo)),
 	)
 
 	return api, nil
@@ -132,6 +152,14 @@ func dummyUUID(t *testing.T) uuid.UUID {
 	return random.UUID()
 }
 
+// accountHandle 正規化済みのアカウントURLからハンドルを取り出す
+func accountHandle(t *testing.T, accountType schema.AccountType, url string) string {
+	t.Helper()
+	handle, _, err := domain.NormalizeAccountURL(domain.AccountType(accountType), url)
+	assert.NoError(t, err)
+	return handle
+}
+
 func httpError(t *testing.T, message string) *echo.HTTPError {
 	t.Helper()
 	return &echo.HTTPError{
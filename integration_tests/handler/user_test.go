@@ -2,7 +2,6 @@ package handler
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strings"
 	"testing"
@@ -357,6 +356,7 @@ func TestAddUserAccount(t *testing.T) {
 				DisplayName: displayName,
 				Type:        accountType,
 				Url:         accountURL,
+				Handle:      accountHandle(t, accountType, accountURL),
 			},
 		},
 		"201 with kanji": {
@@ -372,6 +372,7 @@ func TestAddUserAccount(t *testing.T) {
 				DisplayName: justCountDisplayName,
 				Type:        accountType2,
 				Url:         accountURL2,
+				Handle:      accountHandle(t, accountType2, accountURL2),
 			},
 		},
 		"400 invalid userID": {
@@ -388,7 +389,7 @@ func TestAddUserAccount(t *testing.T) {
 				Type:        accountType,
 				Url:         "invalid url",
 			},
-			httpError(t, "Bad Request: validate error: url: must be a valid URL."),
+			httpError(t, "Bad Request: validate error: url: must be a valid URL or handle of the account type."),
 		},
 		"400 invalid account type": {
 			http.StatusBadRequest,
@@ -398,7 +399,7 @@ func TestAddUserAccount(t *testing.T) {
 				Type:        schema.AccountType(domain.AccountLimit),
 				Url:         accountURL,
 			},
-			httpError(t, fmt.Sprintf("Bad Request: validate error: type: must be no greater than %d.", domain.AccountLimit-1)),
+			httpError(t, "Bad Request: validate error: type: must be a registered account type."),
 		},
 		"409 conflict already exists": {
 			http.StatusConflict,
@@ -562,6 +563,7 @@ func TestEditUserAccount(t *testing.T) {
 					Type:        schema.AccountType(initialAccountType),
 					Url:         random.AccountURLString(initialAccountType),
 				}
+				account.Handle = accountHandle(t, account.Type, account.Url)
 				res := doRequest(t, e, http.MethodPost, e.URL(api.User.AddUserAccount, tt.userID), schema.AddAccountRequest{
 					DisplayName: account.DisplayName,
 					Type:        account.Type,
@@ -588,6 +590,9 @@ func TestEditUserAccount(t *testing.T) {
 				if tt.reqBody.Url != nil {
 					account.Url = *tt.reqBody.Url
 				}
+				if tt.reqBody.Type != nil || tt.reqBody.Url != nil {
+					account.Handle = accountHandle(t, account.Type, account.Url)
+				}
 				res = doRequest(t, e, http.MethodGet, e.URL(api.User.GetUserAccount, tt.userID, tt.accountID), nil)
 				assertResponse(t, http.StatusOK, account, res)
 			}
@@ -652,6 +657,7 @@ func TestDeleteUserAccount(t *testing.T) {
 					DisplayName: reqBody.DisplayName,
 					Type:        reqBody.Type,
 					Url:         reqBody.Url,
+					Handle:      accountHandle(t, reqBody.Type, reqBody.Url),
 				}, res, optSyncID, optRetrieveID(&tt.accountID))
 			}
 			res := doRequest(t, e, http.MethodDelete, e.URL(api.User.DeleteUserAccount, tt.userID, tt.accountID), nil)
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// AccountStats 競技プログラミングなどのサービスから取得したアカウントの成績
+type AccountStats struct {
+	AccountID     uuid.UUID
+	Rating        int    // 現在のレーティング
+	MaxRating     int    // 最高レーティング
+	Color         string // レーティングに対応する色
+	RatedContests int    // レーティングの対象となったコンテストへの参加回数
+	FetchedAt     time.Time
+}
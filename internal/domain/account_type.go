@@ -0,0 +1,305 @@
+package domain
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+type AccountType uint8
+
+var (
+	_ sql.Scanner   = (*AccountType)(nil)
+	_ driver.Valuer = AccountType(0)
+)
+
+// 組み込みの外部アカウントの種類
+// 既存のアカウントのデータと互換性を保つため、IDを変更してはならない
+const (
+	HOMEPAGE AccountType = iota
+	BLOG
+	TWITTER
+	FACEBOOK
+	PIXIV
+	GITHUB
+	QIITA
+	ZENN
+	ATCODER
+	SOUNDCLOUD
+	HACKTHEBOX
+	CTFTIME
+	BLUESKY
+	MIXI2
+	AccountLimit // 組み込みの種類の数
+)
+
+func (a *AccountType) Scan(src interface{}) error {
+	s := sql.NullByte{}
+	if err := s.Scan(src); err != nil {
+		return err
+	}
+
+	if s.Valid {
+		newAT := AccountType(s.Byte)
+		if _, ok := AccountTypes().Get(newAT); !ok {
+			return fmt.Errorf("%w: AccountType(%d) is not registered", ErrTooLargeEnum, newAT)
+		}
+
+		*a = newAT
+	}
+
+	return nil
+}
+
+func (a AccountType) Value() (driver.Value, error) {
+	return sql.NullByte{Byte: byte(a), Valid: true}.Value()
+}
+
+// AccountTypeDefinition 外部アカウントの種類(サービス)の定義
+type AccountTypeDefinition struct {
+	ID            AccountType
+	Label         string         // 表示名
+	URLPattern    *regexp.Regexp // アカウントのURLが満たすべきパターン
+	HandlePattern *regexp.Regexp // URLからハンドルを取り出すパターン。最初のグループがハンドルになる。nilの場合はハンドルを持たない
+	URLTemplate   string         // ハンドルから正規のURLを生成するテンプレート。{handle}がハンドルに置き換わる
+	Icon          string         // クライアントが表示するアイコンのキー
+}
+
+// NewAccountTypeDefinition パターンを文字列で受け取り、外部アカウントの種類の定義を作成する
+// handlePatternが空文字列の場合はハンドルを持たない種類になる
+func NewAccountTypeDefinition(id AccountType, label string, urlPattern string, handlePattern string, urlTemplate string, icon string) (*AccountTypeDefinition, error) {
+	u, err := regexp.Compile(urlPattern)
+	if err != nil {
+		return nil, fmt.Errorf("compile url pattern of account type %d: %w", id, err)
+	}
+
+	d := &AccountTypeDefinition{
+		ID:         id,
+		Label:      label,
+		URLPattern: u,
+		Icon:       icon,
+	}
+
+	if handlePattern != "" {
+		h, err := regexp.Compile(handlePattern)
+		if err != nil {
+			return nil, fmt.Errorf("compile handle pattern of account type %d: %w", id, err)
+		}
+		if h.NumSubexp() < 1 {
+			return nil, fmt.Errorf("handle pattern of account type %d must have a capturing group", id)
+		}
+		if !strings.Contains(urlTemplate, handlePlaceholder) {
+			return nil, fmt.Errorf("url template of account type %d must contain %s", id, handlePlaceholder)
+		}
+		d.HandlePattern = h
+		d.URLTemplate = urlTemplate
+	}
+
+	return d, nil
+}
+
+// IsValidURL URLがこの種類のアカウントのURLとして正しいかどうか
+func (d *AccountTypeDefinition) IsValidURL(URL string) bool {
+	if _, err := url.Parse(URL); err != nil {
+		return false
+	}
+
+	return d.URLPattern.MatchString(URL)
+}
+
+// Handle URLからアカウントのハンドルを取り出す
+func (d *AccountTypeDefinition) Handle(URL string) (string, bool) {
+	if d.HandlePattern == nil {
+		return "", false
+	}
+
+	m := d.HandlePattern.FindStringSubmatch(URL)
+	if len(m) < 2 || m[1] == "" {
+		return "", false
+	}
+
+	return m[1], true
+}
+
+const handlePlaceholder = "{handle}"
+
+// Normalize ユーザーが入力したURLまたはハンドルから、正規のハンドルとURLを求める
+// ハンドルを持たない種類の場合、ハンドルは空文字列になる
+func (d *AccountTypeDefinition) Normalize(input string) (handle string, canonicalURL string, err error) {
+	input = strings.TrimSpace(input)
+
+	if d.HandlePattern == nil {
+		u, err := normalizeURL(input)
+		if err != nil {
+			return "", "", err
+		}
+
+		canonicalURL = u.String()
+		if !d.URLPattern.MatchString(canonicalURL) {
+			return "", "", fmt.Errorf("%w: %s does not match the url pattern", ErrInvalidAccountURL, input)
+		}
+
+		return "", canonicalURL, nil
+	}
+
+	if !strings.Contains(input, "/") {
+		// @から始まるものも含め、ハンドルのみが入力された場合
+		handle = strings.TrimPrefix(input, "@")
+	} else {
+		u, err := normalizeURL(input)
+		if err != nil {
+			return "", "", err
+		}
+		// ハンドルで一意に定まるため、クエリとフラグメントは取り除く
+		u.RawQuery = ""
+		u.Fragment = ""
+
+		h, ok := d.Handle(u.String())
+		if !ok {
+			return "", "", fmt.Errorf("%w: cannot extract handle from %s", ErrInvalidAccountURL, input)
+		}
+		handle = h
+	}
+
+	canonicalURL = strings.ReplaceAll(d.URLTemplate, handlePlaceholder, handle)
+	if h, ok := d.Handle(canonicalURL); !ok || h != handle || !d.URLPattern.MatchString(canonicalURL) {
+		return "", "", fmt.Errorf("%w: invalid handle %s", ErrInvalidAccountURL, handle)
+	}
+
+	return handle, canonicalURL, nil
+}
+
+// normalizeURL スキームとホストを小文字にし、パス末尾のスラッシュを取り除く
+// スキームが省略された場合はhttpsとみなす
+func normalizeURL(input string) (*url.URL, error) {
+	if !strings.Contains(input, "://") {
+		input = "https://" + input
+	}
+
+	u, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidAccountURL, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("%w: %s has no host", ErrInvalidAccountURL, input)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+
+	return u, nil
+}
+
+// AccountTypeRegistry 利用できる外部アカウントの種類の一覧
+type AccountTypeRegistry struct {
+	defs map[AccountType]*AccountTypeDefinition
+}
+
+// NewAccountTypeRegistry 組み込みの種類にdefsを加えた一覧を作成する
+// 組み込みの種類と同じIDの定義は組み込みの定義を上書きする
+func NewAccountTypeRegistry(defs ...*AccountTypeDefinition) (*AccountTypeRegistry, error) {
+	r := &AccountTypeRegistry{
+		defs: make(map[AccountType]*AccountTypeDefinition, int(AccountLimit)+len(defs)),
+	}
+	for _, d := range builtinAccountTypes {
+		r.defs[d.ID] = d
+	}
+
+	seen := make(map[AccountType]struct{}, len(defs))
+	for _, d := range defs {
+		if _, ok := seen[d.ID]; ok {
+			return nil, fmt.Errorf("account type %d is defined more than once", d.ID)
+		}
+		seen[d.ID] = struct{}{}
+		r.defs[d.ID] = d
+	}
+
+	return r, nil
+}
+
+// Get IDに対応する種類の定義を返す
+func (r *AccountTypeRegistry) Get(id AccountType) (*AccountTypeDefinition, bool) {
+	d, ok := r.defs[id]
+	return d, ok
+}
+
+// All 全ての種類の定義をID順に返す
+func (r *AccountTypeRegistry) All() []*AccountTypeDefinition {
+	res := make([]*AccountTypeDefinition, 0, len(r.defs))
+	for _, d := range r.defs {
+		res = append(res, d)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+
+	return res
+}
+
+var accountTypes atomic.Pointer[AccountTypeRegistry]
+
+func init() {
+	r, err := NewAccountTypeRegistry()
+	if err != nil {
+		panic(err)
+	}
+	accountTypes.Store(r)
+}
+
+// AccountTypes 現在利用できる外部アカウントの種類の一覧を返す
+func AccountTypes() *AccountTypeRegistry {
+	return accountTypes.Load()
+}
+
+// SetAccountTypes 利用できる外部アカウントの種類の一覧を置き換える
+// 起動時に設定から読み込んだ一覧を登録するために使う
+func SetAccountTypes(r *AccountTypeRegistry) {
+	accountTypes.Store(r)
+}
+
+func IsValidAccountURL(accountType AccountType, URL string) bool {
+	_, _, err := NormalizeAccountURL(accountType, URL)
+	return err == nil
+}
+
+// NormalizeAccountURL ユーザーが入力したアカウントのURLまたはハンドルから、正規のハンドルとURLを求める
+// twitter.comとx.com、末尾のスラッシュ、ハンドルの前の@、ホストの大文字小文字などの表記揺れを吸収する
+func NormalizeAccountURL(accountType AccountType, input string) (handle string, canonicalURL string, err error) {
+	d, ok := AccountTypes().Get(accountType)
+	if !ok {
+		return "", "", fmt.Errorf("%w: account type %d is not registered", ErrInvalidAccountURL, accountType)
+	}
+
+	return d.Normalize(input)
+}
+
+func mustNewAccountTypeDefinition(id AccountType, label string, urlPattern string, handlePattern string, urlTemplate string, icon string) *AccountTypeDefinition {
+	d, err := NewAccountTypeDefinition(id, label, urlPattern, handlePattern, urlTemplate, icon)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+var builtinAccountTypes = []*AccountTypeDefinition{
+	mustNewAccountTypeDefinition(HOMEPAGE, "ホームページ", `^https?://.+$`, "", "", "homepage"),
+	mustNewAccountTypeDefinition(BLOG, "ブログ", `^https?://.+$`, "", "", "blog"),
+	mustNewAccountTypeDefinition(TWITTER, "Twitter", `^https://(twitter|x)\.com/[a-zA-Z0-9_]+$`, `^https?://(?:www\.)?(?:twitter|x)\.com/@?([a-zA-Z0-9_]+)$`, "https://twitter.com/{handle}", "twitter"),
+	mustNewAccountTypeDefinition(FACEBOOK, "Facebook", `^https://www\.facebook\.com/[a-zA-Z0-9.]+$`, `^https?://(?:www\.)?facebook\.com/([a-zA-Z0-9.]+)$`, "https://www.facebook.com/{handle}", "facebook"),
+	mustNewAccountTypeDefinition(PIXIV, "pixiv", `^https://www\.pixiv\.net/users/[0-9]+`, `^https?://(?:www\.)?pixiv\.net/(?:[a-z]{2}/)?users/([0-9]+)$`, "https://www.pixiv.net/users/{handle}", "pixiv"),
+	mustNewAccountTypeDefinition(GITHUB, "GitHub", `^https://github\.com/[a-zA-Z0-9-]+$`, `^https?://(?:www\.)?github\.com/@?([a-zA-Z0-9-]+)$`, "https://github.com/{handle}", "github"),
+	mustNewAccountTypeDefinition(QIITA, "Qiita", `^https://qiita\.com/[a-zA-Z0-9-_]+$`, `^https?://(?:www\.)?qiita\.com/@?([a-zA-Z0-9-_]+)$`, "https://qiita.com/{handle}", "qiita"),
+	mustNewAccountTypeDefinition(ZENN, "Zenn", `^https://zenn\.dev/[a-z0-9_]+$`, `^https?://(?:www\.)?zenn\.dev/@?([a-z0-9_]+)$`, "https://zenn.dev/{handle}", "zenn"),
+	mustNewAccountTypeDefinition(ATCODER, "AtCoder", `^https://atcoder\.jp/users/[a-zA-Z0-9_]+$`, `^https?://(?:www\.)?atcoder\.jp/users/@?([a-zA-Z0-9_]+)$`, "https://atcoder.jp/users/{handle}", "atcoder"),
+	mustNewAccountTypeDefinition(SOUNDCLOUD, "SoundCloud", `^https://soundcloud\.com/[a-z0-9-_]+$`, `^https?://(?:www\.|m\.)?soundcloud\.com/@?([a-z0-9-_]+)$`, "https://soundcloud.com/{handle}", "soundcloud"),
+	mustNewAccountTypeDefinition(HACKTHEBOX, "Hack The Box", `^https://app\.hackthebox\.com/users/[a-zA-Z0-9]+$`, `^https?://app\.hackthebox\.com/users/([a-zA-Z0-9]+)$`, "https://app.hackthebox.com/users/{handle}", "hackthebox"),
+	mustNewAccountTypeDefinition(CTFTIME, "CTFtime", `^https://ctftime\.org/user/[0-9]+$`, `^https?://(?:www\.)?ctftime\.org/user/([0-9]+)$`, "https://ctftime.org/user/{handle}", "ctftime"),
+	mustNewAccountTypeDefinition(BLUESKY, "Bluesky", `^https://bsky\.app/profile/[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9](\.[a-zA-Z0-9]+)+$`, `^https?://bsky\.app/profile/@?([a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9](?:\.[a-zA-Z0-9]+)+)$`, "https://bsky.app/profile/{handle}", "bluesky"),
+	mustNewAccountTypeDefinition(MIXI2, "mixi2", `^https://mixi\.social/@[a-zA-Z][a-zA-Z0-9_]{3,15}$`, `^https?://mixi\.social/@?([a-zA-Z][a-zA-Z0-9_]{3,15})$`, "https://mixi.social/@{handle}", "mixi2"),
+}
@@ -0,0 +1,110 @@
+package domain
+
+import "testing"
+
+func Test_AccountTypeDefinition_Handle(t *testing.T) {
+	tests := map[string]struct {
+		accountType AccountType
+		url         string
+		want        string
+		wantOK      bool
+	}{
+		"github":                 {GITHUB, "https://github.com/traPtitech", "traPtitech", true},
+		"twitter with x domain":  {TWITTER, "https://x.com/traPtitech", "traPtitech", true},
+		"bluesky":                {BLUESKY, "https://bsky.app/profile/trap.jp", "trap.jp", true},
+		"homepage has no handle": {HOMEPAGE, "https://trap.jp", "", false},
+		"unmatched url":          {ATCODER, "https://github.com/traPtitech", "", false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, ok := AccountTypes().Get(test.accountType)
+			if !ok {
+				t.Fatalf("account type %d is not registered", test.accountType)
+			}
+
+			got, gotOK := d.Handle(test.url)
+			if got != test.want || gotOK != test.wantOK {
+				t.Errorf("got (%v, %v), want (%v, %v)", got, gotOK, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+func Test_NewAccountTypeRegistry(t *testing.T) {
+	const newType = AccountLimit
+
+	custom, err := NewAccountTypeDefinition(newType, "Codeforces", `^https://codeforces\.com/profile/[a-zA-Z0-9_.-]+$`, `^https://codeforces\.com/profile/([a-zA-Z0-9_.-]+)$`, "https://codeforces.com/profile/{handle}", "codeforces")
+	if err != nil {
+		t.Fatal(err)
+	}
+	override, err := NewAccountTypeDefinition(TWITTER, "X", `^https://x\.com/[a-zA-Z0-9_]+$`, "", "", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewAccountTypeRegistry(custom, override)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(r.All()); got != int(AccountLimit)+1 {
+		t.Errorf("got %d account types, want %d", got, int(AccountLimit)+1)
+	}
+	for i, d := range r.All() {
+		if d.ID != AccountType(i) {
+			t.Errorf("account types must be sorted by ID: got %d at %d", d.ID, i)
+		}
+	}
+	if d, _ := r.Get(newType); !d.IsValidURL("https://codeforces.com/profile/tourist") {
+		t.Error("custom account type must accept its url")
+	}
+	if d, _ := r.Get(TWITTER); d.IsValidURL("https://twitter.com/traPtitech") {
+		t.Error("builtin account type must be overridden")
+	}
+	if d, _ := r.Get(GITHUB); d.Label != "GitHub" {
+		t.Error("builtin account types must be kept")
+	}
+
+	if _, err := NewAccountTypeRegistry(custom, custom); err == nil {
+		t.Error("duplicated account types must be rejected")
+	}
+	if _, err := NewAccountTypeDefinition(newType, "invalid", `^https://example\.com/.+$`, `^https://example\.com/.+$`, "https://example.com/{handle}", ""); err == nil {
+		t.Error("handle pattern without capturing group must be rejected")
+	}
+}
+
+func Test_NormalizeAccountURL(t *testing.T) {
+	tests := map[string]struct {
+		accountType AccountType
+		input       string
+		wantHandle  string
+		wantURL     string
+		wantErr     bool
+	}{
+		"canonical url":         {GITHUB, "https://github.com/traPtitech", "traPtitech", "https://github.com/traPtitech", false},
+		"trailing slash":        {GITHUB, "https://github.com/traPtitech/", "traPtitech", "https://github.com/traPtitech", false},
+		"upper-case host":       {GITHUB, "HTTPS://GitHub.com/traPtitech", "traPtitech", "https://github.com/traPtitech", false},
+		"at prefix in url":      {QIITA, "https://qiita.com/@trap", "trap", "https://qiita.com/trap", false},
+		"x.com":                 {TWITTER, "https://x.com/traPtitech", "traPtitech", "https://twitter.com/traPtitech", false},
+		"handle only":           {TWITTER, "@traPtitech", "traPtitech", "https://twitter.com/traPtitech", false},
+		"without scheme":        {ATCODER, "atcoder.jp/users/trap?lang=ja", "trap", "https://atcoder.jp/users/trap", false},
+		"mixi2 keeps at in url": {MIXI2, "https://mixi.social/trap_jp", "trap_jp", "https://mixi.social/@trap_jp", false},
+		"homepage":              {HOMEPAGE, "HTTPS://Trap.JP/about/", "", "https://trap.jp/about", false},
+		"invalid handle":        {GITHUB, "@trap_titech", "", "", true},
+		"other service":         {GITHUB, "https://gitlab.com/traPtitech", "", "", true},
+		"unregistered type":     {AccountLimit, "https://example.com", "", "", true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			handle, url, err := NormalizeAccountURL(test.accountType, test.input)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if handle != test.wantHandle || url != test.wantURL {
+				t.Errorf("got (%v, %v), want (%v, %v)", handle, url, test.wantHandle, test.wantURL)
+			}
+		})
+	}
+}
@@ -7,24 +7,29 @@ import (
 )
 
 type Contest struct {
-	ID        uuid.UUID
-	Name      string
-	TimeStart time.Time
-	TimeEnd   time.Time
+	ID         uuid.UUID
+	Name       string
+	TimeStart  time.Time
+	TimeEnd    time.Time
+	Visibility Visibility
+	Publish    PublishState
 }
 
 type ContestDetail struct {
 	Contest
 	Link         string
 	Description  string
+	Body         string // Markdownで書かれた詳細な説明
 	ContestTeams []*ContestTeam
+	Version      int // コンテストやチームを編集するたびに増える版番号
 }
 
 type ContestTeamWithoutMembers struct {
-	ID        uuid.UUID
-	ContestID uuid.UUID
-	Name      string
-	Result    string
+	ID         uuid.UUID
+	ContestID  uuid.UUID
+	Name       string
+	Result     string
+	Visibility Visibility // チーム自体の公開範囲 (コンテストの公開範囲による制限は含まない)
 }
 
 type ContestTeam struct {
@@ -36,4 +41,32 @@ type ContestTeamDetail struct {
 	ContestTeam
 	Link        string
 	Description string
+	Body        string // Markdownで書かれた詳細な説明
+	Version     int    // チームやメンバーを編集するたびに増える版番号
+}
+
+// ContestTeamImportAction 一括登録の各行で行われる操作
+type ContestTeamImportAction uint8
+
+const (
+	ContestTeamImportCreate ContestTeamImportAction = iota // 同じ名前のチームが無いので新しく作成する
+	ContestTeamImportUpdate                                // 同じ名前のチームがあるので更新する
+)
+
+// ContestTeamImportResult コンテストチームの一括登録の1行分の結果
+type ContestTeamImportResult struct {
+	Action ContestTeamImportAction
+	TeamID uuid.UUID // 作成するチームはdry runの場合uuid.Nil
+	Errors []string  // 1行でもエラーがあれば一括登録全体が適用されない
+}
+
+// HasContestTeamImportErrors いずれかの行にエラーがあるかどうか
+func HasContestTeamImportErrors(results []*ContestTeamImportResult) bool {
+	for _, r := range results {
+		if len(r.Errors) > 0 {
+			return true
+		}
+	}
+
+	return false
 }
@@ -3,5 +3,6 @@ package domain
 import "errors"
 
 var (
-	ErrTooLargeEnum = errors.New("too large enum")
+	ErrTooLargeEnum      = errors.New("too large enum")
+	ErrInvalidAccountURL = errors.New("invalid account url")
 )
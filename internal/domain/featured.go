@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+)
+
+// UserFeaturedItemsLimit プロフィールの上部に固定表示できるプロジェクトやコンテストの数の上限
+const UserFeaturedItemsLimit = 6
+
+// UserFeaturedItem プロフィールの上部に固定表示するプロジェクトやコンテストへの参加
+type UserFeaturedItem struct {
+	Type      FeaturedItemType
+	ID        uuid.UUID // プロジェクトUUIDまたはコンテストチームUUID
+	Name      string    // プロジェクト名またはコンテスト名
+	ContestID uuid.UUID // コンテストチームの場合のみ
+	TeamName  string    // コンテストチームの場合のみ
+}
+
+type FeaturedItemType uint8
+
+var (
+	_ sql.Scanner   = (*FeaturedItemType)(nil)
+	_ driver.Valuer = FeaturedItemType(0)
+)
+
+const (
+	FeaturedItemProject     FeaturedItemType = iota // プロジェクト
+	FeaturedItemContestTeam                         // コンテストへのチームでの参加
+	FeaturedItemLimit
+)
+
+func (t *FeaturedItemType) Scan(src interface{}) error {
+	s := sql.NullByte{}
+	if err := s.Scan(src); err != nil {
+		return err
+	}
+
+	if s.Valid {
+		newT := FeaturedItemType(s.Byte)
+		if newT >= FeaturedItemLimit {
+			return fmt.Errorf("%w: FeaturedItemType(%d) must be less than %d", ErrTooLargeEnum, newT, FeaturedItemLimit)
+		}
+
+		*t = newT
+	}
+
+	return nil
+}
+
+func (t FeaturedItemType) Value() (driver.Value, error) {
+	return sql.NullByte{Byte: byte(t), Valid: true}.Value()
+}
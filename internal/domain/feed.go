@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// FeedProject フィードに載せる新しく追加されたプロジェクト
+type FeedProject struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// FeedContest フィードに載せる新しく追加されたコンテスト
+type FeedContest struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	TimeStart   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// FeedContestResult フィードに載せるコンテストチームの結果
+// UpdatedAtはチームの結果が最後に変わった日時で、名前やメンバーなどの編集では変わらない
+type FeedContestResult struct {
+	ContestID   uuid.UUID
+	ContestName string
+	TeamID      uuid.UUID
+	TeamName    string
+	Result      string
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// GitHubRepositoryLanguagesLimit 主な言語として扱う言語の数の上限
+const GitHubRepositoryLanguagesLimit = 5
+
+// GitHubRepository プロジェクトのリンク先のGitHubリポジトリの情報
+type GitHubRepository struct {
+	Owner       string
+	Name        string
+	Description string
+	Languages   []string // 主な言語をコード量の多い順に並べたもの
+	Stars       int
+	License     string // SPDXの識別子。ライセンスが不明な場合は空文字列
+	PushedAt    time.Time
+	FetchedAt   time.Time
+}
+
+var gitHubRepositoryURLPattern = regexp.MustCompile(`^https?://(?:www\.)?github\.com/([A-Za-z0-9-]+)/([A-Za-z0-9._-]+?)(?:\.git)?(?:[/?#].*)?$`)
+
+// ParseGitHubRepositoryURL リンクがGitHubのリポジトリを指している場合にオーナーとリポジトリ名を返す
+// リポジトリ内のファイルなどを指している場合もそのリポジトリとして扱う
+func ParseGitHubRepositoryURL(link string) (owner string, name string, ok bool) {
+	m := gitHubRepositoryURLPattern.FindStringSubmatch(link)
+	if m == nil || m[2] == "." || m[2] == ".." {
+		return "", "", false
+	}
+
+	return m[1], m[2], true
+}
+
+// IsSame オーナーとリポジトリ名が一致するか。GitHubでは大文字と小文字を区別しない
+func (r *GitHubRepository) IsSame(owner string, name string) bool {
+	return strings.EqualFold(r.Owner, owner) && strings.EqualFold(r.Name, name)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "testing"
+
+func Test_ParseGitHubRepositoryURL(t *testing.T) {
+	tests := map[string]struct {
+		link      string
+		wantOwner string
+		wantName  string
+		wantOK    bool
+	}{
+		"repository":        {"https://github.com/traPtitech/traPortfolio", "traPtitech", "traPortfolio", true},
+		"trailing slash":    {"https://github.com/traPtitech/traPortfolio/", "traPtitech", "traPortfolio", true},
+		"git suffix":        {"https://github.com/traPtitech/traPortfolio.git", "traPtitech", "traPortfolio", true},
+		"file in repo":      {"https://github.com/traPtitech/traPortfolio/tree/main/docs", "traPtitech", "traPortfolio", true},
+		"dotted name":       {"https://github.com/traPtitech/traP.jp", "traPtitech", "traP.jp", true},
+		"with www and http": {"http://www.github.com/traPtitech/traQ", "traPtitech", "traQ", true},
+		"user page":         {"https://github.com/traPtitech", "", "", false},
+		"other host":        {"https://gitlab.com/traPtitech/traPortfolio", "", "", false},
+		"empty":             {"", "", "", false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			owner, repo, ok := ParseGitHubRepositoryURL(tt.link)
+			if owner != tt.wantOwner || repo != tt.wantName || ok != tt.wantOK {
+				t.Errorf("got (%q, %q, %v), want (%q, %q, %v)", owner, repo, ok, tt.wantOwner, tt.wantName, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package domain
+
+// Lang 名前や説明などのコンテンツの言語
+type Lang uint8
+
+const (
+	LangJa Lang = iota // 日本語 (デフォルト)
+	LangEn             // 英語
+)
+
+// Pick 言語に応じてjaかenのどちらかを返す
+// 英語の値が設定されていない場合は日本語の値にフォールバックする
+func (l Lang) Pick(ja string, en string) string {
+	if l == LangEn && en != "" {
+		return en
+	}
+
+	return ja
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "testing"
+
+func Test_Lang_Pick(t *testing.T) {
+	tests := map[string]struct {
+		lang Lang
+		ja   string
+		en   string
+		want string
+	}{
+		"ja":               {LangJa, "日本語", "English", "日本語"},
+		"en":               {LangEn, "日本語", "English", "English"},
+		"en with fallback": {LangEn, "日本語", "", "日本語"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.lang.Pick(test.ja, test.en); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -5,14 +5,20 @@ import (
 )
 
 type Project struct {
-	ID       uuid.UUID
-	Name     string
-	Duration YearWithSemesterDuration
+	ID         uuid.UUID
+	Name       string
+	Duration   YearWithSemesterDuration
+	Visibility Visibility
+	Publish    PublishState
 }
 
 type ProjectDetail struct {
 	Project
 	Description string
+	Body        string // Markdownで書かれた詳細な説明
 	Link        string
 	Members     []*UserWithDuration
+	Version     int // プロジェクトやメンバーを編集するたびに増える版番号
+
+	GitHubRepository *GitHubRepository // リンク先がGitHubのリポジトリで、その情報を取得済みの場合のみ
 }
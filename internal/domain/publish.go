@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+)
+
+// PublishState プロジェクトやコンテストの下書き・公開の状態
+// 既存のデータを全て公開済みとして扱うため、ゼロ値を公開済みとしている
+type PublishState struct {
+	Draft     bool                   // 下書きとして作成された、または公開が予約されているかどうか
+	PublishAt optional.Of[time.Time] // 公開(予定)日時
+}
+
+// IsPublished nowの時点で公開されているかどうか
+// 公開予定日時が設定された下書きは、その日時を過ぎると公開されたものとして扱う
+func (s PublishState) IsPublished(now time.Time) bool {
+	if !s.Draft {
+		return true
+	}
+
+	t, ok := s.PublishAt.V()
+	return ok && !t.After(now)
+}
+
+// Restrict nowの時点で公開されていなければ、公開範囲vを外部に非公開に制限する
+// 下書きはメンバーのみが閲覧できる
+func (s PublishState) Restrict(v Visibility, now time.Time) Visibility {
+	if s.IsPublished(now) {
+		return v
+	}
+
+	return VisibilityPrivate
+}
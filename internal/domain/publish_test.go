@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+)
+
+func Test_PublishState_IsPublished(t *testing.T) {
+	now := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		state PublishState
+		want  bool
+	}{
+		"published":           {PublishState{}, true},
+		"draft":               {PublishState{Draft: true}, false},
+		"scheduled in future": {PublishState{Draft: true, PublishAt: optional.From(now.Add(time.Hour))}, false},
+		"scheduled just now":  {PublishState{Draft: true, PublishAt: optional.From(now)}, true},
+		"scheduled in past":   {PublishState{Draft: true, PublishAt: optional.From(now.Add(-time.Hour))}, true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.state.IsPublished(now); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_PublishState_Restrict(t *testing.T) {
+	now := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		state PublishState
+		v     Visibility
+		want  Visibility
+	}{
+		"published public":    {PublishState{}, VisibilityPublic, VisibilityPublic},
+		"published anonymous": {PublishState{}, VisibilityAnonymous, VisibilityAnonymous},
+		"draft public":        {PublishState{Draft: true}, VisibilityPublic, VisibilityPrivate},
+		"draft anonymous":     {PublishState{Draft: true}, VisibilityAnonymous, VisibilityPrivate},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.state.Restrict(test.v, now); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Revision プロジェクトやコンテストの編集履歴の版
+type Revision struct {
+	Number    int // 1から始まる版番号
+	CreatedAt time.Time
+}
+
+// RevisionFieldDiff 2つの版の間で値が異なるフィールド
+type RevisionFieldDiff struct {
+	Field string
+	From  string
+	To    string
+}
+
+// DiffRevisionFields 2つの版のフィールドを比較し、値が異なるものをフィールド名順に返す
+func DiffRevisionFields(from map[string]string, to map[string]string) []*RevisionFieldDiff {
+	fields := make([]string, 0, len(from))
+	for f := range from {
+		fields = append(fields, f)
+	}
+	for f := range to {
+		if _, ok := from[f]; !ok {
+			fields = append(fields, f)
+		}
+	}
+	sort.Strings(fields)
+
+	diffs := make([]*RevisionFieldDiff, 0)
+	for _, f := range fields {
+		if from[f] != to[f] {
+			diffs = append(diffs, &RevisionFieldDiff{
+				Field: f,
+				From:  from[f],
+				To:    to[f],
+			})
+		}
+	}
+
+	return diffs
+}
+
+type RevisionTarget uint8
+
+var (
+	_ sql.Scanner   = (*RevisionTarget)(nil)
+	_ driver.Valuer = RevisionTarget(0)
+)
+
+const (
+	RevisionTargetProject     RevisionTarget = iota // プロジェクト
+	RevisionTargetContest                           // コンテスト
+	RevisionTargetContestTeam                       // コンテストチーム
+	RevisionTargetLimit
+)
+
+func (t *RevisionTarget) Scan(src interface{}) error {
+	s := sql.NullByte{}
+	if err := s.Scan(src); err != nil {
+		return err
+	}
+
+	if s.Valid {
+		newT := RevisionTarget(s.Byte)
+		if newT >= RevisionTargetLimit {
+			return fmt.Errorf("%w: RevisionTarget(%d) must be less than %d", ErrTooLargeEnum, newT, RevisionTargetLimit)
+		}
+
+		*t = newT
+	}
+
+	return nil
+}
+
+func (t RevisionTarget) Value() (driver.Value, error) {
+	return sql.NullByte{Byte: byte(t), Valid: true}.Value()
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_DiffRevisionFields(t *testing.T) {
+	tests := map[string]struct {
+		from map[string]string
+		to   map[string]string
+		want []*RevisionFieldDiff
+	}{
+		"no changes": {
+			map[string]string{"name": "a", "link": "b"},
+			map[string]string{"name": "a", "link": "b"},
+			[]*RevisionFieldDiff{},
+		},
+		"changed fields are sorted": {
+			map[string]string{"name": "a", "link": "b", "description": "c"},
+			map[string]string{"name": "x", "link": "b", "description": "y"},
+			[]*RevisionFieldDiff{
+				{Field: "description", From: "c", To: "y"},
+				{Field: "name", From: "a", To: "x"},
+			},
+		},
+		"added and removed fields": {
+			map[string]string{"name": "a"},
+			map[string]string{"link": "b"},
+			[]*RevisionFieldDiff{
+				{Field: "link", From: "", To: "b"},
+				{Field: "name", From: "a", To: ""},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := DiffRevisionFields(test.from, test.to); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,7 @@
 package domain
 
 import (
-	"database/sql"
-	"database/sql/driver"
 	"fmt"
-	"net/url"
-	"regexp"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -35,6 +31,24 @@ func (u User) RealName() string {
 	return u.realName
 }
 
+// DisplayName 本名の公開を許可していない場合はユーザー名を使う
+func (u User) DisplayName() string {
+	if name := u.RealName(); name != "" {
+		return name
+	}
+
+	return u.Name
+}
+
+// DisplayNameWithHandle "本名 (@ユーザー名)"、本名の公開を許可していない場合は"@ユーザー名"
+func (u User) DisplayNameWithHandle() string {
+	if name := u.RealName(); name != "" {
+		return fmt.Sprintf("%s (@%s)", name, u.Name)
+	}
+
+	return "@" + u.Name
+}
+
 type UserWithDuration struct {
 	User     User
 	Duration YearWithSemesterDuration
@@ -44,7 +58,8 @@ type Account struct {
 	ID          uuid.UUID
 	DisplayName string
 	Type        AccountType
-	URL         string
+	Handle      string // ハンドルを持たない種類の場合は空文字列
+	URL         string // ハンドルから生成した正規のURL
 }
 
 type UserDetail struct {
@@ -52,6 +67,8 @@ type UserDetail struct {
 	State    TraQState
 	Bio      string
 	Accounts []*Account
+	Featured []*UserFeaturedItem // プロフィールの上部に固定表示する順に並ぶ
+	Version  int                 // 自己紹介、アカウント、固定表示する項目を編集するたびに増える版番号
 }
 
 type UserProject struct {
@@ -75,82 +92,6 @@ type UserGroup struct {
 	Duration YearWithSemesterDuration
 }
 
-type AccountType uint8
-
-var (
-	_ sql.Scanner   = (*AccountType)(nil)
-	_ driver.Valuer = AccountType(0)
-)
-
-const (
-	HOMEPAGE AccountType = iota
-	BLOG
-	TWITTER
-	FACEBOOK
-	PIXIV
-	GITHUB
-	QIITA
-	ZENN
-	ATCODER
-	SOUNDCLOUD
-	HACKTHEBOX
-	CTFTIME
-	BLUESKY
-	MIXI2
-	AccountLimit
-)
-
-func (a *AccountType) Scan(src interface{}) error {
-	s := sql.NullByte{}
-	if err := s.Scan(src); err != nil {
-		return err
-	}
-
-	if s.Valid {
-		newAT := AccountType(s.Byte)
-		if newAT >= AccountLimit {
-			return fmt.Errorf("%w: AccountType(%d) must be less than %d", ErrTooLargeEnum, newAT, AccountLimit)
-		}
-
-		*a = newAT
-	}
-
-	return nil
-}
-
-func (a AccountType) Value() (driver.Value, error) {
-	return sql.NullByte{Byte: byte(a), Valid: true}.Value()
-}
-
-func IsValidAccountURL(accountType AccountType, URL string) bool {
-	if _, err := url.Parse(URL); err != nil {
-		return false
-	}
-
-	var urlRegexp = map[AccountType]*regexp.Regexp{
-		HOMEPAGE:   regexp.MustCompile(`^https?://.+$`),
-		BLOG:       regexp.MustCompile(`^https?://.+$`),
-		TWITTER:    regexp.MustCompile(`^https://(twitter|x)\.com/[a-zA-Z0-9_]+$`),
-		FACEBOOK:   regexp.MustCompile(`^https://www\.facebook\.com/[a-zA-Z0-9.]+$`),
-		PIXIV:      regexp.MustCompile(`^https://www\.pixiv\.net/users/[0-9]+`),
-		GITHUB:     regexp.MustCompile(`^https://github\.com/[a-zA-Z0-9-]+$`),
-		QIITA:      regexp.MustCompile(`^https://qiita\.com/[a-zA-Z0-9-_]+$`),
-		ZENN:       regexp.MustCompile(`^https://zenn\.dev/[a-z0-9_]+$`),
-		ATCODER:    regexp.MustCompile(`^https://atcoder\.jp/users/[a-zA-Z0-9_]+$`),
-		SOUNDCLOUD: regexp.MustCompile(`^https://soundcloud\.com/[a-z0-9-_]+$`),
-		HACKTHEBOX: regexp.MustCompile(`^https://app\.hackthebox\.com/users/[a-zA-Z0-9]+$`),
-		CTFTIME:    regexp.MustCompile(`^https://ctftime\.org/user/[0-9]+$`),
-		BLUESKY:    regexp.MustCompile(`^https://bsky\.app/profile/[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9](\.[a-zA-Z0-9]+)+$`),
-		MIXI2:      regexp.MustCompile(`^https://mixi\.social/@[a-zA-Z][a-zA-Z0-9_]{3,15}$`),
-	}
-
-	if r, ok := urlRegexp[accountType]; ok {
-		return r.MatchString(URL)
-	}
-
-	return false
-}
-
 type TraQState uint8
 
 const (
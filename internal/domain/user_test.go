@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+)
+
+func Test_User_DisplayName(t *testing.T) {
+	tests := map[string]struct {
+		user           *User
+		want           string
+		wantWithHandle string
+	}{
+		"real name":        {NewUser(uuid.Nil, "user1", "User One", true), "User One", "User One (@user1)"},
+		"real name hidden": {NewUser(uuid.Nil, "user1", "User One", false), "user1", "@user1"},
+		"empty real name":  {NewUser(uuid.Nil, "user1", "", true), "user1", "@user1"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.user.DisplayName(); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+			if got := test.user.DisplayNameWithHandle(); got != test.wantWithHandle {
+				t.Errorf("got %v, want %v", got, test.wantWithHandle)
+			}
+		})
+	}
+}
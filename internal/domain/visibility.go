@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+// Visibility プロジェクトやコンテストなどの公開範囲
+// EventLevelと異なり、既存のデータを全て公開として扱うためゼロ値をPublicとしている
+type Visibility uint8
+
+var (
+	_ sql.Scanner   = (*Visibility)(nil)
+	_ driver.Valuer = Visibility(0)
+)
+
+const (
+	VisibilityPublic    Visibility = iota // 全て公開
+	VisibilityAnonymous                   // メンバーを伏せて公開
+	VisibilityPrivate                     // 外部に非公開
+	VisibilityLimit
+)
+
+// IsVisible 閲覧者がその対象自体を閲覧できるかどうか
+func (v Visibility) IsVisible(isMember bool) bool {
+	return isMember || v != VisibilityPrivate
+}
+
+// ShowsMembers 閲覧者がその対象のメンバーを閲覧できるかどうか
+func (v Visibility) ShowsMembers(isMember bool) bool {
+	return isMember || v == VisibilityPublic
+}
+
+// Restrict vとoのうちより制限の強い公開範囲を返す
+// コンテストチームの公開範囲をコンテストの公開範囲で制限する際などに用いる
+func (v Visibility) Restrict(o Visibility) Visibility {
+	switch {
+	case v == VisibilityPrivate || o == VisibilityPrivate:
+		return VisibilityPrivate
+	case v == VisibilityAnonymous || o == VisibilityAnonymous:
+		return VisibilityAnonymous
+	default:
+		return VisibilityPublic
+	}
+}
+
+func (v *Visibility) Scan(src interface{}) error {
+	s := sql.NullByte{}
+	if err := s.Scan(src); err != nil {
+		return err
+	}
+
+	if s.Valid {
+		newV := Visibility(s.Byte)
+		if newV >= VisibilityLimit {
+			return fmt.Errorf("%w: Visibility(%d) must be less than %d", ErrTooLargeEnum, newV, VisibilityLimit)
+		}
+
+		*v = newV
+	}
+
+	return nil
+}
+
+func (v Visibility) Value() (driver.Value, error) {
+	return sql.NullByte{Byte: byte(v), Valid: true}.Value()
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_Visibility_IsVisible(t *testing.T) {
+	tests := map[string]struct {
+		v        Visibility
+		isMember bool
+		want     bool
+	}{
+		"public to outsider":    {VisibilityPublic, false, true},
+		"anonymous to outsider": {VisibilityAnonymous, false, true},
+		"private to outsider":   {VisibilityPrivate, false, false},
+		"private to member":     {VisibilityPrivate, true, true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.v.IsVisible(test.isMember); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Visibility_ShowsMembers(t *testing.T) {
+	tests := map[string]struct {
+		v        Visibility
+		isMember bool
+		want     bool
+	}{
+		"public to outsider":    {VisibilityPublic, false, true},
+		"anonymous to outsider": {VisibilityAnonymous, false, false},
+		"private to outsider":   {VisibilityPrivate, false, false},
+		"anonymous to member":   {VisibilityAnonymous, true, true},
+		"private to member":     {VisibilityPrivate, true, true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.v.ShowsMembers(test.isMember); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Visibility_Restrict(t *testing.T) {
+	tests := map[string]struct {
+		v    Visibility
+		o    Visibility
+		want Visibility
+	}{
+		"public and public":     {VisibilityPublic, VisibilityPublic, VisibilityPublic},
+		"public and anonymous":  {VisibilityPublic, VisibilityAnonymous, VisibilityAnonymous},
+		"anonymous and public":  {VisibilityAnonymous, VisibilityPublic, VisibilityAnonymous},
+		"anonymous and private": {VisibilityAnonymous, VisibilityPrivate, VisibilityPrivate},
+		"private and public":    {VisibilityPrivate, VisibilityPublic, VisibilityPrivate},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.v.Restrict(test.o); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_Visibility_Scan(t *testing.T) {
+	tests := map[string]struct {
+		src     interface{}
+		want    Visibility
+		wantErr error
+	}{
+		"public":    {int64(0), VisibilityPublic, nil},
+		"private":   {int64(2), VisibilityPrivate, nil},
+		"too large": {int64(3), VisibilityPublic, ErrTooLargeEnum},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var v Visibility
+			err := v.Scan(test.src)
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("got error %v, want %v", err, test.wantErr)
+			}
+			if v != test.want {
+				t.Errorf("got %v, want %v", v, test.want)
+			}
+		})
+	}
+}
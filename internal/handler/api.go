@@ -2,12 +2,16 @@ package handler
 
 import (
 	"cmp"
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gofrs/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+	"golang.org/x/text/language"
 )
 
 type API struct {
@@ -17,9 +21,12 @@ type API struct {
 	Event   *EventHandler
 	Contest *ContestHandler
 	Group   *GroupHandler
+	OGP     *OGPHandler
+	GraphQL *GraphQLHandler
+	RPC     *RPCHandler
 }
 
-func NewAPI(ping *PingHandler, user *UserHandler, project *ProjectHandler, event *EventHandler, contest *ContestHandler, group *GroupHandler) API {
+func NewAPI(ping *PingHandler, user *UserHandler, project *ProjectHandler, event *EventHandler, contest *ContestHandler, group *GroupHandler, ogp *OGPHandler, graphql *GraphQLHandler, rpc *RPCHandler) API {
 	return API{
 		Ping:    ping,
 		User:    user,
@@ -27,6 +34,9 @@ func NewAPI(ping *PingHandler, user *UserHandler, project *ProjectHandler, event
 		Event:   event,
 		Contest: contest,
 		Group:   group,
+		OGP:     ogp,
+		GraphQL: graphql,
+		RPC:     rpc,
 	}
 }
 
@@ -41,7 +51,7 @@ func setupV1API(g *echo.Group, api API, isProduction bool) {
 		}
 	}
 
-	v1 := g.Group("/v1")
+	v1 := g.Group("/v1", memberMiddleware, langMiddleware)
 
 	// ping API
 	apiPing := v1.Group("/ping")
@@ -53,18 +63,28 @@ func setupV1API(g *echo.Group, api API, isProduction bool) {
 	userAPI := v1.Group("/users")
 	{
 		userAPI.GET("", api.User.GetUsers)
+		userAPI.POST("/batch", api.User.BatchGetUsers)
 		userAPI.POST("/sync", api.User.SyncUsers)
 		userAPI.GET("/:userID", api.User.GetUser)
 		userAPI.PATCH("/:userID", api.User.UpdateUser)
+		userAPI.PUT("/:userID/featured", api.User.EditUserFeaturedItems)
 		userAPI.GET("/:userID/accounts", api.User.GetUserAccounts)
 		userAPI.POST("/:userID/accounts", api.User.AddUserAccount)
 		userAPI.GET("/:userID/accounts/:accountID", api.User.GetUserAccount)
 		userAPI.PATCH("/:userID/accounts/:accountID", api.User.EditUserAccount)
 		userAPI.DELETE("/:userID/accounts/:accountID", api.User.DeleteUserAccount)
+		userAPI.GET("/:userID/accounts/:accountID/stats", api.User.GetUserAccountStats)
 		userAPI.GET("/:userID/projects", api.User.GetUserProjects)
 		userAPI.GET("/:userID/contests", api.User.GetUserContests)
 		userAPI.GET("/:userID/groups", api.User.GetUserGroups)
+		userAPI.GET("/:userID/resume.json", api.User.GetUserResume)
+		userAPI.GET("/:userID/cv.pdf", api.User.GetUserCv)
+		userAPI.GET("/:userID/export.md", api.User.GetUserMarkdown)
+		userAPI.GET("/:userID/ogp.png", api.OGP.GetUserOgpImage)
+		userAPI.GET("/:userID/badge.svg", api.User.GetUserBadge)
+		userAPI.GET("/:userID/badges/contests.svg", api.User.GetUserContestsBadge)
 		userAPI.GET("/:userID/events", api.User.GetUserEvents, tmpEventMiddleware)
+		userAPI.GET("/:userID/events.ics", api.User.GetUserEventsCalendar, tmpEventMiddleware)
 
 		userMeAPI := userAPI.Group("/me")
 		{
@@ -72,14 +92,27 @@ func setupV1API(g *echo.Group, api API, isProduction bool) {
 		}
 	}
 
+	// account type API
+	accountTypeAPI := v1.Group("/account-types")
+	{
+		accountTypeAPI.GET("", api.User.GetAccountTypes)
+	}
+
 	// project API
 	projectAPI := v1.Group("/projects")
 	{
 		projectAPI.GET("", api.Project.GetProjects)
 		projectAPI.POST("", api.Project.CreateProject)
+		projectAPI.POST("/batch", api.Project.BatchGetProjects)
 		projectAPI.GET("/:projectID", api.Project.GetProject)
+		projectAPI.GET("/:projectID/export.md", api.Project.GetProjectMarkdown)
+		projectAPI.GET("/:projectID/ogp.png", api.OGP.GetProjectOgpImage)
 		projectAPI.PATCH("/:projectID", api.Project.EditProject)
 		projectAPI.DELETE("/:projectID", api.Project.DeleteProject)
+		projectAPI.POST("/:projectID/publish", api.Project.PublishProject)
+		projectAPI.GET("/:projectID/revisions", api.Project.GetProjectRevisions)
+		projectAPI.GET("/:projectID/revisions/diff", api.Project.GetProjectRevisionDiff)
+		projectAPI.POST("/:projectID/revisions/:revision/restore", api.Project.RestoreProjectRevision)
 		projectAPI.GET("/:projectID/members", api.Project.GetProjectMembers)
 		projectAPI.PUT("/:projectID/members", api.Project.EditProjectMembers)
 	}
@@ -92,14 +125,35 @@ func setupV1API(g *echo.Group, api API, isProduction bool) {
 		eventAPI.PATCH("/:eventID", api.Event.EditEvent)
 	}
 
+	// feed API
+	feedAPI := v1.Group("/feeds")
+	{
+		feedAPI.GET("/projects.atom", api.Project.GetProjectsFeed)
+		feedAPI.GET("/contests.atom", api.Contest.GetContestsFeed)
+	}
+
+	// calendar API
+	v1.GET("/contests.ics", api.Contest.GetContestsCalendar)
+
+	// oEmbed API
+	v1.GET("/oembed", api.OGP.GetOEmbed)
+
 	// contest API
 	contestAPI := v1.Group("/contests")
 	{
 		contestAPI.GET("", api.Contest.GetContests)
 		contestAPI.POST("", api.Contest.CreateContest)
+		contestAPI.POST("/batch", api.Contest.BatchGetContests)
+		contestAPI.GET("/export.csv", api.Contest.GetContestsCsv)
 		contestAPI.GET("/:contestID", api.Contest.GetContest)
+		contestAPI.GET("/:contestID/export.csv", api.Contest.GetContestCsv)
 		contestAPI.PATCH("/:contestID", api.Contest.EditContest)
 		contestAPI.DELETE("/:contestID", api.Contest.DeleteContest)
+		contestAPI.POST("/:contestID/publish", api.Contest.PublishContest)
+		contestAPI.GET("/:contestID/revisions", api.Contest.GetContestRevisions)
+		contestAPI.GET("/:contestID/revisions/diff", api.Contest.GetContestRevisionDiff)
+		contestAPI.POST("/:contestID/revisions/:revision/restore", api.Contest.RestoreContestRevision)
+		contestAPI.POST("/:contestID/import", api.Contest.ImportContestTeams)
 		contestAPI.GET("/:contestID/teams", api.Contest.GetContestTeams)
 		contestAPI.POST("/:contestID/teams", api.Contest.AddContestTeam)
 		contestAPI.GET("/:contestID/teams/:teamID", api.Contest.GetContestTeam)
@@ -107,6 +161,9 @@ func setupV1API(g *echo.Group, api API, isProduction bool) {
 		contestAPI.DELETE("/:contestID/teams/:teamID", api.Contest.DeleteContestTeam)
 		contestAPI.GET("/:contestID/teams/:teamID/members", api.Contest.GetContestTeamMembers)
 		contestAPI.PUT("/:contestID/teams/:teamID/members", api.Contest.EditContestTeamMembers)
+		contestAPI.GET("/:contestID/teams/:teamID/revisions", api.Contest.GetContestTeamRevisions)
+		contestAPI.GET("/:contestID/teams/:teamID/revisions/diff", api.Contest.GetContestTeamRevisionDiff)
+		contestAPI.POST("/:contestID/teams/:teamID/revisions/:revision/restore", api.Contest.RestoreContestTeamRevision)
 	}
 
 	// group API
@@ -117,12 +174,26 @@ func setupV1API(g *echo.Group, api API, isProduction bool) {
 	}
 }
 
+func setupGraphQLAPI(g *echo.Group, api API) {
+	g.POST("/graphql", api.GraphQL.Query, memberMiddleware, langMiddleware)
+}
+
+// setupRPCAPI gRPCのクライアントがパスの接頭辞を変えられないため、/apiの外に登録する
+func setupRPCAPI(e *echo.Echo, api API) {
+	if api.RPC == nil {
+		return
+	}
+
+	for path, h := range api.RPC.handlers(e.Logger) {
+		e.Any(path+"*", echo.WrapHandler(h), memberMiddleware, langMiddleware)
+	}
+}
+
 const keyUserName = "userName"
 
 func authMeMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		h := c.Request().Header
-		name := cmp.Or(h.Get("X-Forwarded-User"), h.Get("X-Showcase-User"))
+		name := getForwardedUserName(c)
 		if name == "" {
 			return fmt.Errorf("%w: %s", repository.ErrUnauthorized, "missing user name")
 		}
@@ -133,6 +204,51 @@ func authMeMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	}
 }
 
+// memberMiddleware 認証済みのメンバーからのアクセスであればリクエストのcontextに記録する
+func memberMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if getForwardedUserName(c) != "" {
+			req := c.Request()
+			c.SetRequest(req.WithContext(repository.WithMember(req.Context())))
+		}
+
+		return next(c)
+	}
+}
+
+// publicContext フィード、プレビュー画像、バッジ、カレンダー、エクスポートのように誰にでも共有・埋め込みされるレスポンスは、
+// メンバーからのアクセスでも公開されている情報だけで作る
+func publicContext(c echo.Context) context.Context {
+	return repository.WithGuest(c.Request().Context())
+}
+
+var langMatcher = language.NewMatcher([]language.Tag{
+	language.Japanese, // 先頭の言語がフォールバック先になる
+	language.English,
+})
+
+// langMiddleware lang クエリパラメータかAccept-Languageヘッダーから閲覧者が希望する言語を決定し、リクエストのcontextに記録する
+func langMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		tags, _, _ := language.ParseAcceptLanguage(cmp.Or(c.QueryParam("lang"), req.Header.Get("Accept-Language")))
+		_, idx, conf := langMatcher.Match(tags...)
+
+		lang := domain.LangJa
+		if idx == 1 && conf != language.No {
+			lang = domain.LangEn
+		}
+		c.SetRequest(req.WithContext(repository.WithLang(req.Context(), lang)))
+
+		return next(c)
+	}
+}
+
+func getForwardedUserName(c echo.Context) string {
+	h := c.Request().Header
+	return cmp.Or(h.Get("X-Forwarded-User"), h.Get("X-Showcase-User"))
+}
+
 type idKey string
 
 const (
@@ -155,3 +271,17 @@ func getID(c echo.Context, key idKey) (uuid.UUID, error) {
 
 	return id, nil
 }
+
+const keyRevision = "revision"
+
+// getRevision パスパラメータから1以上の版番号を取得する
+func getRevision(c echo.Context) (int, error) {
+	revision, err := strconv.Atoi(c.Param(keyRevision))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", repository.ErrInvalidArg, err.Error())
+	} else if revision < 1 {
+		return 0, fmt.Errorf("%w: revision must be at least 1", repository.ErrInvalidArg)
+	}
+
+	return revision, nil
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler/graphql"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+func Test_langMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		query          string
+		acceptLanguage string
+		want           domain.Lang
+	}{
+		{"default", "", "", domain.LangJa},
+		{"query en", "?lang=en", "", domain.LangEn},
+		{"query ja overrides header", "?lang=ja", "en-US", domain.LangJa},
+		{"header en-US", "", "en-US,en;q=0.9", domain.LangEn},
+		{"header prefers ja", "", "ja,en;q=0.8", domain.LangJa},
+		{"unsupported language falls back to ja", "", "fr-FR", domain.LangJa},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/"+tt.query, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			var got domain.Lang
+			h := langMiddleware(func(c echo.Context) error {
+				got = repository.LangFrom(c.Request().Context())
+				return nil
+			})
+
+			assert.NoError(t, h(c))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_errorStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		gqlCode string
+		rpcCode connect.Code
+	}{
+		{"invalid arg", fmt.Errorf("%w: name", repository.ErrInvalidArg), http.StatusBadRequest, "BAD_REQUEST", connect.CodeInvalidArgument},
+		{"already exists", repository.ErrAlreadyExists, http.StatusConflict, "CONFLICT", connect.CodeAlreadyExists},
+		{"precondition failed", repository.ErrPreconditionFailed, http.StatusPreconditionFailed, "PRECONDITION_FAILED", connect.CodeFailedPrecondition},
+		{"unauthorized", repository.ErrUnauthorized, http.StatusUnauthorized, "UNAUTHORIZED", connect.CodeUnauthenticated},
+		{"forbidden", repository.ErrForbidden, http.StatusForbidden, "FORBIDDEN", connect.CodePermissionDenied},
+		{"not found", repository.ErrNotFound, http.StatusNotFound, "NOT_FOUND", connect.CodeNotFound},
+		{"event disabled", graphql.ErrEventDisabled, http.StatusNotImplemented, "NOT_IMPLEMENTED", connect.CodeUnimplemented},
+		{"internal", errInternal, http.StatusInternalServerError, graphQLErrorInternal, connect.CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.status, errorStatus(tt.err))
+			assert.Equal(t, tt.gqlCode, graphQLErrorCode(tt.err))
+			assert.Equal(t, tt.rpcCode, rpcErrorCode(tt.err))
+		})
+	}
+}
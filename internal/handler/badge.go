@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/badge"
+)
+
+const (
+	mimeImageSVG        = "image/svg+xml; charset=UTF-8"
+	badgeCacheMaxAge    = 10 * time.Minute
+	badgeMaxContestRows = 5
+)
+
+func newUserProjectsBadge(lang domain.Lang, projects []*domain.UserProject) []byte {
+	message := lang.Pick(fmt.Sprintf("%d プロジェクト", len(projects)), fmt.Sprintf("%d projects", len(projects)))
+	if len(projects) == 1 {
+		message = lang.Pick(message, "1 project")
+	}
+
+	return badge.Render(badge.Badge{Label: "traP", Message: message, Color: badge.ColorBlue})
+}
+
+// newUserContestsBadge 結果が登録されているチームを新しいコンテストから並べる
+func newUserContestsBadge(lang domain.Lang, contests []*domain.UserContest) []byte {
+	contests = slices.Clone(contests)
+	sort.SliceStable(contests, func(i, j int) bool {
+		return contests[i].TimeStart.After(contests[j].TimeStart)
+	})
+
+	badges := make([]badge.Badge, 0, badgeMaxContestRows)
+	for _, c := range contests {
+		for _, t := range c.Teams {
+			if t.Result != "" && len(badges) < badgeMaxContestRows {
+				badges = append(badges, badge.Badge{Label: c.Name, Message: t.Result, Color: badge.ColorBlue})
+			}
+		}
+	}
+	if len(badges) == 0 {
+		badges = append(badges, badge.Badge{
+			Label:   lang.Pick("コンテスト", "contests"),
+			Message: lang.Pick("なし", "none"),
+			Color:   badge.ColorGray,
+		})
+	}
+
+	return badge.Render(badges...)
+}
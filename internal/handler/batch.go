@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// headerMissingIDs idsを指定した一覧の取得で、結果に含まれなかったIDを返すヘッダー
+const headerMissingIDs = "X-Missing-Ids"
+
+// uniqueIDs 重複したIDを除き、最初に現れた順に並べる
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	res := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+
+	return res
+}
+
+// sortByIDs リポジトリから取得した順不同の結果をidsの順に並べ、結果に含まれなかったIDを返す
+func sortByIDs[T any](ids []uuid.UUID, items []T, idOf func(T) uuid.UUID) ([]T, []uuid.UUID) {
+	itemMap := make(map[uuid.UUID]T, len(items))
+	for _, v := range items {
+		itemMap[idOf(v)] = v
+	}
+
+	sorted := make([]T, 0, len(ids))
+	missing := make([]uuid.UUID, 0)
+	for _, id := range ids {
+		if v, ok := itemMap[id]; ok {
+			sorted = append(sorted, v)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	return sorted, missing
+}
+
+func setMissingIDsHeader(c echo.Context, missing []uuid.UUID) {
+	if len(missing) == 0 {
+		return
+	}
+
+	ids := make([]string, len(missing))
+	for i, id := range missing {
+		ids[i] = id.String()
+	}
+	c.Response().Header().Set(headerMissingIDs, strings.Join(ids, ","))
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/ical"
+)
+
+const mimeTextCalendar = "text/calendar; charset=UTF-8"
+
+// UIDの@以降に付ける識別子。UIDは変更するとカレンダーアプリ上で予定が重複するため変えないこと
+const calendarUIDDomain = "traportfolio"
+
+func newContestsCalendar(lang domain.Lang, contests []*domain.Contest, now time.Time) *ical.Calendar {
+	events := make([]*ical.Event, 0, len(contests))
+	for _, c := range contests {
+		if c.Visibility == domain.VisibilityPrivate || !c.Publish.IsPublished(now) {
+			continue
+		}
+
+		events = append(events, &ical.Event{
+			UID:     fmt.Sprintf("contest-%s@%s", c.ID, calendarUIDDomain),
+			Summary: c.Name,
+			Start:   c.TimeStart,
+			End:     c.TimeEnd,
+		})
+	}
+
+	return &ical.Calendar{
+		Name:   lang.Pick("traP コンテスト", "traP Contests"),
+		Events: events,
+	}
+}
+
+func newUserEventsCalendar(lang domain.Lang, user *domain.UserDetail, userEvents []*domain.Event) *ical.Calendar {
+	events := make([]*ical.Event, 0, len(userEvents))
+	for _, e := range userEvents {
+		var description string
+		switch e.Level {
+		case domain.EventLevelPublic:
+			description = fmt.Sprintf("%s: @%s", lang.Pick("開催者", "Host"), user.Name)
+		case domain.EventLevelAnonymous:
+			// 匿名で公開されているイベントは開催者を伏せる
+		default:
+			continue
+		}
+
+		events = append(events, &ical.Event{
+			UID:         fmt.Sprintf("event-%s@%s", e.ID, calendarUIDDomain),
+			Summary:     e.Name,
+			Description: description,
+			Start:       e.TimeStart,
+			End:         e.TimeEnd,
+		})
+	}
+
+	return &ical.Calendar{
+		Name:   lang.Pick(fmt.Sprintf("@%s が開催したイベント", user.Name), fmt.Sprintf("Events hosted by @%s", user.Name)),
+		Events: events,
+	}
+}
@@ -2,10 +2,13 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/handler/schema"
 
 	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
@@ -34,12 +37,21 @@ func (h *ContestHandler) GetContests(c echo.Context) error {
 	args := repository.GetContestsArgs{
 		Limit: optional.FromPtr((*int)(req.Limit)),
 	}
+	if req.Ids != nil {
+		args.IDs = uniqueIDs(*req.Ids)
+	}
 
 	contests, err := h.contest.GetContests(ctx, &args)
 	if err != nil {
 		return err
 	}
 
+	if args.IDs != nil {
+		var missing []uuid.UUID
+		contests, missing = sortByIDs(args.IDs, contests, contestIDOf)
+		setMissingIDsHeader(c, missing)
+	}
+
 	res := make([]schema.Contest, len(contests))
 	for i, v := range contests {
 		res[i] = newContest(v.ID, v.Name, v.TimeStart, v.TimeEnd)
@@ -48,6 +60,109 @@ func (h *ContestHandler) GetContests(c echo.Context) error {
 	return c.JSON(http.StatusOK, res)
 }
 
+// BatchGetContests POST /contests/batch
+func (h *ContestHandler) BatchGetContests(c echo.Context) error {
+	req := schema.BatchRequest{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	ids := uniqueIDs(req.Ids)
+	contests, err := h.contest.GetContests(ctx, &repository.GetContestsArgs{IDs: ids})
+	if err != nil {
+		return err
+	}
+
+	contests, missing := sortByIDs(ids, contests, contestIDOf)
+	res := schema.ContestsBatch{
+		Contests: make([]schema.Contest, len(contests)),
+		Missing:  missing,
+	}
+	for i, v := range contests {
+		res.Contests[i] = newContest(v.ID, v.Name, v.TimeStart, v.TimeEnd)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func contestIDOf(c *domain.Contest) uuid.UUID {
+	return c.ID
+}
+
+// GetContestsCalendar GET /contests.ics
+func (h *ContestHandler) GetContestsCalendar(c echo.Context) error {
+	ctx := publicContext(c)
+	contests, err := h.contest.GetContests(ctx, &repository.GetContestsArgs{})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	cal := newContestsCalendar(repository.LangFrom(ctx), contests, now)
+
+	return c.Blob(http.StatusOK, mimeTextCalendar, cal.Encode(now))
+}
+
+// GetContestsFeed GET /feeds/contests.atom
+func (h *ContestHandler) GetContestsFeed(c echo.Context) error {
+	req := schema.GetContestsFeedParams{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	limit := optional.FromPtr((*int)(req.Limit)).ValueOr(feedDefaultLimit)
+	contests, err := h.contest.GetFeedContests(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	results, err := h.contest.GetFeedContestResults(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	b, err := newContestsFeed(repository.LangFrom(ctx), feedURL(c), contests, results, limit).Encode(time.Now())
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, mimeApplicationAtom, b)
+}
+
+// GetContestsCsv GET /contests/export.csv
+func (h *ContestHandler) GetContestsCsv(c echo.Context) error {
+	ctx := c.Request().Context()
+	contests, err := h.contest.GetContests(ctx, &repository.GetContestsArgs{})
+	if err != nil {
+		return err
+	}
+
+	contestIDs := make([]uuid.UUID, len(contests))
+	for i, contest := range contests {
+		contestIDs[i] = contest.ID
+	}
+
+	teams, err := h.contest.GetContestTeamsByContestIDs(ctx, contestIDs)
+	if err != nil {
+		return err
+	}
+
+	exports := make([]*contestWithTeams, len(contests))
+	for i, contest := range contests {
+		exports[i] = &contestWithTeams{contest, teams[contest.ID]}
+	}
+
+	b, err := newContestsCSV(repository.LangFrom(ctx), exports)
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="contests.csv"`)
+
+	return c.Blob(http.StatusOK, mimeTextCSVCharsetUTF8, b)
+}
+
 // GetContest GET /contests/:contestID
 func (h *ContestHandler) GetContest(c echo.Context) error {
 	contestID, err := getID(c, keyContestID)
@@ -78,14 +193,22 @@ func (h *ContestHandler) GetContest(c echo.Context) error {
 		teams[i] = newContestTeam(v.ID, v.Name, v.Result, members)
 	}
 
+	body, err := schema.ConvertMarkdown(contest.Body)
+	if err != nil {
+		return err
+	}
+
 	res := newContestDetail(
 		newContest(contest.ID, contest.Name, contest.TimeStart, contest.TimeEnd),
 		contest.Link,
 		contest.Description,
+		body,
 		teams,
+		contest.Visibility,
+		contest.Publish,
 	)
 
-	return c.JSON(http.StatusOK, res)
+	return jsonWithETag(c, contest.Version, res)
 }
 
 // CreateContest POST /contests
@@ -96,11 +219,17 @@ func (h *ContestHandler) CreateContest(c echo.Context) error {
 	}
 
 	createReq := repository.CreateContestArgs{
-		Name:        req.Name,
-		Description: req.Description,
-		Link:        optional.FromPtr(req.Link),
-		Since:       req.Duration.Since,
-		Until:       optional.FromPtr(req.Duration.Until),
+		Name:          req.Name,
+		NameEn:        optional.FromPtr(req.NameEn),
+		Description:   req.Description,
+		DescriptionEn: optional.FromPtr(req.DescriptionEn),
+		Body:          optional.FromPtr(req.Body),
+		Link:          optional.FromPtr(req.Link),
+		Since:         req.Duration.Since,
+		Until:         optional.FromPtr(req.Duration.Until),
+		Visibility:    optional.FromPtr((*domain.Visibility)(req.Visibility)),
+		Draft:         optional.FromPtr(req.Draft),
+		PublishAt:     optional.FromPtr(req.PublishAt),
 	}
 
 	ctx := c.Request().Context()
@@ -109,7 +238,12 @@ func (h *ContestHandler) CreateContest(c echo.Context) error {
 		return err
 	}
 
-	res := newContestDetail(newContest(contest.ID, contest.Name, contest.TimeStart, contest.TimeEnd), contest.Link, contest.Description, []schema.ContestTeam{})
+	body, err := schema.ConvertMarkdown(contest.Body)
+	if err != nil {
+		return err
+	}
+
+	res := newContestDetail(newContest(contest.ID, contest.Name, contest.TimeStart, contest.TimeEnd), contest.Link, contest.Description, body, []schema.ContestTeam{}, contest.Visibility, contest.Publish)
 
 	return c.JSON(http.StatusCreated, res)
 }
@@ -127,16 +261,20 @@ func (h *ContestHandler) EditContest(c echo.Context) error {
 	}
 
 	patchReq := repository.UpdateContestArgs{
-		Name:        optional.FromPtr(req.Name),
-		Description: optional.FromPtr(req.Description),
-		Link:        optional.FromPtr(req.Link),
+		Name:          optional.FromPtr(req.Name),
+		NameEn:        optional.FromPtr(req.NameEn),
+		Description:   optional.FromPtr(req.Description),
+		DescriptionEn: optional.FromPtr(req.DescriptionEn),
+		Body:          optional.FromPtr(req.Body),
+		Link:          optional.FromPtr(req.Link),
+		Visibility:    optional.FromPtr((*domain.Visibility)(req.Visibility)),
 	}
 	if req.Duration != nil {
 		patchReq.Since = optional.FromPtr(&req.Duration.Since)
 		patchReq.Until = optional.FromPtr(req.Duration.Until)
 	}
 
-	ctx := c.Request().Context()
+	ctx := ifMatchContext(c)
 	err = h.contest.UpdateContest(ctx, contestID, &patchReq)
 	if err != nil {
 		return err
@@ -160,6 +298,168 @@ func (h *ContestHandler) DeleteContest(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// GetContestCsv GET /contests/:contestID/export.csv
+func (h *ContestHandler) GetContestCsv(c echo.Context) error {
+	contestID, err := getID(c, keyContestID)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	contest, err := h.contest.GetContest(ctx, contestID)
+	if err != nil {
+		return err
+	}
+
+	teams, err := h.contest.GetContestTeams(ctx, contestID)
+	if err != nil {
+		return err
+	}
+
+	b, err := newContestsCSV(repository.LangFrom(ctx), []*contestWithTeams{{&contest.Contest, teams}})
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", "contest-"+contestID.String()+".csv"))
+
+	return c.Blob(http.StatusOK, mimeTextCSVCharsetUTF8, b)
+}
+
+// PublishContest POST /contests/:contestID/publish
+func (h *ContestHandler) PublishContest(c echo.Context) error {
+	contestID, err := getID(c, keyContestID)
+	if err != nil {
+		return err
+	}
+
+	req := schema.PublishRequest{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	if err := h.contest.PublishContest(ctx, contestID, optional.FromPtr(req.PublishAt)); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetContestRevisions GET /contests/:contestID/revisions
+func (h *ContestHandler) GetContestRevisions(c echo.Context) error {
+	contestID, err := getID(c, keyContestID)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	revisions, err := h.contest.GetContestRevisions(ctx, contestID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newRevisions(revisions))
+}
+
+// GetContestRevisionDiff GET /contests/:contestID/revisions/diff
+func (h *ContestHandler) GetContestRevisionDiff(c echo.Context) error {
+	contestID, err := getID(c, keyContestID)
+	if err != nil {
+		return err
+	}
+
+	req := schema.GetContestRevisionDiffParams{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	diffs, err := h.contest.GetContestRevisionDiff(ctx, contestID, req.From, req.To)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newRevisionFieldDiffs(diffs))
+}
+
+// RestoreContestRevision POST /contests/:contestID/revisions/:revision/restore
+func (h *ContestHandler) RestoreContestRevision(c echo.Context) error {
+	contestID, err := getID(c, keyContestID)
+	if err != nil {
+		return err
+	}
+
+	revision, err := getRevision(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	if err := h.contest.RestoreContestRevision(ctx, contestID, revision); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ImportContestTeams POST /contests/:contestID/import
+func (h *ContestHandler) ImportContestTeams(c echo.Context) error {
+	contestID, err := getID(c, keyContestID)
+	if err != nil {
+		return err
+	}
+
+	// POSTではクエリパラメーターがBindされないため個別に取得する
+	var dryRun bool
+	if err := echo.QueryParamsBinder(c).Bool("dryRun", &dryRun).BindError(); err != nil {
+		return fmt.Errorf("%w: %w", repository.ErrBind, err)
+	}
+
+	var rows []schema.ContestTeamImportRow
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), mimeTextCSV) {
+		rows, err = parseContestTeamsCSV(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		if err := (schema.ImportContestTeamsRequest{Rows: rows}).Validate(); err != nil {
+			return fmt.Errorf("%w: %w", repository.ErrValidate, err)
+		}
+	} else {
+		req := schema.ImportContestTeamsRequest{}
+		if err := c.Bind(&req); err != nil {
+			return err
+		}
+		rows = req.Rows
+	}
+
+	// 形式の誤りも行ごとのエラーとして返し、その場合は変更を適用しない
+	formatErrors := make([][]string, len(rows))
+	hasFormatErrors := false
+	args := make([]*repository.ImportContestTeamArgs, len(rows))
+	for i, row := range rows {
+		if err := row.Validate(); err != nil {
+			formatErrors[i] = []string{err.Error()}
+			hasFormatErrors = true
+		}
+		args[i] = newImportContestTeamArgs(row)
+	}
+
+	ctx := c.Request().Context()
+	results, err := h.contest.ImportContestTeams(ctx, contestID, args, dryRun || hasFormatErrors)
+	if err != nil {
+		return err
+	}
+	for i, r := range results {
+		r.Errors = append(formatErrors[i], r.Errors...)
+	}
+
+	if domain.HasContestTeamImportErrors(results) {
+		return c.JSON(http.StatusBadRequest, newContestTeamImportResult(rows, results, false))
+	}
+
+	return c.JSON(http.StatusOK, newContestTeamImportResult(rows, results, !dryRun))
+}
+
 // GetContestTeams GET /contests/:contestID/teams
 func (h *ContestHandler) GetContestTeams(c echo.Context) error {
 	contestID, err := getID(c, keyContestID)
@@ -167,7 +467,13 @@ func (h *ContestHandler) GetContestTeams(c echo.Context) error {
 		return err
 	}
 
+	// ETagにコンテストの版番号を使う
 	ctx := c.Request().Context()
+	contest, err := h.contest.GetContest(ctx, contestID)
+	if err != nil {
+		return err
+	}
+
 	contestTeams, err := h.contest.GetContestTeams(ctx, contestID)
 	if err != nil {
 		return err
@@ -182,7 +488,7 @@ func (h *ContestHandler) GetContestTeams(c echo.Context) error {
 		res[i] = newContestTeam(v.ID, v.Name, v.Result, members)
 	}
 
-	return c.JSON(http.StatusOK, res)
+	return jsonWithETag(c, contest.Version, res)
 }
 
 // GetContestTeams GET /contests/:contestID/teams/:teamID
@@ -217,13 +523,20 @@ func (h *ContestHandler) GetContestTeam(c echo.Context) error {
 		members[i] = newUser(v.ID, v.Name, v.RealName())
 	}
 
+	body, err := schema.ConvertMarkdown(contestTeam.Body)
+	if err != nil {
+		return err
+	}
+
 	res := newContestTeamDetail(
 		newContestTeam(contestTeam.ID, contestTeam.Name, contestTeam.Result, members),
 		contestTeam.Link,
 		contestTeam.Description,
+		body,
+		contestTeam.Visibility,
 	)
 
-	return c.JSON(http.StatusOK, res)
+	return jsonWithETag(c, contestTeam.Version, res)
 }
 
 // AddContestTeam POST /contests/:contestID/teams
@@ -239,10 +552,14 @@ func (h *ContestHandler) AddContestTeam(c echo.Context) error {
 	}
 
 	args := repository.CreateContestTeamArgs{
-		Name:        req.Name,
-		Result:      optional.FromPtr(req.Result),
-		Link:        optional.FromPtr(req.Link),
-		Description: req.Description,
+		Name:          req.Name,
+		NameEn:        optional.FromPtr(req.NameEn),
+		Result:        optional.FromPtr(req.Result),
+		Link:          optional.FromPtr(req.Link),
+		Description:   req.Description,
+		DescriptionEn: optional.FromPtr(req.DescriptionEn),
+		Body:          optional.FromPtr(req.Body),
+		Visibility:    optional.FromPtr((*domain.Visibility)(req.Visibility)),
 	}
 
 	ctx := c.Request().Context()
@@ -275,13 +592,17 @@ func (h *ContestHandler) EditContestTeam(c echo.Context) error {
 	}
 
 	args := repository.UpdateContestTeamArgs{
-		Name:        optional.FromPtr(req.Name),
-		Result:      optional.FromPtr(req.Result),
-		Link:        optional.FromPtr(req.Link),
-		Description: optional.FromPtr(req.Description),
+		Name:          optional.FromPtr(req.Name),
+		NameEn:        optional.FromPtr(req.NameEn),
+		Result:        optional.FromPtr(req.Result),
+		Link:          optional.FromPtr(req.Link),
+		Description:   optional.FromPtr(req.Description),
+		DescriptionEn: optional.FromPtr(req.DescriptionEn),
+		Body:          optional.FromPtr(req.Body),
+		Visibility:    optional.FromPtr((*domain.Visibility)(req.Visibility)),
 	}
 
-	ctx := c.Request().Context()
+	ctx := ifMatchContext(c)
 	if err = h.contest.UpdateContestTeam(ctx, teamID, &args); err != nil {
 		return err
 	}
@@ -321,7 +642,13 @@ func (h *ContestHandler) GetContestTeamMembers(c echo.Context) error {
 		return err
 	}
 
+	// ETagにチームの版番号を使う
 	ctx := c.Request().Context()
+	team, err := h.contest.GetContestTeam(ctx, contestID, teamID)
+	if err != nil {
+		return err
+	}
+
 	users, err := h.contest.GetContestTeamMembers(ctx, contestID, teamID)
 	if err != nil {
 		return err
@@ -335,7 +662,8 @@ func (h *ContestHandler) GetContestTeamMembers(c echo.Context) error {
 			RealName: v.RealName(),
 		})
 	}
-	return c.JSON(http.StatusOK, res)
+
+	return jsonWithETag(c, team.Version, res)
 }
 
 // EditContestTeamMembers PUT /contests/:contestID/teams/:teamID/members
@@ -356,13 +684,85 @@ func (h *ContestHandler) EditContestTeamMembers(c echo.Context) error {
 		return err
 	}
 
-	ctx := c.Request().Context()
+	ctx := ifMatchContext(c)
 	if err = h.contest.EditContestTeamMembers(ctx, teamID, req.Members); err != nil {
 		return err
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
+// GetContestTeamRevisions GET /contests/:contestID/teams/:teamID/revisions
+func (h *ContestHandler) GetContestTeamRevisions(c echo.Context) error {
+	contestID, err := getID(c, keyContestID)
+	if err != nil {
+		return err
+	}
+
+	teamID, err := getID(c, keyContestTeamID)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	revisions, err := h.contest.GetContestTeamRevisions(ctx, contestID, teamID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newRevisions(revisions))
+}
+
+// GetContestTeamRevisionDiff GET /contests/:contestID/teams/:teamID/revisions/diff
+func (h *ContestHandler) GetContestTeamRevisionDiff(c echo.Context) error {
+	contestID, err := getID(c, keyContestID)
+	if err != nil {
+		return err
+	}
+
+	teamID, err := getID(c, keyContestTeamID)
+	if err != nil {
+		return err
+	}
+
+	req := schema.GetContestTeamRevisionDiffParams{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	diffs, err := h.contest.GetContestTeamRevisionDiff(ctx, contestID, teamID, req.From, req.To)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newRevisionFieldDiffs(diffs))
+}
+
+// RestoreContestTeamRevision POST /contests/:contestID/teams/:teamID/revisions/:revision/restore
+func (h *ContestHandler) RestoreContestTeamRevision(c echo.Context) error {
+	contestID, err := getID(c, keyContestID)
+	if err != nil {
+		return err
+	}
+
+	teamID, err := getID(c, keyContestTeamID)
+	if err != nil {
+		return err
+	}
+
+	revision, err := getRevision(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	if err := h.contest.RestoreContestTeamRevision(ctx, contestID, teamID, revision); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
 func newContest(id uuid.UUID, name string, since time.Time, until time.Time) schema.Contest {
 	return schema.Contest{
 		Id:   id,
@@ -374,14 +774,23 @@ func newContest(id uuid.UUID, name string, since time.Time, until time.Time) sch
 	}
 }
 
-func newContestDetail(contest schema.Contest, link string, description string, teams []schema.ContestTeam) schema.ContestDetail {
+func newContestDetail(contest schema.Contest, link string, description string, body schema.Markdown, teams []schema.ContestTeam, visibility domain.Visibility, publish domain.PublishState) schema.ContestDetail {
+	var publishAt *time.Time
+	if t, ok := publish.PublishAt.V(); ok {
+		publishAt = &t
+	}
+
 	return schema.ContestDetail{
+		Body:        body,
 		Description: description,
+		Draft:       !publish.IsPublished(time.Now()),
 		Duration:    contest.Duration,
 		Id:          contest.Id,
 		Link:        link,
 		Name:        contest.Name,
+		PublishAt:   publishAt,
 		Teams:       teams,
+		Visibility:  schema.Visibility(visibility),
 	}
 }
 
@@ -402,13 +811,15 @@ func newContestTeamWithoutMembers(id uuid.UUID, name string, result string) sche
 	}
 }
 
-func newContestTeamDetail(team schema.ContestTeam, link string, description string) schema.ContestTeamDetail {
+func newContestTeamDetail(team schema.ContestTeam, link string, description string, body schema.Markdown, visibility domain.Visibility) schema.ContestTeamDetail {
 	return schema.ContestTeamDetail{
+		Body:        body,
 		Description: description,
 		Id:          team.Id,
 		Link:        link,
 		Members:     team.Members,
 		Name:        team.Name,
 		Result:      team.Result,
+		Visibility:  schema.Visibility(visibility),
 	}
 }
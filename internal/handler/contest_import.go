@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler/schema"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+// parseContestTeamsCSV 見出し行で列を指定したCSVを一括登録の行に変換する
+// 空欄の列は指定されなかったものとして扱い、membersはtraQ IDを空白区切りで並べる
+func parseContestTeamsCSV(r io.Reader) ([]schema.ContestTeamImportRow, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: csv header is missing", repository.ErrInvalidArg)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %s", repository.ErrInvalidArg, err.Error())
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, utf8BOM)))] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("%w: csv must have a name column", repository.ErrInvalidArg)
+	}
+
+	rows := []schema.ContestTeamImportRow{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %s", repository.ErrInvalidArg, err.Error())
+		}
+
+		column := func(name string) *string {
+			i, ok := columns[name]
+			if !ok || record[i] == "" {
+				return nil
+			}
+			return &record[i]
+		}
+
+		row := schema.ContestTeamImportRow{
+			Result:      column("result"),
+			Link:        column("link"),
+			Description: column("description"),
+		}
+		if name := column("name"); name != nil {
+			row.Name = *name
+		}
+		if members := column("members"); members != nil {
+			m := strings.Fields(*members)
+			row.Members = &m
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func newImportContestTeamArgs(row schema.ContestTeamImportRow) *repository.ImportContestTeamArgs {
+	return &repository.ImportContestTeamArgs{
+		Name:        row.Name,
+		Result:      optional.FromPtr(row.Result),
+		Link:        optional.FromPtr(row.Link),
+		Description: optional.FromPtr(row.Description),
+		Members:     optional.FromPtr(row.Members),
+	}
+}
+
+func newContestTeamImportResult(rows []schema.ContestTeamImportRow, results []*domain.ContestTeamImportResult, applied bool) schema.ContestTeamImportResult {
+	res := schema.ContestTeamImportResult{
+		Applied: applied,
+		Rows:    make([]schema.ContestTeamImportRowResult, len(results)),
+	}
+	for i, r := range results {
+		row := schema.ContestTeamImportRowResult{
+			Row:    i + 1,
+			Name:   rows[i].Name,
+			Action: newContestTeamImportAction(r.Action),
+			Errors: r.Errors,
+		}
+		if row.Errors == nil {
+			row.Errors = []string{}
+		}
+		if !r.TeamID.IsNil() {
+			teamID := r.TeamID
+			row.TeamId = &teamID
+		}
+		res.Rows[i] = row
+	}
+
+	return res
+}
+
+func newContestTeamImportAction(a domain.ContestTeamImportAction) schema.ContestTeamImportAction {
+	if a == domain.ContestTeamImportUpdate {
+		return schema.Update
+	}
+
+	return schema.Create
+}
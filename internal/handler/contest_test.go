@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+	"encoding/xml"
 	"fmt"
 	"net/http"
 	"strings"
@@ -8,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -29,7 +32,7 @@ func setupContestMock(t *testing.T) (MockRepository, API) {
 	ctrl := gomock.NewController(t)
 	contest := mock_repository.NewMockContestRepository(ctrl)
 	mr := MockRepository{contest: contest}
-	api := NewAPI(nil, nil, nil, nil, NewContestHandler(contest), nil)
+	api := NewAPI(nil, nil, nil, nil, NewContestHandler(contest), nil, nil, nil, nil)
 
 	return mr, api
 }
@@ -78,6 +81,13 @@ func TestContestHandler_GetContests(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Bad Request: ids with limit",
+			setup: func(_ MockRepository, _ []*domain.Contest) string {
+				return fmt.Sprintf("/api/v1/contests?ids=%s&limit=1", random.UUID())
+			},
+			statusCode: http.StatusBadRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -99,6 +109,72 @@ func TestContestHandler_GetContests(t *testing.T) {
 	}
 }
 
+func TestContestHandler_BatchGetContests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (reqBody *schema.BatchRequest, hres *schema.ContestsBatch)
+		statusCode int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (*schema.BatchRequest, *schema.ContestsBatch) {
+				since := time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)
+				until := time.Date(2022, 8, 2, 0, 0, 0, 0, time.UTC)
+				contest1 := &domain.Contest{ID: random.UUID(), Name: random.AlphaNumeric(), TimeStart: since, TimeEnd: until}
+				contest2 := &domain.Contest{ID: random.UUID(), Name: random.AlphaNumeric(), TimeStart: since, TimeEnd: until}
+				missing := random.UUID()
+				ids := []uuid.UUID{contest2.ID, missing, contest1.ID}
+
+				mr.contest.EXPECT().GetContests(anyCtx{}, &repository.GetContestsArgs{IDs: ids}).Return([]*domain.Contest{contest1, contest2}, nil)
+
+				return &schema.BatchRequest{Ids: ids}, &schema.ContestsBatch{
+					Contests: []schema.Contest{
+						newContest(contest2.ID, contest2.Name, since, until),
+						newContest(contest1.ID, contest1.Name, since, until),
+					},
+					Missing: []uuid.UUID{missing},
+				}
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "internal error",
+			setup: func(mr MockRepository) (*schema.BatchRequest, *schema.ContestsBatch) {
+				ids := []uuid.UUID{random.UUID()}
+				mr.contest.EXPECT().GetContests(anyCtx{}, &repository.GetContestsArgs{IDs: ids}).Return(nil, errInternal)
+
+				return &schema.BatchRequest{Ids: ids}, nil
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "missing ids",
+			setup: func(_ MockRepository) (*schema.BatchRequest, *schema.ContestsBatch) {
+				return &schema.BatchRequest{}, nil
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupContestMock(t)
+
+			reqBody, hres := tt.setup(mr)
+
+			var resBody *schema.ContestsBatch
+			statusCode, _ := doRequest(t, api, http.MethodPost, "/api/v1/contests/batch", reqBody, &resBody)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, hres, resBody)
+		})
+	}
+}
+
 var (
 	getContestID = []uuid.UUID{
 		uuid.FromStringOrNil("11111111-1111-4111-8111-111111111111"),
@@ -183,6 +259,352 @@ func makeContest(t *testing.T) (*domain.ContestDetail, *schema.ContestDetail) {
 	return &d, &hres
 }
 
+func TestContestHandler_GetContestsCalendar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (contains []string, notContains []string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) (contains []string, notContains []string) {
+				public := &domain.Contest{
+					ID:        random.UUID(),
+					Name:      "ISUCON, 本選",
+					TimeStart: time.Date(2022, 8, 1, 1, 0, 0, 0, time.UTC),
+					TimeEnd:   time.Date(2022, 8, 1, 9, 0, 0, 0, time.UTC),
+				}
+				private := &domain.Contest{
+					ID:         random.UUID(),
+					Name:       random.AlphaNumeric(),
+					Visibility: domain.VisibilityPrivate,
+				}
+				draft := &domain.Contest{
+					ID:      random.UUID(),
+					Name:    random.AlphaNumeric(),
+					Publish: domain.PublishState{Draft: true},
+				}
+
+				mr.contest.EXPECT().GetContests(guestCtx{}, &repository.GetContestsArgs{}).Return([]*domain.Contest{public, private, draft}, nil)
+
+				contains = []string{
+					"BEGIN:VCALENDAR\r\n",
+					fmt.Sprintf("UID:contest-%s@traportfolio\r\n", public.ID),
+					"DTSTART:20220801T010000Z\r\n",
+					"DTEND:20220801T090000Z\r\n",
+					"SUMMARY:ISUCON\\, 本選\r\n",
+				}
+				notContains = []string{private.ID.String(), draft.ID.String()}
+				return contains, notContains
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Internal Error",
+			setup: func(mr MockRepository) (contains []string, notContains []string) {
+				mr.contest.EXPECT().GetContests(guestCtx{}, &repository.GetContestsArgs{}).Return(nil, errInternal)
+				return nil, nil
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupContestMock(t)
+
+			contains, notContains := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, "/api/v1/contests.ics", nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "text/calendar; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				for _, v := range contains {
+					assert.Contains(t, rec.Body.String(), v)
+				}
+				for _, v := range notContains {
+					assert.NotContains(t, rec.Body.String(), v)
+				}
+			}
+		})
+	}
+}
+
+func TestContestHandler_GetContestsCsv(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) string
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) string {
+				contest1 := &domain.Contest{
+					ID:        random.UUID(),
+					Name:      "ISUCON, 本選",
+					TimeStart: time.Date(2022, 8, 1, 1, 0, 0, 0, time.UTC),
+					TimeEnd:   time.Date(2022, 8, 1, 9, 0, 0, 0, time.UTC),
+				}
+				contest2 := &domain.Contest{
+					ID:        random.UUID(),
+					Name:      "=HYPERLINK()",
+					TimeStart: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+				}
+				teams := []*domain.ContestTeam{
+					{
+						ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{Name: "team1", Result: "1st"},
+						Members: []*domain.User{
+							domain.NewUser(random.UUID(), "user1", "ユーザー1", true),
+							domain.NewUser(random.UUID(), "user2", "ユーザー2", false),
+						},
+					},
+					{
+						ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{Name: "team2"},
+					},
+				}
+
+				mr.contest.EXPECT().GetContests(anyCtx{}, &repository.GetContestsArgs{}).Return([]*domain.Contest{contest1, contest2}, nil)
+				mr.contest.EXPECT().GetContestTeamsByContestIDs(anyCtx{}, []uuid.UUID{contest1.ID, contest2.ID}).Return(map[uuid.UUID][]*domain.ContestTeam{
+					contest1.ID: teams,
+					contest2.ID: {},
+				}, nil)
+
+				return "\ufeffコンテスト,開始日,終了日,チーム,結果,traQ ID,本名\n" +
+					"\"ISUCON, 本選\",2022/08/01,2022/08/01,team1,1st,user1,ユーザー1\n" +
+					"\"ISUCON, 本選\",2022/08/01,2022/08/01,team1,1st,user2,\n" +
+					"\"ISUCON, 本選\",2022/08/01,2022/08/01,team2,,,\n" +
+					"'=HYPERLINK(),2023/04/01,,,,,\n"
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Success: no contests",
+			setup: func(mr MockRepository) string {
+				mr.contest.EXPECT().GetContests(anyCtx{}, &repository.GetContestsArgs{}).Return([]*domain.Contest{}, nil)
+				mr.contest.EXPECT().GetContestTeamsByContestIDs(anyCtx{}, []uuid.UUID{}).Return(map[uuid.UUID][]*domain.ContestTeam{}, nil)
+				return "\ufeffコンテスト,開始日,終了日,チーム,結果,traQ ID,本名\n"
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Internal Error",
+			setup: func(mr MockRepository) string {
+				mr.contest.EXPECT().GetContests(anyCtx{}, &repository.GetContestsArgs{}).Return(nil, errInternal)
+				return ""
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "Internal Error: teams",
+			setup: func(mr MockRepository) string {
+				contest := &domain.Contest{ID: random.UUID(), Name: "contest"}
+				mr.contest.EXPECT().GetContests(anyCtx{}, &repository.GetContestsArgs{}).Return([]*domain.Contest{contest}, nil)
+				mr.contest.EXPECT().GetContestTeamsByContestIDs(anyCtx{}, []uuid.UUID{contest.ID}).Return(nil, errInternal)
+				return ""
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupContestMock(t)
+
+			want := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, "/api/v1/contests/export.csv", nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "text/csv; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestContestHandler_GetContestCsv(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (path string, want string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) (string, string) {
+				contest := &domain.ContestDetail{
+					Contest: domain.Contest{
+						ID:        random.UUID(),
+						Name:      "ICPC",
+						TimeStart: time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC),
+						TimeEnd:   time.Date(2022, 10, 2, 0, 0, 0, 0, time.UTC),
+					},
+				}
+				teams := []*domain.ContestTeam{
+					{
+						ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{Name: "team1", Result: "-"},
+						Members:                   []*domain.User{domain.NewUser(random.UUID(), "user1", "User One", true)},
+					},
+				}
+
+				mr.contest.EXPECT().GetContest(anyCtx{}, contest.ID).Return(contest, nil)
+				mr.contest.EXPECT().GetContestTeams(anyCtx{}, contest.ID).Return(teams, nil)
+
+				return fmt.Sprintf("/api/v1/contests/%s/export.csv?lang=en", contest.ID),
+					"\ufeffContest,Start,End,Team,Result,traQ ID,Real name\n" +
+						"ICPC,2022/10/01,2022/10/02,team1,'-,user1,User One\n"
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			setup: func(mr MockRepository) (string, string) {
+				contestID := random.UUID()
+				mr.contest.EXPECT().GetContest(anyCtx{}, contestID).Return(nil, repository.ErrNotFound)
+				return fmt.Sprintf("/api/v1/contests/%s/export.csv", contestID), ""
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: invalid contest ID",
+			setup: func(_ MockRepository) (string, string) {
+				return fmt.Sprintf("/api/v1/contests/%s/export.csv", invalidID), ""
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupContestMock(t)
+
+			path, want := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, path, nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "text/csv; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestContestHandler_GetContestsFeed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (want []string, path string)
+		statusCode int
+	}{
+		{
+			name: "Success: entries are sorted by updated time and limited",
+			setup: func(mr MockRepository) (want []string, path string) {
+				contest1 := &domain.FeedContest{
+					ID:        random.UUID(),
+					Name:      "contest1",
+					CreatedAt: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+				}
+				contest2 := &domain.FeedContest{
+					ID:        random.UUID(),
+					Name:      "contest2",
+					CreatedAt: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+				}
+				result := &domain.FeedContestResult{
+					ContestID:   contest1.ID,
+					ContestName: "contest1",
+					TeamID:      random.UUID(),
+					TeamName:    "team1",
+					Result:      "優勝",
+					UpdatedAt:   time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC),
+				}
+
+				mr.contest.EXPECT().GetFeedContests(guestCtx{}, 2).Return([]*domain.FeedContest{contest2, contest1}, nil)
+				mr.contest.EXPECT().GetFeedContestResults(guestCtx{}, 2).Return([]*domain.FeedContestResult{result}, nil)
+
+				want = []string{
+					fmt.Sprintf("urn:uuid:%s", contest2.ID),
+					fmt.Sprintf("urn:uuid:%s", result.TeamID),
+				}
+				return want, "/api/v1/feeds/contests.atom?limit=2"
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Bad Request: invalid limit",
+			setup: func(_ MockRepository) (want []string, path string) {
+				return nil, "/api/v1/feeds/contests.atom?limit=-1"
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: too large limit",
+			setup: func(_ MockRepository) (want []string, path string) {
+				return nil, "/api/v1/feeds/contests.atom?limit=101"
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Internal Error",
+			setup: func(mr MockRepository) (want []string, path string) {
+				mr.contest.EXPECT().GetFeedContests(guestCtx{}, 20).Return(nil, errInternal)
+				return nil, "/api/v1/feeds/contests.atom"
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupContestMock(t)
+
+			want, path := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, path, nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "application/atom+xml; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+
+				var feed struct {
+					Entries []struct {
+						ID string `xml:"id"`
+					} `xml:"entry"`
+				}
+				assert.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &feed))
+				got := make([]string, len(feed.Entries))
+				for i, e := range feed.Entries {
+					got[i] = e.ID
+				}
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
 func TestContestHandler_GetContest(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -516,6 +938,64 @@ func TestContestHandler_DeleteContest(t *testing.T) {
 	}
 }
 
+func TestContestHandler_PublishContest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (reqBody *schema.PublishRequest, path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) (*schema.PublishRequest, string) {
+				contestID := random.UUID()
+				mr.contest.EXPECT().PublishContest(anyCtx{}, contestID, optional.Of[time.Time]{}).Return(nil)
+				return nil, fmt.Sprintf("/api/v1/contests/%s/publish", contestID)
+			},
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name: "Success: scheduled",
+			setup: func(mr MockRepository) (*schema.PublishRequest, string) {
+				contestID := random.UUID()
+				publishAt := time.Date(2100, 4, 1, 0, 0, 0, 0, time.UTC)
+				mr.contest.EXPECT().PublishContest(anyCtx{}, contestID, optional.From(publishAt)).Return(nil)
+				return &schema.PublishRequest{PublishAt: &publishAt}, fmt.Sprintf("/api/v1/contests/%s/publish", contestID)
+			},
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name: "Conflict: already published",
+			setup: func(mr MockRepository) (*schema.PublishRequest, string) {
+				contestID := random.UUID()
+				mr.contest.EXPECT().PublishContest(anyCtx{}, contestID, optional.Of[time.Time]{}).Return(repository.ErrAlreadyExists)
+				return nil, fmt.Sprintf("/api/v1/contests/%s/publish", contestID)
+			},
+			statusCode: http.StatusConflict,
+		},
+		{
+			name: "BadRequest: Invalid ID",
+			setup: func(_ MockRepository) (*schema.PublishRequest, string) {
+				return nil, fmt.Sprintf("/api/v1/contests/%s/publish", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			mr, api := setupContestMock(t)
+
+			reqBody, path := tt.setup(mr)
+
+			statusCode, _ := doRequest(t, api, http.MethodPost, path, reqBody, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+		})
+	}
+}
+
 func TestContestHandler_GetContestTeams(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -573,6 +1053,7 @@ func TestContestHandler_GetContestTeams(t *testing.T) {
 						Result: repoContestTeams[1].Result,
 					},
 				}
+				mr.contest.EXPECT().GetContest(anyCtx{}, contestID).Return(&domain.ContestDetail{Contest: domain.Contest{ID: contestID}}, nil)
 				mr.contest.EXPECT().GetContestTeams(anyCtx{}, contestID).Return(repoContestTeams, nil)
 				return hres, fmt.Sprintf("/api/v1/contests/%s/teams", contestID)
 			},
@@ -1015,6 +1496,63 @@ func TestContestHandler_PatchContestTeam(t *testing.T) {
 	}
 }
 
+func TestContestHandler_PatchContestTeam_IfMatch(t *testing.T) {
+	t.Parallel()
+
+	mr, api := setupContestMock(t)
+
+	contestID := random.UUID()
+	teamID := random.UUID()
+	team := domain.ContestTeamDetail{
+		ContestTeam: domain.ContestTeam{
+			ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{
+				ID:        teamID,
+				ContestID: contestID,
+				Name:      random.AlphaNumeric(),
+				Result:    random.AlphaNumeric(),
+			},
+		},
+		Link:        random.AlphaNumeric(),
+		Description: random.AlphaNumeric(),
+		Version:     5,
+	}
+	members := []*domain.User{domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), random.Bool())}
+	path := fmt.Sprintf("/api/v1/contests/%s/teams/%s", contestID, teamID)
+
+	// 言語ごとにETagは変わるが、どちらも同じ版番号を含む
+	mr.contest.EXPECT().GetContestTeam(anyCtx{}, contestID, teamID).Return(&team, nil).Times(2)
+	mr.contest.EXPECT().GetContestTeamMembers(anyCtx{}, contestID, teamID).Return(members, nil).Times(2)
+	statusCode, rec := doRequestWithHeader(t, api, http.MethodGet, path, nil, nil, map[string]string{headerAcceptLanguage: "en"})
+	assert.Equal(t, http.StatusOK, statusCode)
+	etagEn := rec.Header().Get(headerETag)
+	statusCode, rec = doRequest(t, api, http.MethodGet, path, nil, nil)
+	assert.Equal(t, http.StatusOK, statusCode)
+	etag := rec.Header().Get(headerETag)
+	assert.NotEqual(t, etagEn, etag)
+	enVersion, ok := parseETag(etagEn)
+	assert.True(t, ok)
+	assert.Equal(t, team.Version, enVersion)
+
+	// 取得したETagを使って編集する
+	version := team.Version
+	reqBody := &schema.EditContestTeamRequest{Result: ptr(t, random.AlphaNumeric())}
+	args := repository.UpdateContestTeamArgs{Result: optional.FromPtr(reqBody.Result)}
+	mr.contest.EXPECT().UpdateContestTeam(anyCtx{}, teamID, &args).DoAndReturn(func(ctx context.Context, _ uuid.UUID, _ *repository.UpdateContestTeamArgs) error {
+		if err := repository.CheckVersion(ctx, version); err != nil {
+			return err
+		}
+		version++
+		return nil
+	}).Times(2)
+	statusCode, _ = doRequestWithHeader(t, api, http.MethodPatch, path, reqBody, nil, map[string]string{headerIfMatch: etag})
+	assert.Equal(t, http.StatusNoContent, statusCode)
+
+	// 他のメンバーが先に編集した場合は412を返し、編集しない
+	statusCode, _ = doRequestWithHeader(t, api, http.MethodPatch, path, reqBody, nil, map[string]string{headerIfMatch: etag})
+	assert.Equal(t, http.StatusPreconditionFailed, statusCode)
+	assert.Equal(t, team.Version+1, version)
+}
+
 func TestContestHandler_GetContestTeamMembers(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1039,6 +1577,12 @@ func TestContestHandler_GetContestTeamMembers(t *testing.T) {
 					}
 				}
 
+				team := &domain.ContestTeamDetail{
+					ContestTeam: domain.ContestTeam{
+						ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{ID: teamID, ContestID: contestID},
+					},
+				}
+				mr.contest.EXPECT().GetContestTeam(anyCtx{}, contestID, teamID).Return(team, nil)
 				mr.contest.EXPECT().GetContestTeamMembers(anyCtx{}, contestID, teamID).Return(users, nil)
 				return hres, fmt.Sprintf("/api/v1/contests/%s/teams/%s/members", contestID, teamID)
 			},
@@ -1065,7 +1609,7 @@ func TestContestHandler_GetContestTeamMembers(t *testing.T) {
 			setup: func(mr MockRepository) ([]*schema.User, string) {
 				contestID := random.UUID()
 				teamID := random.UUID()
-				mr.contest.EXPECT().GetContestTeamMembers(anyCtx{}, contestID, teamID).Return(nil, repository.ErrNotFound)
+				mr.contest.EXPECT().GetContestTeam(anyCtx{}, contestID, teamID).Return(nil, repository.ErrNotFound)
 				return nil, fmt.Sprintf("/api/v1/contests/%s/teams/%s/members", contestID, teamID)
 			},
 			statusCode: http.StatusNotFound,
@@ -1168,3 +1712,298 @@ func TestContestHandler_EditContestTeamMembers(t *testing.T) {
 		})
 	}
 }
+
+func TestContestHandler_GetContestRevisionDiff(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (hres []schema.RevisionFieldDiff, path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) ([]schema.RevisionFieldDiff, string) {
+				contestID := random.UUID()
+				diffs := []*domain.RevisionFieldDiff{
+					{Field: "description", From: "before", To: "after"},
+					{Field: "visibility", From: "0", To: "2"},
+				}
+				mr.contest.EXPECT().GetContestRevisionDiff(anyCtx{}, contestID, 2, 1).Return(diffs, nil)
+				hres := []schema.RevisionFieldDiff{
+					{Field: "description", From: "before", To: "after"},
+					{Field: "visibility", From: "0", To: "2"},
+				}
+				return hres, fmt.Sprintf("/api/v1/contests/%s/revisions/diff?from=2&to=1", contestID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Forbidden",
+			setup: func(mr MockRepository) ([]schema.RevisionFieldDiff, string) {
+				contestID := random.UUID()
+				mr.contest.EXPECT().GetContestRevisionDiff(anyCtx{}, contestID, 1, 2).Return(nil, repository.ErrForbidden)
+				return nil, fmt.Sprintf("/api/v1/contests/%s/revisions/diff?from=1&to=2", contestID)
+			},
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name: "Bad Request: missing from",
+			setup: func(_ MockRepository) ([]schema.RevisionFieldDiff, string) {
+				return nil, fmt.Sprintf("/api/v1/contests/%s/revisions/diff?to=2", random.UUID())
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			mr, api := setupContestMock(t)
+
+			expectedHres, path := tt.setup(mr)
+
+			var hres []schema.RevisionFieldDiff
+			statusCode, _ := doRequest(t, api, http.MethodGet, path, nil, &hres)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, expectedHres, hres)
+		})
+	}
+}
+
+func TestContestHandler_RestoreContestTeamRevision(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) string {
+				contestID := random.UUID()
+				teamID := random.UUID()
+				mr.contest.EXPECT().RestoreContestTeamRevision(anyCtx{}, contestID, teamID, 2).Return(nil)
+				return fmt.Sprintf("/api/v1/contests/%s/teams/%s/revisions/2/restore", contestID, teamID)
+			},
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name: "Not Found",
+			setup: func(mr MockRepository) string {
+				contestID := random.UUID()
+				teamID := random.UUID()
+				mr.contest.EXPECT().RestoreContestTeamRevision(anyCtx{}, contestID, teamID, 10).Return(repository.ErrNotFound)
+				return fmt.Sprintf("/api/v1/contests/%s/teams/%s/revisions/10/restore", contestID, teamID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: Invalid Team ID",
+			setup: func(_ MockRepository) string {
+				return fmt.Sprintf("/api/v1/contests/%s/teams/%s/revisions/1/restore", random.UUID(), invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			mr, api := setupContestMock(t)
+
+			path := tt.setup(mr)
+
+			statusCode, _ := doRequest(t, api, http.MethodPost, path, nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+		})
+	}
+}
+
+func TestContestHandler_ImportContestTeams(t *testing.T) {
+	t.Parallel()
+
+	var (
+		contestID = random.UUID()
+		teamID1   = random.UUID()
+		teamID2   = random.UUID()
+	)
+
+	tests := []struct {
+		name        string
+		path        string
+		contentType string
+		reqBody     string
+		setup       func(mr MockRepository)
+		statusCode  int
+		want        *schema.ContestTeamImportResult
+	}{
+		{
+			name:        "Success: json",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import", contestID),
+			contentType: echo.MIMEApplicationJSON,
+			reqBody:     `{"rows":[{"name":"team1","result":"1st","members":["user1","user2"]},{"name":"team2"}]}`,
+			setup: func(mr MockRepository) {
+				args := []*repository.ImportContestTeamArgs{
+					{Name: "team1", Result: optional.From("1st"), Members: optional.From([]string{"user1", "user2"})},
+					{Name: "team2"},
+				}
+				mr.contest.EXPECT().ImportContestTeams(anyCtx{}, contestID, args, false).Return([]*domain.ContestTeamImportResult{
+					{Action: domain.ContestTeamImportCreate, TeamID: teamID1},
+					{Action: domain.ContestTeamImportUpdate, TeamID: teamID2},
+				}, nil)
+			},
+			statusCode: http.StatusOK,
+			want: &schema.ContestTeamImportResult{
+				Applied: true,
+				Rows: []schema.ContestTeamImportRowResult{
+					{Row: 1, Name: "team1", Action: schema.Create, TeamId: &teamID1, Errors: []string{}},
+					{Row: 2, Name: "team2", Action: schema.Update, TeamId: &teamID2, Errors: []string{}},
+				},
+			},
+		},
+		{
+			name:        "Success: csv",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import", contestID),
+			contentType: "text/csv; charset=UTF-8",
+			reqBody:     "name,result,link,members\nteam1,1st,https://example.com,user1 user2\n\"team,2\",,,\n",
+			setup: func(mr MockRepository) {
+				args := []*repository.ImportContestTeamArgs{
+					{Name: "team1", Result: optional.From("1st"), Link: optional.From("https://example.com"), Members: optional.From([]string{"user1", "user2"})},
+					{Name: "team,2"},
+				}
+				mr.contest.EXPECT().ImportContestTeams(anyCtx{}, contestID, args, false).Return([]*domain.ContestTeamImportResult{
+					{Action: domain.ContestTeamImportCreate, TeamID: teamID1},
+					{Action: domain.ContestTeamImportCreate, TeamID: teamID2},
+				}, nil)
+			},
+			statusCode: http.StatusOK,
+			want: &schema.ContestTeamImportResult{
+				Applied: true,
+				Rows: []schema.ContestTeamImportRowResult{
+					{Row: 1, Name: "team1", Action: schema.Create, TeamId: &teamID1, Errors: []string{}},
+					{Row: 2, Name: "team,2", Action: schema.Create, TeamId: &teamID2, Errors: []string{}},
+				},
+			},
+		},
+		{
+			name:        "Success: dry run",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import?dryRun=true", contestID),
+			contentType: echo.MIMEApplicationJSON,
+			reqBody:     `{"rows":[{"name":"team1"},{"name":"team2","description":"desc"}]}`,
+			setup: func(mr MockRepository) {
+				args := []*repository.ImportContestTeamArgs{
+					{Name: "team1"},
+					{Name: "team2", Description: optional.From("desc")},
+				}
+				mr.contest.EXPECT().ImportContestTeams(anyCtx{}, contestID, args, true).Return([]*domain.ContestTeamImportResult{
+					{Action: domain.ContestTeamImportCreate},
+					{Action: domain.ContestTeamImportUpdate, TeamID: teamID2},
+				}, nil)
+			},
+			statusCode: http.StatusOK,
+			want: &schema.ContestTeamImportResult{
+				Applied: false,
+				Rows: []schema.ContestTeamImportRowResult{
+					{Row: 1, Name: "team1", Action: schema.Create, Errors: []string{}},
+					{Row: 2, Name: "team2", Action: schema.Update, TeamId: &teamID2, Errors: []string{}},
+				},
+			},
+		},
+		{
+			name:        "BadRequest: row errors",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import", contestID),
+			contentType: echo.MIMEApplicationJSON,
+			reqBody:     `{"rows":[{"name":"team1","members":["unknown"]},{"name":"team2","link":"invalid link"}]}`,
+			setup: func(mr MockRepository) {
+				args := []*repository.ImportContestTeamArgs{
+					{Name: "team1", Members: optional.From([]string{"unknown"})},
+					{Name: "team2", Link: optional.From("invalid link")},
+				}
+				// 形式の誤りがある場合は変更を適用しない
+				mr.contest.EXPECT().ImportContestTeams(anyCtx{}, contestID, args, true).Return([]*domain.ContestTeamImportResult{
+					{Action: domain.ContestTeamImportCreate, Errors: []string{`user "unknown" is not found`}},
+					{Action: domain.ContestTeamImportCreate},
+				}, nil)
+			},
+			statusCode: http.StatusBadRequest,
+			want: &schema.ContestTeamImportResult{
+				Applied: false,
+				Rows: []schema.ContestTeamImportRowResult{
+					{Row: 1, Name: "team1", Action: schema.Create, Errors: []string{`user "unknown" is not found`}},
+					{Row: 2, Name: "team2", Action: schema.Create, Errors: []string{"link: must be a valid URL."}},
+				},
+			},
+		},
+		{
+			name:        "BadRequest: no rows",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import", contestID),
+			contentType: echo.MIMEApplicationJSON,
+			reqBody:     `{"rows":[]}`,
+			setup:       func(_ MockRepository) {},
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			name:        "BadRequest: csv without name column",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import", contestID),
+			contentType: "text/csv",
+			reqBody:     "result\n1st\n",
+			setup:       func(_ MockRepository) {},
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			name:        "BadRequest: csv with wrong number of fields",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import", contestID),
+			contentType: "text/csv",
+			reqBody:     "name,result\nteam1\n",
+			setup:       func(_ MockRepository) {},
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			name:        "BadRequest: invalid dryRun",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import?dryRun=maybe", contestID),
+			contentType: echo.MIMEApplicationJSON,
+			reqBody:     `{"rows":[{"name":"team1"}]}`,
+			setup:       func(_ MockRepository) {},
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			name:        "BadRequest: invalid contest ID",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import", invalidID),
+			contentType: echo.MIMEApplicationJSON,
+			reqBody:     `{"rows":[{"name":"team1"}]}`,
+			setup:       func(_ MockRepository) {},
+			statusCode:  http.StatusBadRequest,
+		},
+		{
+			name:        "NotFound: contest not exist",
+			path:        fmt.Sprintf("/api/v1/contests/%s/import", contestID),
+			contentType: echo.MIMEApplicationJSON,
+			reqBody:     `{"rows":[{"name":"team1"}]}`,
+			setup: func(mr MockRepository) {
+				args := []*repository.ImportContestTeamArgs{{Name: "team1"}}
+				mr.contest.EXPECT().ImportContestTeams(anyCtx{}, contestID, args, false).Return(nil, repository.ErrNotFound)
+			},
+			statusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			mr, api := setupContestMock(t)
+
+			tt.setup(mr)
+
+			rec := doRawRequest(t, api, http.MethodPost, tt.path, tt.contentType, tt.reqBody)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, rec.Code)
+			if tt.want != nil {
+				res := schema.ContestTeamImportResult{}
+				responseDecode(t, rec, &res)
+				assert.Equal(t, *tt.want, res)
+			}
+		})
+	}
+}
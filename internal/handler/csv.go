@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"time"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+const (
+	mimeTextCSV            = "text/csv"
+	mimeTextCSVCharsetUTF8 = mimeTextCSV + "; charset=UTF-8"
+)
+
+// Excelで開いたときに文字化けしないようUTF-8のBOMを先頭に付ける
+const utf8BOM = "\ufeff"
+
+// csvCell 表計算ソフトで数式として解釈されないよう、記号で始まるユーザー入力の先頭に'を付ける
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+
+	return s
+}
+
+func csvDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format("2006/01/02")
+}
+
+// contestWithTeams CSVに出力するコンテストと所属するチーム
+type contestWithTeams struct {
+	contest *domain.Contest
+	teams   []*domain.ContestTeam
+}
+
+// newContestsCSV メンバー1人につき1行のCSVを作る
+// メンバーのいないチームとチームのないコンテストも、該当する列を空欄にして1行出力する
+func newContestsCSV(lang domain.Lang, contests []*contestWithTeams) ([]byte, error) {
+	rows := [][]string{{
+		lang.Pick("コンテスト", "Contest"),
+		lang.Pick("開始日", "Start"),
+		lang.Pick("終了日", "End"),
+		lang.Pick("チーム", "Team"),
+		lang.Pick("結果", "Result"),
+		"traQ ID",
+		lang.Pick("本名", "Real name"),
+	}}
+
+	for _, c := range contests {
+		row := func(team, result, name, realName string) []string {
+			return []string{
+				csvCell(c.contest.Name),
+				csvDate(c.contest.TimeStart),
+				csvDate(c.contest.TimeEnd),
+				csvCell(team),
+				csvCell(result),
+				name,
+				csvCell(realName),
+			}
+		}
+
+		if len(c.teams) == 0 {
+			rows = append(rows, row("", "", "", ""))
+		}
+		for _, t := range c.teams {
+			if len(t.Members) == 0 {
+				rows = append(rows, row(t.Name, t.Result, "", ""))
+			}
+			for _, m := range t.Members {
+				// 本名の公開を許可していないユーザーはRealNameが空文字列になる
+				rows = append(rows, row(t.Name, t.Result, m.Name, m.RealName()))
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(utf8BOM)
+	if err := csv.NewWriter(&buf).WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
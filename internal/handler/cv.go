@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler/schema"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/pdf"
+)
+
+// PDF形式の履歴書の文字の大きさ(pt)
+const (
+	cvTitleSize   = 20
+	cvHeadingSize = 13
+	cvBodySize    = 10
+	cvNoteSize    = 9
+	cvIndent      = 12
+)
+
+// cvMaxPages 履歴書のページ数の上限。収まらない項目は省略する
+const cvMaxPages = 2
+
+var cvAllSections = []schema.CvSection{
+	schema.Profile,
+	schema.Accounts,
+	schema.Projects,
+	schema.Contests,
+	schema.Groups,
+}
+
+// cvSections 履歴書に含める項目。指定されていない場合は全ての項目を含める
+func cvSections(sections *schema.CvSectionsInQuery) map[schema.CvSection]bool {
+	res := make(map[schema.CvSection]bool, len(cvAllSections))
+	if sections == nil {
+		for _, s := range cvAllSections {
+			res[s] = true
+		}
+
+		return res
+	}
+
+	for _, s := range *sections {
+		res[s] = true
+	}
+
+	return res
+}
+
+// cvContent 履歴書に載せる内容。含めない項目はnil
+type cvContent struct {
+	user     *domain.UserDetail
+	projects []*domain.UserProject
+	contests []*domain.UserContest
+	groups   []*domain.UserGroup
+}
+
+func newCV(lang domain.Lang, sections map[schema.CvSection]bool, c cvContent) ([]byte, error) {
+	name := c.user.DisplayName()
+
+	d := pdf.NewDocument(
+		fmt.Sprintf("%s - %s", name, lang.Pick("履歴書", "CV")),
+		cvMaxPages,
+		lang.Pick("2ページに収まらない項目は省略しました", "Some items were omitted to fit in two pages"),
+	)
+	d.Text(name, cvTitleSize, 0)
+	d.Text("@"+c.user.Name, cvBodySize, 0)
+	d.Rule()
+
+	if sections[schema.Profile] {
+		d.Heading(lang.Pick("プロフィール", "Profile"), cvHeadingSize)
+		bio := strings.TrimSpace(c.user.Bio)
+		if bio == "" {
+			bio = lang.Pick("自己紹介は登録されていません", "No bio registered")
+		}
+		d.Text(bio, cvBodySize, 0)
+	}
+
+	if sections[schema.Accounts] {
+		d.Heading(lang.Pick("アカウント", "Accounts"), cvHeadingSize)
+		for _, a := range c.user.Accounts {
+			d.Text(fmt.Sprintf("%s: %s", accountTypeLabel(a.Type), accountUsername(a)), cvBodySize, 0)
+			d.Text(a.URL, cvNoteSize, cvIndent)
+		}
+	}
+
+	if sections[schema.Projects] {
+		d.Heading(lang.Pick("プロジェクト", "Projects"), cvHeadingSize)
+		for _, p := range c.projects {
+			d.Text(p.Name, cvBodySize, 0)
+			d.Text(fmt.Sprintf("%s: %s", lang.Pick("参加期間", "Membership"), durationLabel(lang, p.UserDuration)), cvNoteSize, cvIndent)
+		}
+	}
+
+	if sections[schema.Contests] {
+		d.Heading(lang.Pick("コンテスト", "Contests"), cvHeadingSize)
+		for _, ct := range c.contests {
+			d.Text(fmt.Sprintf("%s (%s)", ct.Name, ct.TimeStart.Format("2006/01/02")), cvBodySize, 0)
+			for _, t := range ct.Teams {
+				result := t.Result
+				if result == "" {
+					result = "-"
+				}
+				d.Text(fmt.Sprintf("%s: %s  %s: %s", lang.Pick("チーム", "Team"), t.Name, lang.Pick("結果", "Result"), result), cvNoteSize, cvIndent)
+			}
+		}
+	}
+
+	if sections[schema.Groups] {
+		d.Heading(lang.Pick("班", "Groups"), cvHeadingSize)
+		for _, g := range c.groups {
+			d.Text(g.Name, cvBodySize, 0)
+			d.Text(fmt.Sprintf("%s: %s", lang.Pick("所属期間", "Membership"), durationLabel(lang, g.Duration)), cvNoteSize, cvIndent)
+		}
+	}
+
+	return d.Bytes()
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+	headerIfMatch     = "If-Match"
+
+	headerAcceptLanguage = "Accept-Language"
+)
+
+// newETag リソースの版番号と、レスポンスのJSONのハッシュから作るETag
+// 版番号はリソースやメンバーなどの子リソースを編集するたびに増え、If-Matchでは版番号だけを比べて同時編集を検出する
+// 外部サービスから同期する情報や言語、閲覧者によって版番号が同じでも内容が変わるため、
+// If-None-Matchでは言語と閲覧者を含めたハッシュまで比べる
+func newETag(ctx context.Context, version int, b []byte) string {
+	h := sha256.New()
+	viewer := byte(0)
+	if repository.IsMember(ctx) {
+		viewer = 1
+	}
+	h.Write([]byte{byte(repository.LangFrom(ctx)), viewer})
+	h.Write(b)
+	sum := h.Sum(nil)
+
+	return `"` + strconv.Itoa(version) + "-" + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// parseETag newETagで作ったETagから版番号を取り出す
+// 弱いETag(W/から始まるもの)はIf-Matchで一致として扱わないため、版番号として読まない
+func parseETag(etag string) (int, bool) {
+	s, ok := strings.CutPrefix(etag, `"`)
+	if !ok {
+		return 0, false
+	}
+	s, ok = strings.CutSuffix(s, `"`)
+	if !ok {
+		return 0, false
+	}
+	s, _, _ = strings.Cut(s, "-")
+
+	version, err := strconv.Atoi(s)
+	if err != nil || version < 0 {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// matchETag If-None-Matchの値がetagを含むかどうか
+// 弱いETag(W/から始まるもの)も一致として扱う
+func matchETag(header string, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jsonWithETag 版番号とレスポンスの内容から作ったETagをつけてvを200で返す
+// If-None-Matchのいずれかと一致する場合は304を返す
+func jsonWithETag(c echo.Context, version int, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	etag := newETag(c.Request().Context(), version, b)
+	h := c.Response().Header()
+	h.Set(headerETag, etag)
+	h.Set(echo.HeaderVary, headerAcceptLanguage)
+	if inm := c.Request().Header.Get(headerIfNoneMatch); inm != "" && matchETag(inm, etag) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return c.JSONBlob(http.StatusOK, b)
+}
+
+// ifMatchContext If-Matchが指定された場合、編集前のリソースの版番号の条件をリクエストのcontextに記録する
+// 条件はrepositoryの編集のトランザクション内で対象の行をロックして確かめ、満たさない場合はErrPreconditionFailedを返す
+func ifMatchContext(c echo.Context) context.Context {
+	ctx := c.Request().Context()
+	im := c.Request().Header.Get(headerIfMatch)
+	if im == "" {
+		return ctx
+	}
+
+	versions := make([]int, 0)
+	for _, v := range strings.Split(im, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" {
+			// リソースが存在すれば版は問わない
+			return ctx
+		}
+		if version, ok := parseETag(v); ok {
+			versions = append(versions, version)
+		}
+	}
+
+	return repository.WithVersions(ctx, versions...)
+}
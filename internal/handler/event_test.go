@@ -24,7 +24,7 @@ func setupEventMock(t *testing.T) (MockRepository, API) {
 	event := mock_repository.NewMockEventRepository(ctrl)
 	user := mock_repository.NewMockUserRepository(ctrl)
 	mr := MockRepository{user: user, event: event}
-	api := NewAPI(nil, nil, nil, NewEventHandler(event, user), nil, nil)
+	api := NewAPI(nil, nil, nil, NewEventHandler(event, user), nil, nil, nil, nil, nil)
 
 	return mr, api
 }
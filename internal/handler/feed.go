@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/atom"
+)
+
+const (
+	mimeApplicationAtom = "application/atom+xml; charset=UTF-8"
+	feedDefaultLimit    = 20
+	feedAuthor          = "traP"
+)
+
+// feedURL フィードのIDとして使うため、クエリパラメーターを含めないリクエストのURL
+func feedURL(c echo.Context) string {
+	return fmt.Sprintf("%s://%s%s", c.Scheme(), c.Request().Host, c.Request().URL.Path)
+}
+
+func feedEntryID(id fmt.Stringer) string {
+	return "urn:uuid:" + id.String()
+}
+
+func newProjectsFeed(lang domain.Lang, url string, projects []*domain.FeedProject) *atom.Feed {
+	entries := make([]*atom.Entry, len(projects))
+	for i, p := range projects {
+		entries[i] = &atom.Entry{
+			ID:        feedEntryID(p.ID),
+			Title:     p.Name,
+			Content:   p.Description,
+			Published: p.CreatedAt,
+			Updated:   p.UpdatedAt,
+		}
+	}
+
+	return &atom.Feed{
+		ID:      url,
+		Title:   lang.Pick("traP 新着プロジェクト", "traP New Projects"),
+		SelfURL: url,
+		Author:  feedAuthor,
+		Entries: entries,
+	}
+}
+
+// newContestsFeed コンテストの追加と結果の更新を更新日時の新しい順に合わせてlimit件にする
+func newContestsFeed(lang domain.Lang, url string, contests []*domain.FeedContest, results []*domain.FeedContestResult, limit int) *atom.Feed {
+	entries := make([]*atom.Entry, 0, len(contests)+len(results))
+	for _, c := range contests {
+		entries = append(entries, &atom.Entry{
+			ID:        feedEntryID(c.ID),
+			Title:     lang.Pick(fmt.Sprintf("コンテスト「%s」が追加されました", c.Name), fmt.Sprintf("New contest: %s", c.Name)),
+			Content:   c.Description,
+			Published: c.CreatedAt,
+			Updated:   c.UpdatedAt,
+		})
+	}
+	for _, r := range results {
+		entries = append(entries, &atom.Entry{
+			ID:      feedEntryID(r.TeamID),
+			Title:   lang.Pick(fmt.Sprintf("%s: %sの結果", r.ContestName, r.TeamName), fmt.Sprintf("%s: result of %s", r.ContestName, r.TeamName)),
+			Content: r.Result,
+			Updated: r.UpdatedAt,
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Updated.After(entries[j].Updated)
+	})
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return &atom.Feed{
+		ID:      url,
+		Title:   lang.Pick("traP コンテスト", "traP Contests"),
+		SelfURL: url,
+		Author:  feedAuthor,
+		Entries: entries,
+	}
+}
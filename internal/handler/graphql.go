@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	vd "github.com/go-ozzo/ozzo-validation/v4"
+	gqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/traPortfolio/internal/handler/graphql"
+)
+
+type GraphQLHandler struct {
+	resolver *graphql.Resolver
+	schema   *gqlgo.Schema
+}
+
+// NewGraphQLHandler creates a GraphQLHandler
+func NewGraphQLHandler(resolver *graphql.Resolver) (*GraphQLHandler, error) {
+	schema, err := graphql.NewSchema(resolver)
+	if err != nil {
+		return nil, fmt.Errorf("parse graphql schema: %w", err)
+	}
+
+	return &GraphQLHandler{resolver: resolver, schema: schema}, nil
+}
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func (r graphQLRequest) Validate() error {
+	return vd.ValidateStruct(&r,
+		vd.Field(&r.Query, vd.Required),
+	)
+}
+
+// Query POST /graphql
+// GraphQLの慣例に従い、クエリの実行時のエラーはステータスコード200のレスポンスのerrorsで返す
+func (h *GraphQLHandler) Query(c echo.Context) error {
+	req := graphQLRequest{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := h.resolver.WithLoaders(c.Request().Context())
+	res := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	for _, e := range res.Errors {
+		if e.ResolverError == nil {
+			continue
+		}
+
+		code := graphQLErrorCode(e.ResolverError)
+		if code == graphQLErrorInternal {
+			// RESTのAPIと同様に、内部のエラーの詳細は返さずログに出力する
+			c.Logger().Error(e.ResolverError)
+			e.Message = http.StatusText(http.StatusInternalServerError)
+		}
+		e.Extensions = map[string]interface{}{"code": code}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+const graphQLErrorInternal = "INTERNAL_SERVER_ERROR"
+
+// graphQLErrorCode RESTのAPIのステータスコードの名前をエラーの種類とする (例: NOT_FOUND)
+func graphQLErrorCode(err error) string {
+	text := http.StatusText(errorStatus(err))
+	return strings.ToUpper(strings.ReplaceAll(text, " ", "_"))
+}
@@ -0,0 +1,88 @@
+package graphql
+
+import (
+	"context"
+
+	gqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+// contestResolver 説明とチームは必要になったときにloaderで取得する
+type contestResolver struct {
+	r       *Resolver
+	contest domain.Contest
+}
+
+func (r *Resolver) newContest(contest domain.Contest) *contestResolver {
+	return &contestResolver{r: r, contest: contest}
+}
+
+func (c *contestResolver) ID() gqlgo.ID {
+	return gqlgo.ID(c.contest.ID.String())
+}
+
+func (c *contestResolver) Name() string {
+	return c.contest.Name
+}
+
+func (c *contestResolver) Duration() *durationResolver {
+	return newDuration(c.contest.TimeStart, c.contest.TimeEnd)
+}
+
+func (c *contestResolver) Description(ctx context.Context) (string, error) {
+	contest, err := loadersFrom(ctx).contest.Load(ctx, c.contest.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return contest.Description, nil
+}
+
+func (c *contestResolver) Link(ctx context.Context) (string, error) {
+	contest, err := loadersFrom(ctx).contest.Load(ctx, c.contest.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return contest.Link, nil
+}
+
+func (c *contestResolver) Teams(ctx context.Context) ([]*contestTeamResolver, error) {
+	contest, err := loadersFrom(ctx).contest.Load(ctx, c.contest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*contestTeamResolver, len(contest.ContestTeams))
+	for i, t := range contest.ContestTeams {
+		res[i] = &contestTeamResolver{r: c.r, team: t}
+	}
+
+	return res, nil
+}
+
+type contestTeamResolver struct {
+	r    *Resolver
+	team *domain.ContestTeam
+}
+
+func (t *contestTeamResolver) ID() gqlgo.ID {
+	return gqlgo.ID(t.team.ID.String())
+}
+
+func (t *contestTeamResolver) Name() string {
+	return t.team.Name
+}
+
+func (t *contestTeamResolver) Result() string {
+	return t.team.Result
+}
+
+func (t *contestTeamResolver) Members() []*userResolver {
+	res := make([]*userResolver, len(t.team.Members))
+	for i, m := range t.team.Members {
+		res[i] = t.r.newUser(*m)
+	}
+
+	return res
+}
@@ -0,0 +1,60 @@
+package graphql
+
+import (
+	"time"
+
+	gqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+type yearWithSemesterResolver struct {
+	ys domain.YearWithSemester
+}
+
+func (y *yearWithSemesterResolver) Year() int32 {
+	return int32(y.ys.Year)
+}
+
+func (y *yearWithSemesterResolver) Semester() int32 {
+	return int32(y.ys.Semester)
+}
+
+type yearWithSemesterDurationResolver struct {
+	d domain.YearWithSemesterDuration
+}
+
+func newYearWithSemesterDuration(d domain.YearWithSemesterDuration) *yearWithSemesterDurationResolver {
+	return &yearWithSemesterDurationResolver{d}
+}
+
+func (d *yearWithSemesterDurationResolver) Since() *yearWithSemesterResolver {
+	return &yearWithSemesterResolver{d.d.Since}
+}
+
+// Until 継続中の場合はnull
+func (d *yearWithSemesterDurationResolver) Until() *yearWithSemesterResolver {
+	until, ok := d.d.Until.V()
+	if !ok {
+		return nil
+	}
+
+	return &yearWithSemesterResolver{until}
+}
+
+// durationResolver RESTのAPIと同様に、終了日時は常に返す
+type durationResolver struct {
+	since time.Time
+	until time.Time
+}
+
+func newDuration(since time.Time, until time.Time) *durationResolver {
+	return &durationResolver{since: since, until: until}
+}
+
+func (d *durationResolver) Since() gqlgo.Time {
+	return gqlgo.Time{Time: d.since}
+}
+
+func (d *durationResolver) Until() *gqlgo.Time {
+	return &gqlgo.Time{Time: d.until}
+}
@@ -0,0 +1,80 @@
+package graphql
+
+import (
+	"context"
+
+	gqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+// eventResolver 公開範囲による制限はリポジトリで適用されるため、取得できた情報をそのまま返す
+type eventResolver struct {
+	r     *Resolver
+	event domain.Event
+}
+
+func (r *Resolver) newEvent(event domain.Event) *eventResolver {
+	return &eventResolver{r: r, event: event}
+}
+
+func (r *Resolver) newEvents(events []*domain.Event) []*eventResolver {
+	res := make([]*eventResolver, len(events))
+	for i, e := range events {
+		res[i] = r.newEvent(*e)
+	}
+
+	return res
+}
+
+func (e *eventResolver) ID() gqlgo.ID {
+	return gqlgo.ID(e.event.ID.String())
+}
+
+func (e *eventResolver) Name() string {
+	return e.event.Name
+}
+
+func (e *eventResolver) Level() int32 {
+	return int32(e.event.Level)
+}
+
+func (e *eventResolver) Duration() *durationResolver {
+	return newDuration(e.event.TimeStart, e.event.TimeEnd)
+}
+
+func (e *eventResolver) Description(ctx context.Context) (string, error) {
+	event, err := loadersFrom(ctx).event.Load(ctx, e.event.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return event.Description, nil
+}
+
+func (e *eventResolver) Place(ctx context.Context) (string, error) {
+	event, err := loadersFrom(ctx).event.Load(ctx, e.event.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return event.Place, nil
+}
+
+func (e *eventResolver) Hosts(ctx context.Context) ([]*userResolver, error) {
+	event, err := loadersFrom(ctx).event.Load(ctx, e.event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := loadersFrom(ctx).allUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*userResolver, len(event.HostName))
+	for i, h := range event.HostName {
+		res[i] = e.r.newUser(fillUser(users, *h))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	gqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+// groupResolver 説明とメンバーは必要になったときにloaderで取得する
+type groupResolver struct {
+	r    *Resolver
+	id   uuid.UUID
+	name string
+}
+
+func (r *Resolver) newGroup(id uuid.UUID, name string) *groupResolver {
+	return &groupResolver{r: r, id: id, name: name}
+}
+
+func (g *groupResolver) ID() gqlgo.ID {
+	return gqlgo.ID(g.id.String())
+}
+
+func (g *groupResolver) Name() string {
+	return g.name
+}
+
+func (g *groupResolver) Description(ctx context.Context) (string, error) {
+	group, err := loadersFrom(ctx).group.Load(ctx, g.id)
+	if err != nil {
+		return "", err
+	}
+
+	return group.Description, nil
+}
+
+func (g *groupResolver) Link(ctx context.Context) (string, error) {
+	group, err := loadersFrom(ctx).group.Load(ctx, g.id)
+	if err != nil {
+		return "", err
+	}
+
+	return group.Link, nil
+}
+
+func (g *groupResolver) Admins(ctx context.Context) ([]*userResolver, error) {
+	group, err := loadersFrom(ctx).group.Load(ctx, g.id)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := loadersFrom(ctx).allUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*userResolver, len(group.Admin))
+	for i, a := range group.Admin {
+		res[i] = g.r.newUser(fillUser(users, *a))
+	}
+
+	return res, nil
+}
+
+func (g *groupResolver) Members(ctx context.Context) ([]*memberResolver, error) {
+	group, err := loadersFrom(ctx).group.Load(ctx, g.id)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := loadersFrom(ctx).allUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*domain.UserWithDuration, len(group.Members))
+	for i, m := range group.Members {
+		members[i] = &domain.UserWithDuration{User: fillUser(users, m.User), Duration: m.Duration}
+	}
+
+	return g.r.newMembers(members), nil
+}
+
+// fillUser 班やイベントのリポジトリはユーザーのIDしか持たないため、ユーザー一覧から名前などを補う
+// RESTのAPIと同様に、一覧に無いユーザーはそのまま返す
+func fillUser(users map[uuid.UUID]*domain.User, u domain.User) domain.User {
+	if filled, ok := users[u.ID]; ok {
+		return *filled
+	}
+
+	return u
+}
@@ -0,0 +1,150 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/graph-gophers/dataloader"
+	"github.com/samber/lo"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+// loaderWait 同じバッチにまとめるために他のフィールドの読み込みを待つ時間
+const loaderWait = 2 * time.Millisecond
+
+// idKey dataloaderのキーとして使うUUID
+type idKey uuid.UUID
+
+func (k idKey) String() string   { return uuid.UUID(k).String() }
+func (k idKey) Raw() interface{} { return uuid.UUID(k) }
+
+// loader リクエスト内で同時に必要になったIDをまとめて取得し、同じIDの取得結果を使い回す
+// クエリ中で同じユーザーやプロジェクトが何度現れても、リポジトリへの問い合わせはIDごとに高々1回になる
+type loader[T any] struct {
+	l *dataloader.Loader
+}
+
+// newBatchLoader 複数のIDをまとめて取得するリポジトリのメソッドで、バッチごとに1回だけ問い合わせる
+// 結果に含まれないIDはErrNotFoundとして扱う
+func newBatchLoader[T any](fetch func(ctx context.Context, ids []uuid.UUID) ([]T, error), keyOf func(T) uuid.UUID) loader[T] {
+	batch := func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		ids := make([]uuid.UUID, len(keys))
+		for i, k := range keys {
+			ids[i] = k.Raw().(uuid.UUID)
+		}
+
+		results := make([]*dataloader.Result, len(keys))
+		vs, err := fetch(ctx, ids)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result{Error: err}
+			}
+			return results
+		}
+
+		m := lo.KeyBy(vs, keyOf)
+		for i, id := range ids {
+			if v, ok := m[id]; ok {
+				results[i] = &dataloader.Result{Data: v}
+			} else {
+				results[i] = &dataloader.Result{Error: repository.ErrNotFound}
+			}
+		}
+
+		return results
+	}
+
+	return loader[T]{l: dataloader.NewBatchedLoader(batch, dataloader.WithWait(loaderWait))}
+}
+
+// newLoader IDごとにしか取得できないリポジトリのメソッドで、バッチ内の異なるIDごとに問い合わせる
+func newLoader[T any](fetch func(ctx context.Context, id uuid.UUID) (T, error)) loader[T] {
+	batch := func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		results := make([]*dataloader.Result, len(keys))
+		for i, k := range keys {
+			v, err := fetch(ctx, k.Raw().(uuid.UUID))
+			results[i] = &dataloader.Result{Data: v, Error: err}
+		}
+
+		return results
+	}
+
+	return loader[T]{l: dataloader.NewBatchedLoader(batch, dataloader.WithWait(loaderWait))}
+}
+
+func (l loader[T]) Load(ctx context.Context, id uuid.UUID) (T, error) {
+	v, err := l.l.Load(ctx, idKey(id))()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return v.(T), nil
+}
+
+// loaders 1回のリクエストで使うloader
+// 取得結果をリクエストの間キャッシュするため、リクエストごとに作る
+type loaders struct {
+	user         loader[*domain.UserDetail]
+	userProjects loader[[]*domain.UserProject]
+	userContests loader[[]*domain.UserContest]
+	userGroups   loader[[]*domain.UserGroup]
+	userEvents   loader[[]*domain.Event]
+	project      loader[*domain.ProjectDetail]
+	contest      loader[*domain.ContestDetail]
+	group        loader[*domain.GroupDetail]
+	event        loader[*domain.EventDetail]
+
+	// allUsers イベントの主催者の本名などを補うためのユーザー一覧
+	allUsers func(ctx context.Context) (map[uuid.UUID]*domain.User, error)
+}
+
+func newLoaders(r *Resolver) *loaders {
+	var (
+		once     sync.Once
+		usersMap map[uuid.UUID]*domain.User
+		usersErr error
+	)
+
+	return &loaders{
+		user:         newBatchLoader(r.user.GetUserDetails, func(u *domain.UserDetail) uuid.UUID { return u.ID }),
+		userProjects: newLoader(r.user.GetProjects),
+		userContests: newLoader(r.user.GetContests),
+		userGroups:   newLoader(r.user.GetGroupsByUserID),
+		userEvents:   newLoader(r.event.GetUserEvents),
+		project:      newBatchLoader(r.project.GetProjectDetails, func(p *domain.ProjectDetail) uuid.UUID { return p.ID }),
+		contest:      newBatchLoader(r.contest.GetContestDetails, func(c *domain.ContestDetail) uuid.UUID { return c.ID }),
+		group:        newLoader(r.group.GetGroup),
+		event:        newLoader(r.event.GetEvent),
+		allUsers: func(ctx context.Context) (map[uuid.UUID]*domain.User, error) {
+			once.Do(func() {
+				var users []*domain.User
+				users, usersErr = r.user.GetUsers(ctx, &repository.GetUsersArgs{})
+				if usersErr != nil {
+					return
+				}
+
+				usersMap = make(map[uuid.UUID]*domain.User, len(users))
+				for _, u := range users {
+					usersMap[u.ID] = u
+				}
+			})
+
+			return usersMap, usersErr
+		},
+	}
+}
+
+type loadersKey struct{}
+
+// WithLoaders リクエストごとのloaderをctxに記録する
+func (r *Resolver) WithLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, newLoaders(r))
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,79 @@
+package graphql
+
+import (
+	"context"
+
+	gqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+// projectResolver 説明とメンバーは必要になったときにloaderで取得する
+type projectResolver struct {
+	r       *Resolver
+	project domain.Project
+}
+
+func (r *Resolver) newProject(project domain.Project) *projectResolver {
+	return &projectResolver{r: r, project: project}
+}
+
+func (p *projectResolver) ID() gqlgo.ID {
+	return gqlgo.ID(p.project.ID.String())
+}
+
+func (p *projectResolver) Name() string {
+	return p.project.Name
+}
+
+func (p *projectResolver) Duration() *yearWithSemesterDurationResolver {
+	return newYearWithSemesterDuration(p.project.Duration)
+}
+
+func (p *projectResolver) Description(ctx context.Context) (string, error) {
+	project, err := loadersFrom(ctx).project.Load(ctx, p.project.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return project.Description, nil
+}
+
+func (p *projectResolver) Link(ctx context.Context) (string, error) {
+	project, err := loadersFrom(ctx).project.Load(ctx, p.project.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return project.Link, nil
+}
+
+func (p *projectResolver) Members(ctx context.Context) ([]*memberResolver, error) {
+	project, err := loadersFrom(ctx).project.Load(ctx, p.project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.r.newMembers(project.Members), nil
+}
+
+type memberResolver struct {
+	r      *Resolver
+	member *domain.UserWithDuration
+}
+
+func (r *Resolver) newMembers(members []*domain.UserWithDuration) []*memberResolver {
+	res := make([]*memberResolver, len(members))
+	for i, m := range members {
+		res[i] = &memberResolver{r: r, member: m}
+	}
+
+	return res
+}
+
+func (m *memberResolver) User() *userResolver {
+	return m.r.newUser(m.member.User)
+}
+
+func (m *memberResolver) Duration() *yearWithSemesterDurationResolver {
+	return newYearWithSemesterDuration(m.member.Duration)
+}
@@ -0,0 +1,219 @@
+// Package graphql RESTのAPIと同じリポジトリを使うGraphQLのスキーマとリゾルバ
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	gqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+// maxDepth 入れ子の深いクエリで大量に問い合わせないよう、クエリの深さを制限する
+const maxDepth = 8
+
+// ErrEventDisabled RESTのAPIと同様に、本番環境ではイベントの情報を提供しない
+var ErrEventDisabled = errors.New("event API is not implemented in this version")
+
+// Resolver Queryのリゾルバ
+type Resolver struct {
+	user    repository.UserRepository
+	project repository.ProjectRepository
+	contest repository.ContestRepository
+	group   repository.GroupRepository
+	event   repository.EventRepository
+
+	enableEvents bool
+}
+
+func NewResolver(user repository.UserRepository, project repository.ProjectRepository, contest repository.ContestRepository, group repository.GroupRepository, event repository.EventRepository, enableEvents bool) *Resolver {
+	return &Resolver{
+		user:         user,
+		project:      project,
+		contest:      contest,
+		group:        group,
+		event:        event,
+		enableEvents: enableEvents,
+	}
+}
+
+// NewSchema リゾルバを紐づけたスキーマを作る
+// 実行時のctxにはWithLoadersでloaderを記録する必要がある
+func NewSchema(r *Resolver) (*gqlgo.Schema, error) {
+	return gqlgo.ParseSchema(schemaString, r, gqlgo.MaxDepth(maxDepth))
+}
+
+func parseID(id gqlgo.ID) (uuid.UUID, error) {
+	u, err := uuid.FromString(string(id))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %s", repository.ErrInvalidID, err.Error())
+	}
+
+	return u, nil
+}
+
+func optionalInt(v *int32) optional.Of[int] {
+	if v == nil {
+		return optional.Of[int]{}
+	}
+
+	return optional.From(int(*v))
+}
+
+func (r *Resolver) Users(ctx context.Context, args struct {
+	Name             *string
+	IncludeSuspended *bool
+	Limit            *int32
+}) ([]*userResolver, error) {
+	users, err := r.user.GetUsers(ctx, &repository.GetUsersArgs{
+		IncludeSuspended: optional.FromPtr(args.IncludeSuspended),
+		Name:             optional.FromPtr(args.Name),
+		Limit:            optionalInt(args.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*userResolver, len(users))
+	for i, u := range users {
+		res[i] = r.newUser(*u)
+	}
+
+	return res, nil
+}
+
+func (r *Resolver) User(ctx context.Context, args struct{ ID gqlgo.ID }) (*userResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := loadersFrom(ctx).user.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newUser(user.User), nil
+}
+
+func (r *Resolver) Projects(ctx context.Context, args struct{ Limit *int32 }) ([]*projectResolver, error) {
+	projects, err := r.project.GetProjects(ctx, &repository.GetProjectsArgs{Limit: optionalInt(args.Limit)})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*projectResolver, len(projects))
+	for i, p := range projects {
+		res[i] = r.newProject(*p)
+	}
+
+	return res, nil
+}
+
+func (r *Resolver) Project(ctx context.Context, args struct{ ID gqlgo.ID }) (*projectResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := loadersFrom(ctx).project.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newProject(project.Project), nil
+}
+
+func (r *Resolver) Contests(ctx context.Context, args struct{ Limit *int32 }) ([]*contestResolver, error) {
+	contests, err := r.contest.GetContests(ctx, &repository.GetContestsArgs{Limit: optionalInt(args.Limit)})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*contestResolver, len(contests))
+	for i, c := range contests {
+		res[i] = r.newContest(*c)
+	}
+
+	return res, nil
+}
+
+func (r *Resolver) Contest(ctx context.Context, args struct{ ID gqlgo.ID }) (*contestResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	contest, err := loadersFrom(ctx).contest.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newContest(contest.Contest), nil
+}
+
+func (r *Resolver) Groups(ctx context.Context, args struct{ Limit *int32 }) ([]*groupResolver, error) {
+	groups, err := r.group.GetGroups(ctx, &repository.GetGroupsArgs{Limit: optionalInt(args.Limit)})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*groupResolver, len(groups))
+	for i, g := range groups {
+		res[i] = r.newGroup(g.ID, g.Name)
+	}
+
+	return res, nil
+}
+
+func (r *Resolver) Group(ctx context.Context, args struct{ ID gqlgo.ID }) (*groupResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := loadersFrom(ctx).group.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newGroup(group.ID, group.Name), nil
+}
+
+func (r *Resolver) Events(ctx context.Context) ([]*eventResolver, error) {
+	if !r.enableEvents {
+		return nil, ErrEventDisabled
+	}
+
+	events, err := r.event.GetEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newEvents(events), nil
+}
+
+func (r *Resolver) Event(ctx context.Context, args struct{ ID gqlgo.ID }) (*eventResolver, error) {
+	if !r.enableEvents {
+		return nil, ErrEventDisabled
+	}
+
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := loadersFrom(ctx).event.Load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newEvent(event.Event), nil
+}
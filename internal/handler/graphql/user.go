@@ -0,0 +1,234 @@
+package graphql
+
+import (
+	"context"
+
+	gqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+// userResolver 名前以外の情報は必要になったときにloaderで取得する
+type userResolver struct {
+	r    *Resolver
+	user domain.User
+}
+
+func (r *Resolver) newUser(user domain.User) *userResolver {
+	return &userResolver{r: r, user: user}
+}
+
+func (u *userResolver) ID() gqlgo.ID {
+	return gqlgo.ID(u.user.ID.String())
+}
+
+func (u *userResolver) Name() string {
+	return u.user.Name
+}
+
+// RealName 本名の公開を許可していない場合は空文字列
+func (u *userResolver) RealName() string {
+	return u.user.RealName()
+}
+
+func (u *userResolver) State(ctx context.Context) (int32, error) {
+	user, err := loadersFrom(ctx).user.Load(ctx, u.user.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(user.State), nil
+}
+
+func (u *userResolver) Bio(ctx context.Context) (string, error) {
+	user, err := loadersFrom(ctx).user.Load(ctx, u.user.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return user.Bio, nil
+}
+
+func (u *userResolver) Accounts(ctx context.Context) ([]*accountResolver, error) {
+	user, err := loadersFrom(ctx).user.Load(ctx, u.user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*accountResolver, len(user.Accounts))
+	for i, a := range user.Accounts {
+		res[i] = &accountResolver{a}
+	}
+
+	return res, nil
+}
+
+func (u *userResolver) Projects(ctx context.Context) ([]*userProjectResolver, error) {
+	projects, err := loadersFrom(ctx).userProjects.Load(ctx, u.user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*userProjectResolver, len(projects))
+	for i, p := range projects {
+		res[i] = &userProjectResolver{r: u.r, project: p}
+	}
+
+	return res, nil
+}
+
+func (u *userResolver) Contests(ctx context.Context) ([]*userContestResolver, error) {
+	contests, err := loadersFrom(ctx).userContests.Load(ctx, u.user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*userContestResolver, len(contests))
+	for i, c := range contests {
+		res[i] = &userContestResolver{r: u.r, contest: c}
+	}
+
+	return res, nil
+}
+
+func (u *userResolver) Groups(ctx context.Context) ([]*userGroupResolver, error) {
+	groups, err := loadersFrom(ctx).userGroups.Load(ctx, u.user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*userGroupResolver, len(groups))
+	for i, g := range groups {
+		res[i] = &userGroupResolver{r: u.r, group: g}
+	}
+
+	return res, nil
+}
+
+func (u *userResolver) Events(ctx context.Context) ([]*eventResolver, error) {
+	if !u.r.enableEvents {
+		return nil, ErrEventDisabled
+	}
+
+	events, err := loadersFrom(ctx).userEvents.Load(ctx, u.user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.r.newEvents(events), nil
+}
+
+type accountResolver struct {
+	account *domain.Account
+}
+
+func (a *accountResolver) ID() gqlgo.ID {
+	return gqlgo.ID(a.account.ID.String())
+}
+
+func (a *accountResolver) DisplayName() string {
+	return a.account.DisplayName
+}
+
+func (a *accountResolver) Type() int32 {
+	return int32(a.account.Type)
+}
+
+func (a *accountResolver) URL() string {
+	return a.account.URL
+}
+
+func (a *accountResolver) Handle() string {
+	return a.account.Handle
+}
+
+type userProjectResolver struct {
+	r       *Resolver
+	project *domain.UserProject
+}
+
+func (p *userProjectResolver) ID() gqlgo.ID {
+	return gqlgo.ID(p.project.ID.String())
+}
+
+func (p *userProjectResolver) Name() string {
+	return p.project.Name
+}
+
+func (p *userProjectResolver) Duration() *yearWithSemesterDurationResolver {
+	return newYearWithSemesterDuration(p.project.Duration)
+}
+
+func (p *userProjectResolver) UserDuration() *yearWithSemesterDurationResolver {
+	return newYearWithSemesterDuration(p.project.UserDuration)
+}
+
+func (p *userProjectResolver) Project() *projectResolver {
+	return p.r.newProject(domain.Project{ID: p.project.ID, Name: p.project.Name, Duration: p.project.Duration})
+}
+
+type userContestResolver struct {
+	r       *Resolver
+	contest *domain.UserContest
+}
+
+func (c *userContestResolver) ID() gqlgo.ID {
+	return gqlgo.ID(c.contest.ID.String())
+}
+
+func (c *userContestResolver) Name() string {
+	return c.contest.Name
+}
+
+func (c *userContestResolver) Duration() *durationResolver {
+	return newDuration(c.contest.TimeStart, c.contest.TimeEnd)
+}
+
+func (c *userContestResolver) Teams() []*userContestTeamResolver {
+	res := make([]*userContestTeamResolver, len(c.contest.Teams))
+	for i, t := range c.contest.Teams {
+		res[i] = &userContestTeamResolver{t}
+	}
+
+	return res
+}
+
+func (c *userContestResolver) Contest() *contestResolver {
+	return c.r.newContest(domain.Contest{ID: c.contest.ID, Name: c.contest.Name, TimeStart: c.contest.TimeStart, TimeEnd: c.contest.TimeEnd})
+}
+
+type userContestTeamResolver struct {
+	team *domain.ContestTeamWithoutMembers
+}
+
+func (t *userContestTeamResolver) ID() gqlgo.ID {
+	return gqlgo.ID(t.team.ID.String())
+}
+
+func (t *userContestTeamResolver) Name() string {
+	return t.team.Name
+}
+
+func (t *userContestTeamResolver) Result() string {
+	return t.team.Result
+}
+
+type userGroupResolver struct {
+	r     *Resolver
+	group *domain.UserGroup
+}
+
+func (g *userGroupResolver) ID() gqlgo.ID {
+	return gqlgo.ID(g.group.ID.String())
+}
+
+func (g *userGroupResolver) Name() string {
+	return g.group.Name
+}
+
+func (g *userGroupResolver) Duration() *yearWithSemesterDurationResolver {
+	return newYearWithSemesterDuration(g.group.Duration)
+}
+
+func (g *userGroupResolver) Group() *groupResolver {
+	return g.r.newGroup(g.group.ID, g.group.Name)
+}
@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler/graphql"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository/mock_repository"
+	"go.uber.org/mock/gomock"
+)
+
+func setupGraphQLMock(t *testing.T, enableEvents bool) (MockRepository, API) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mr := MockRepository{
+		user:    mock_repository.NewMockUserRepository(ctrl),
+		project: mock_repository.NewMockProjectRepository(ctrl),
+		contest: mock_repository.NewMockContestRepository(ctrl),
+		group:   mock_repository.NewMockGroupRepository(ctrl),
+		event:   mock_repository.NewMockEventRepository(ctrl),
+	}
+	h, err := NewGraphQLHandler(graphql.NewResolver(mr.user, mr.project, mr.contest, mr.group, mr.event, enableEvents))
+	assert.NoError(t, err)
+	api := NewAPI(nil, nil, nil, nil, nil, nil, nil, h, nil)
+
+	return mr, api
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string            `json:"message"`
+		Extensions map[string]string `json:"extensions"`
+	} `json:"errors"`
+}
+
+func TestGraphQLHandler_Query(t *testing.T) {
+	t.Parallel()
+
+	type errorWant struct {
+		message string
+		code    string
+	}
+
+	tests := []struct {
+		name         string
+		enableEvents bool
+		setup        func(mr MockRepository) (req graphQLRequest, data string)
+		errors       []errorWant
+	}{
+		{
+			name:         "success: profile in one request",
+			enableEvents: true,
+			setup: func(mr MockRepository) (graphQLRequest, string) {
+				userID := random.UUID()
+				projectID := random.UUID()
+				contestID := random.UUID()
+				groupID := random.UUID()
+				eventID := random.UUID()
+				user := &domain.UserDetail{
+					User:  *domain.NewUser(userID, "user1", "User One", false),
+					State: domain.TraqStateActive,
+					Bio:   "Hello",
+					Accounts: []*domain.Account{
+						{ID: random.UUID(), DisplayName: "user1", Type: domain.GITHUB, Handle: "user1", URL: "https://github.com/user1"},
+					},
+				}
+
+				// bioとaccountsはどちらもGetUserDetailsの結果から返すため、GetUserDetailsは1回だけ呼ばれる
+				mr.user.EXPECT().GetUserDetails(anyCtx{}, []uuid.UUID{userID}).Return([]*domain.UserDetail{user}, nil).Times(1)
+				mr.user.EXPECT().GetProjects(anyCtx{}, userID).Return([]*domain.UserProject{
+					{ID: projectID, Name: "project1", Duration: domain.NewYearWithSemesterDuration(2022, 0, 0, 0), UserDuration: domain.NewYearWithSemesterDuration(2022, 1, 2023, 0)},
+				}, nil)
+				mr.user.EXPECT().GetContests(anyCtx{}, userID).Return([]*domain.UserContest{
+					{
+						ID:        contestID,
+						Name:      "contest1",
+						TimeStart: time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC),
+						TimeEnd:   time.Date(2022, 8, 2, 0, 0, 0, 0, time.UTC),
+						Teams:     []*domain.ContestTeamWithoutMembers{{ID: random.UUID(), Name: "team1", Result: "優勝"}},
+					},
+				}, nil)
+				mr.user.EXPECT().GetGroupsByUserID(anyCtx{}, userID).Return([]*domain.UserGroup{
+					{ID: groupID, Name: "group1", Duration: domain.NewYearWithSemesterDuration(2021, 0, 2021, 1)},
+				}, nil)
+				mr.event.EXPECT().GetUserEvents(anyCtx{}, userID).Return([]*domain.Event{
+					{ID: eventID, Name: "event1", Level: domain.EventLevelPublic, TimeStart: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC), TimeEnd: time.Date(2022, 4, 1, 1, 0, 0, 0, time.UTC)},
+				}, nil)
+
+				req := graphQLRequest{
+					Query: `query($id: ID!) {
+						user(id: $id) {
+							name realName state bio
+							accounts { displayName type url handle }
+							projects { name duration { since { year semester } until { year semester } } userDuration { since { year semester } until { year semester } } }
+							contests { name duration { since until } teams { name result } }
+							groups { name }
+							events { name level }
+						}
+					}`,
+					Variables: map[string]interface{}{"id": userID.String()},
+				}
+				data := fmt.Sprintf(`{"user": {
+					"name": "user1", "realName": "", "state": %d, "bio": "Hello",
+					"accounts": [{"displayName": "user1", "type": %d, "url": "https://github.com/user1", "handle": "user1"}],
+					"projects": [{"name": "project1", "duration": {"since": {"year": 2022, "semester": 0}, "until": null}, "userDuration": {"since": {"year": 2022, "semester": 1}, "until": {"year": 2023, "semester": 0}}}],
+					"contests": [{"name": "contest1", "duration": {"since": "2022-08-01T00:00:00Z", "until": "2022-08-02T00:00:00Z"}, "teams": [{"name": "team1", "result": "優勝"}]}],
+					"groups": [{"name": "group1"}],
+					"events": [{"name": "event1", "level": %d}]
+				}}`, domain.TraqStateActive, domain.GITHUB, domain.EventLevelPublic)
+
+				return req, data
+			},
+		},
+		{
+			name: "success: projects and shared members are loaded in one batch",
+			setup: func(mr MockRepository) (graphQLRequest, string) {
+				member := domain.UserWithDuration{User: *domain.NewUser(random.UUID(), "user1", "User One", true), Duration: domain.NewYearWithSemesterDuration(2022, 0, 0, 0)}
+				projects := []*domain.Project{
+					{ID: random.UUID(), Name: "project1"},
+					{ID: random.UUID(), Name: "project2"},
+				}
+
+				mr.project.EXPECT().GetProjects(anyCtx{}, gomock.Any()).Return(projects, nil)
+				mr.project.EXPECT().GetProjectDetails(anyCtx{}, gomock.InAnyOrder([]uuid.UUID{projects[0].ID, projects[1].ID})).Return([]*domain.ProjectDetail{
+					{Project: *projects[0], Members: []*domain.UserWithDuration{&member}},
+					{Project: *projects[1], Members: []*domain.UserWithDuration{&member}},
+				}, nil).Times(1)
+				mr.user.EXPECT().GetUserDetails(anyCtx{}, []uuid.UUID{member.User.ID}).Return([]*domain.UserDetail{{User: member.User, Bio: "Hello"}}, nil).Times(1)
+
+				req := graphQLRequest{Query: `{ projects { name members { user { name realName bio } } } }`}
+				data := `{"projects": [
+					{"name": "project1", "members": [{"user": {"name": "user1", "realName": "User One", "bio": "Hello"}}]},
+					{"name": "project2", "members": [{"user": {"name": "user1", "realName": "User One", "bio": "Hello"}}]}
+				]}`
+
+				return req, data
+			},
+		},
+		{
+			name: "success: group members are filled with user names",
+			setup: func(mr MockRepository) (graphQLRequest, string) {
+				user := domain.NewUser(random.UUID(), "user1", "User One", true)
+				groupID := random.UUID()
+
+				mr.group.EXPECT().GetGroup(anyCtx{}, groupID).Return(&domain.GroupDetail{
+					ID:      groupID,
+					Name:    "group1",
+					Admin:   []*domain.User{{ID: user.ID}},
+					Members: []*domain.UserWithDuration{{User: domain.User{ID: user.ID}}},
+				}, nil)
+				mr.user.EXPECT().GetUsers(anyCtx{}, &repository.GetUsersArgs{}).Return([]*domain.User{user}, nil).Times(1)
+
+				req := graphQLRequest{Query: fmt.Sprintf(`{ group(id: "%s") { name admins { name } members { user { name realName } } } }`, groupID)}
+				data := `{"group": {"name": "group1", "admins": [{"name": "user1"}], "members": [{"user": {"name": "user1", "realName": "User One"}}]}}`
+
+				return req, data
+			},
+		},
+		{
+			name: "success: teams of contests are loaded in one batch",
+			setup: func(mr MockRepository) (graphQLRequest, string) {
+				member := domain.NewUser(random.UUID(), "user1", "User One", true)
+				contests := []*domain.Contest{
+					{ID: random.UUID(), Name: "contest1"},
+					{ID: random.UUID(), Name: "contest2"},
+				}
+
+				mr.contest.EXPECT().GetContests(anyCtx{}, gomock.Any()).Return(contests, nil)
+				mr.contest.EXPECT().GetContestDetails(anyCtx{}, gomock.InAnyOrder([]uuid.UUID{contests[0].ID, contests[1].ID})).Return([]*domain.ContestDetail{
+					{Contest: *contests[0], ContestTeams: []*domain.ContestTeam{
+						{ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{ID: random.UUID(), Name: "team1", Result: "優勝"}, Members: []*domain.User{member}},
+					}},
+					{Contest: *contests[1], ContestTeams: []*domain.ContestTeam{}},
+				}, nil).Times(1)
+
+				req := graphQLRequest{Query: `{ contests { name teams { name result members { name } } } }`}
+				data := `{"contests": [
+					{"name": "contest1", "teams": [{"name": "team1", "result": "優勝", "members": [{"name": "user1"}]}]},
+					{"name": "contest2", "teams": []}
+				]}`
+
+				return req, data
+			},
+		},
+		{
+			name: "not found",
+			setup: func(mr MockRepository) (graphQLRequest, string) {
+				projectID := random.UUID()
+				mr.project.EXPECT().GetProjectDetails(anyCtx{}, []uuid.UUID{projectID}).Return([]*domain.ProjectDetail{}, nil)
+
+				return graphQLRequest{Query: fmt.Sprintf(`{ project(id: "%s") { name } }`, projectID)}, `null`
+			},
+			errors: []errorWant{{message: repository.ErrNotFound.Error(), code: "NOT_FOUND"}},
+		},
+		{
+			name: "invalid id",
+			setup: func(_ MockRepository) (graphQLRequest, string) {
+				return graphQLRequest{Query: fmt.Sprintf(`{ contest(id: "%s") { name } }`, invalidID)}, `null`
+			},
+			errors: []errorWant{{code: "BAD_REQUEST"}},
+		},
+		{
+			name: "internal error is hidden",
+			setup: func(mr MockRepository) (graphQLRequest, string) {
+				mr.contest.EXPECT().GetContests(anyCtx{}, gomock.Any()).Return(nil, errInternal)
+
+				return graphQLRequest{Query: `{ contests { name } }`}, `null`
+			},
+			errors: []errorWant{{message: "Internal Server Error", code: "INTERNAL_SERVER_ERROR"}},
+		},
+		{
+			name:         "events are disabled",
+			enableEvents: false,
+			setup: func(_ MockRepository) (graphQLRequest, string) {
+				return graphQLRequest{Query: `{ events { name } }`}, `null`
+			},
+			errors: []errorWant{{code: "NOT_IMPLEMENTED"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupGraphQLMock(t, tt.enableEvents)
+
+			req, data := tt.setup(mr)
+
+			var res graphQLResponse
+			statusCode, _ := doRequest(t, api, http.MethodPost, "/api/graphql", req, &res)
+
+			// Assertion
+			assert.Equal(t, http.StatusOK, statusCode)
+			assert.JSONEq(t, data, string(res.Data))
+			if assert.Len(t, res.Errors, len(tt.errors)) {
+				for i, e := range tt.errors {
+					assert.Equal(t, e.code, res.Errors[i].Extensions["code"])
+					if e.message != "" {
+						assert.Equal(t, e.message, res.Errors[i].Message)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGraphQLHandler_Query_BadRequest(t *testing.T) {
+	t.Parallel()
+
+	_, api := setupGraphQLMock(t, true)
+
+	statusCode, _ := doRequest(t, api, http.MethodPost, "/api/graphql", graphQLRequest{}, nil)
+	assert.Equal(t, http.StatusBadRequest, statusCode)
+}
@@ -23,7 +23,7 @@ func setupGroupMock(t *testing.T) (MockRepository, API) {
 	user := mock_repository.NewMockUserRepository(ctrl)
 	group := mock_repository.NewMockGroupRepository(ctrl)
 	mr := MockRepository{user: user, group: group}
-	api := NewAPI(nil, nil, nil, nil, nil, NewGroupHandler(group, user))
+	api := NewAPI(nil, nil, nil, nil, nil, NewGroupHandler(group, user), nil, nil, nil)
 
 	return mr, api
 }
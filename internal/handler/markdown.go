@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+const mimeTextMarkdown = "text/markdown; charset=UTF-8"
+
+// mdEscaper 名前などのユーザー入力がMarkdownの記法として解釈されないようにする
+var mdEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+	">", `\>`,
+	"|", `\|`,
+	"#", `\#`,
+)
+
+func mdEscape(s string) string {
+	return mdEscaper.Replace(s)
+}
+
+// mdURLEscaper リンク先のURLが`)`や`>`でリンクの外に抜け出さないよう、括弧と空白をパーセントエンコードする
+var mdURLEscaper = strings.NewReplacer(
+	" ", "%20",
+	"(", "%28",
+	")", "%29",
+	"<", "%3C",
+	">", "%3E",
+)
+
+// mdURL `[..](url)`や`<url>`に埋め込むURL
+func mdURL(u string) string {
+	return mdURLEscaper.Replace(u)
+}
+
+// mdTableCell 表のセルは1行で表す必要があるため改行を空白に置き換える
+func mdTableCell(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if s == "" {
+		return "-"
+	}
+
+	return mdEscape(s)
+}
+
+func writeMarkdownTable(sb *strings.Builder, header []string, rows [][]string) {
+	sb.WriteString("| " + strings.Join(header, " | ") + " |\n")
+	sb.WriteString("|" + strings.Repeat(" --- |", len(header)) + "\n")
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, v := range row {
+			cells[i] = mdTableCell(v)
+		}
+		sb.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	}
+}
+
+func newUserMarkdown(lang domain.Lang, user *domain.UserDetail, projects []*domain.UserProject, contests []*domain.UserContest) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s\n", mdEscape(user.DisplayNameWithHandle()))
+
+	if bio := strings.TrimSpace(user.Bio); bio != "" {
+		fmt.Fprintf(&sb, "\n%s\n", mdEscape(bio))
+	}
+
+	if len(user.Accounts) > 0 {
+		fmt.Fprintf(&sb, "\n## %s\n\n", lang.Pick("アカウント", "Accounts"))
+		for _, a := range user.Accounts {
+			fmt.Fprintf(&sb, "- %s: [%s](%s)\n", mdEscape(accountTypeLabel(a.Type)), mdEscape(accountUsername(a)), mdURL(a.URL))
+		}
+	}
+
+	if len(projects) > 0 {
+		fmt.Fprintf(&sb, "\n## %s\n\n", lang.Pick("プロジェクト", "Projects"))
+		rows := make([][]string, len(projects))
+		for i, p := range projects {
+			rows[i] = []string{p.Name, durationLabel(lang, p.UserDuration)}
+		}
+		writeMarkdownTable(&sb, []string{lang.Pick("プロジェクト", "Project"), lang.Pick("参加期間", "Membership")}, rows)
+	}
+
+	if len(contests) > 0 {
+		fmt.Fprintf(&sb, "\n## %s\n\n", lang.Pick("コンテスト", "Contests"))
+		rows := make([][]string, 0, len(contests))
+		for _, c := range contests {
+			for _, t := range c.Teams {
+				rows = append(rows, []string{c.Name, c.TimeStart.Format("2006/01/02"), t.Name, t.Result})
+			}
+		}
+		writeMarkdownTable(&sb, []string{lang.Pick("コンテスト", "Contest"), lang.Pick("日付", "Date"), lang.Pick("チーム", "Team"), lang.Pick("結果", "Result")}, rows)
+	}
+
+	return sb.String()
+}
+
+func newProjectMarkdown(lang domain.Lang, project *domain.ProjectDetail) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s\n", mdEscape(project.Name))
+
+	if description := strings.TrimSpace(project.Description); description != "" {
+		fmt.Fprintf(&sb, "\n%s\n", mdEscape(description))
+	}
+
+	sb.WriteString("\n")
+	fmt.Fprintf(&sb, "- %s: %s\n", lang.Pick("期間", "Period"), durationLabel(lang, project.Duration))
+	if project.Link != "" {
+		fmt.Fprintf(&sb, "- %s: <%s>\n", lang.Pick("リンク", "Link"), mdURL(project.Link))
+	}
+	if gh := project.GitHubRepository; gh != nil {
+		fmt.Fprintf(&sb, "- GitHub: [%s/%s](https://github.com/%s/%s) ★%d", mdEscape(gh.Owner), mdEscape(gh.Name), mdURL(gh.Owner), mdURL(gh.Name), gh.Stars)
+		if len(gh.Languages) > 0 {
+			fmt.Fprintf(&sb, " (%s)", mdEscape(strings.Join(gh.Languages, ", ")))
+		}
+		sb.WriteString("\n")
+	}
+
+	// 本文は既にMarkdownで書かれているのでそのまま載せる
+	if body := strings.TrimSpace(project.Body); body != "" {
+		fmt.Fprintf(&sb, "\n## %s\n\n%s\n", lang.Pick("詳細", "Details"), body)
+	}
+
+	if len(project.Members) > 0 {
+		fmt.Fprintf(&sb, "\n## %s\n\n", lang.Pick("メンバー", "Members"))
+		rows := make([][]string, len(project.Members))
+		for i, m := range project.Members {
+			rows[i] = []string{m.User.DisplayNameWithHandle(), durationLabel(lang, m.Duration)}
+		}
+		writeMarkdownTable(&sb, []string{lang.Pick("メンバー", "Member"), lang.Pick("参加期間", "Membership")}, rows)
+	}
+
+	return sb.String()
+}
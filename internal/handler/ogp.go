@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/patrickmn/go-cache"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler/schema"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/ogp"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+const (
+	ogpProviderName    = "traPortfolio"
+	ogpCacheExpiration = 10 * time.Minute
+	ogpMaxBadges       = 4
+)
+
+type OGPHandler struct {
+	user        repository.UserRepository
+	project     repository.ProjectRepository
+	renderer    *ogp.Renderer
+	cache       *cache.Cache
+	frontendURL string
+}
+
+// NewOGPHandler creates a OGPHandler
+// frontendURLが空でない場合、oEmbedではそのオリジンのURLのみを受け付ける
+func NewOGPHandler(user repository.UserRepository, project repository.ProjectRepository, renderer *ogp.Renderer, frontendURL string) *OGPHandler {
+	return &OGPHandler{
+		user:        user,
+		project:     project,
+		renderer:    renderer,
+		cache:       cache.New(ogpCacheExpiration, 2*ogpCacheExpiration),
+		frontendURL: strings.TrimSuffix(frontendURL, "/"),
+	}
+}
+
+// GetUserOgpImage GET /users/:userID/ogp.png
+func (h *OGPHandler) GetUserOgpImage(c echo.Context) error {
+	userID, err := getID(c, keyUserID)
+	if err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	lang := repository.LangFrom(ctx)
+
+	return h.respondImage(c, fmt.Sprintf("users/%s/%d", userID, lang), func() (*ogp.Card, error) {
+		user, err := h.user.GetUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		projects, err := h.user.GetProjects(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		contests, err := h.user.GetContests(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		return newUserCard(user, projects, contests), nil
+	})
+}
+
+// GetProjectOgpImage GET /projects/:projectID/ogp.png
+func (h *OGPHandler) GetProjectOgpImage(c echo.Context) error {
+	projectID, err := getID(c, keyProject)
+	if err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	lang := repository.LangFrom(ctx)
+
+	return h.respondImage(c, fmt.Sprintf("projects/%s/%d", projectID, lang), func() (*ogp.Card, error) {
+		project, err := h.project.GetProject(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+
+		return newProjectCard(lang, project), nil
+	})
+}
+
+// respondImage 同じ内容の画像を何度も描画しないよう、描画した画像をキャッシュする
+func (h *OGPHandler) respondImage(c echo.Context, cacheKey string, newCard func() (*ogp.Card, error)) error {
+	b, found := h.cache.Get(cacheKey)
+	if !found {
+		card, err := newCard()
+		if err != nil {
+			return err
+		}
+
+		b, err = h.renderer.Render(card)
+		if err != nil {
+			return err
+		}
+		h.cache.Set(cacheKey, b, cache.DefaultExpiration)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(ogpCacheExpiration.Seconds())))
+
+	return c.Blob(http.StatusOK, "image/png", b.([]byte))
+}
+
+// GetOEmbed GET /oembed
+func (h *OGPHandler) GetOEmbed(c echo.Context) error {
+	req := schema.GetOEmbedParams{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	if req.Format != nil && *req.Format == schema.XML {
+		return c.NoContent(http.StatusNotImplemented)
+	}
+
+	kind, id, err := h.parsePageURL(req.Url)
+	if err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	lang := repository.LangFrom(ctx)
+	res := schema.OEmbed{
+		Type:            schema.Link,
+		Version:         "1.0",
+		ProviderName:    ogpProviderName,
+		ThumbnailWidth:  ogp.Width,
+		ThumbnailHeight: ogp.Height,
+	}
+	if h.frontendURL != "" {
+		res.ProviderUrl = &h.frontendURL
+	}
+
+	switch kind {
+	case "users":
+		user, err := h.user.GetUser(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		res.Title = user.DisplayNameWithHandle()
+		res.AuthorName = &user.Name
+	case "projects":
+		project, err := h.project.GetProject(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		res.Title = project.Name
+	}
+	res.ThumbnailUrl = ogpImageURL(c, lang, kind, id)
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// parsePageURL フロントエンドの /users/:userID と /projects/:projectID のURLのみを受け付ける
+func (h *OGPHandler) parsePageURL(rawURL string) (kind string, id uuid.UUID, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", uuid.Nil, fmt.Errorf("%w: %s", repository.ErrInvalidArg, err.Error())
+	}
+	if h.frontendURL != "" && u.Scheme+"://"+u.Host != h.frontendURL {
+		return "", uuid.Nil, fmt.Errorf("%w: url is not a page of %s", repository.ErrNotFound, h.frontendURL)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) != 2 || (segments[0] != "users" && segments[0] != "projects") {
+		return "", uuid.Nil, fmt.Errorf("%w: url is not a page of a user or a project", repository.ErrNotFound)
+	}
+
+	id, err = uuid.FromString(segments[1])
+	if err != nil {
+		return "", uuid.Nil, fmt.Errorf("%w: %s", repository.ErrNotFound, err.Error())
+	}
+
+	return segments[0], id, nil
+}
+
+// ogpImageURL oEmbedのリクエストと同じAPIのプレビュー画像のURL
+func ogpImageURL(c echo.Context, lang domain.Lang, kind string, id uuid.UUID) string {
+	base := strings.TrimSuffix(c.Request().URL.Path, "/oembed")
+	u := fmt.Sprintf("%s://%s%s/%s/%s/ogp.png", c.Scheme(), c.Request().Host, base, kind, id)
+	if lang == domain.LangEn {
+		u += "?lang=en"
+	}
+
+	return u
+}
+
+// newUserCard 固定表示しているプロジェクトを優先し、残りはrepositoryが返す新しい順に並べる
+func newUserCard(user *domain.UserDetail, projects []*domain.UserProject, contests []*domain.UserContest) *ogp.Card {
+	items := make([]string, 0, len(projects))
+	featured := make(map[uuid.UUID]struct{}, len(user.Featured))
+	for _, f := range user.Featured {
+		if f.Type == domain.FeaturedItemProject {
+			items = append(items, f.Name)
+			featured[f.ID] = struct{}{}
+		}
+	}
+
+	for _, p := range projects {
+		if _, ok := featured[p.ID]; !ok {
+			items = append(items, p.Name)
+		}
+	}
+
+	badges := make([]string, 0, ogpMaxBadges)
+	for _, c := range contests {
+		for _, t := range c.Teams {
+			if t.Result != "" && len(badges) < ogpMaxBadges {
+				badges = append(badges, fmt.Sprintf("%s: %s", c.Name, t.Result))
+			}
+		}
+	}
+
+	return &ogp.Card{
+		Title:    "@" + user.Name,
+		Subtitle: user.RealName(),
+		Items:    items,
+		Badges:   badges,
+		Footer:   ogpProviderName,
+	}
+}
+
+func newProjectCard(lang domain.Lang, project *domain.ProjectDetail) *ogp.Card {
+	badges := make([]string, len(project.Members))
+	for i, m := range project.Members {
+		badges[i] = "@" + m.User.Name
+	}
+
+	card := &ogp.Card{
+		Title:    project.Name,
+		Subtitle: durationLabel(lang, project.Duration),
+		Badges:   badges,
+		Footer:   ogpProviderName,
+	}
+	if project.Description != "" {
+		card.Items = []string{project.Description}
+	}
+
+	return card
+}
@@ -0,0 +1,334 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image/png"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler/schema"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/ogp"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository/mock_repository"
+)
+
+func setupOGPMock(t *testing.T, frontendURL string) (MockRepository, API) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	user := mock_repository.NewMockUserRepository(ctrl)
+	project := mock_repository.NewMockProjectRepository(ctrl)
+	renderer, err := ogp.NewRenderer(nil)
+	assert.NoError(t, err)
+
+	mr := MockRepository{user: user, project: project}
+	api := NewAPI(nil, nil, nil, nil, nil, nil, NewOGPHandler(user, project, renderer, frontendURL), nil, nil)
+
+	return mr, api
+}
+
+// guestCtx メンバーからのアクセスでも公開されている情報だけを取得していることを確認する
+type guestCtx struct{}
+
+func (guestCtx) Matches(v interface{}) bool {
+	ctx, ok := v.(context.Context)
+	return ok && !repository.IsMember(ctx)
+}
+
+func (guestCtx) String() string {
+	return "is Context without member"
+}
+
+func TestOGPHandler_GetUserOgpImage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) string {
+				user := &domain.UserDetail{User: *domain.NewUser(random.UUID(), "user1", "User One", true)}
+				// 2回目のリクエストではキャッシュした画像を返す
+				mr.user.EXPECT().GetUser(guestCtx{}, user.ID).Return(user, nil).Times(1)
+				mr.user.EXPECT().GetProjects(guestCtx{}, user.ID).Return([]*domain.UserProject{}, nil).Times(1)
+				mr.user.EXPECT().GetContests(guestCtx{}, user.ID).Return([]*domain.UserContest{}, nil).Times(1)
+				return fmt.Sprintf("/api/v1/users/%s/ogp.png", user.ID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			setup: func(mr MockRepository) string {
+				userID := random.UUID()
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(nil, repository.ErrNotFound).Times(2)
+				return fmt.Sprintf("/api/v1/users/%s/ogp.png", userID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: invalid user ID",
+			setup: func(_ MockRepository) string {
+				return fmt.Sprintf("/api/v1/users/%s/ogp.png", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupOGPMock(t, "")
+
+			path := tt.setup(mr)
+
+			for range 2 {
+				statusCode, rec := doRequestWithHeader(t, api, http.MethodGet, path, nil, nil, map[string]string{"X-Forwarded-User": "user1"})
+
+				// Assertion
+				assert.Equal(t, tt.statusCode, statusCode)
+				if statusCode == http.StatusOK {
+					assert.Equal(t, "image/png", rec.Header().Get(echo.HeaderContentType))
+					assert.Equal(t, "public, max-age=600", rec.Header().Get(echo.HeaderCacheControl))
+
+					img, err := png.Decode(bytes.NewReader(rec.Body.Bytes()))
+					assert.NoError(t, err)
+					assert.Equal(t, ogp.Width, img.Bounds().Dx())
+					assert.Equal(t, ogp.Height, img.Bounds().Dy())
+				}
+			}
+		})
+	}
+}
+
+func TestOGPHandler_GetProjectOgpImage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) string {
+				project := &domain.ProjectDetail{
+					Project: domain.Project{
+						ID:       random.UUID(),
+						Name:     "traPortfolio",
+						Duration: domain.NewYearWithSemesterDuration(2022, 0, 2023, 1),
+					},
+					Description: "portfolio of traP members",
+					Members: []*domain.UserWithDuration{
+						{User: *domain.NewUser(random.UUID(), "user1", "", false)},
+					},
+				}
+				mr.project.EXPECT().GetProject(guestCtx{}, project.ID).Return(project, nil)
+				return fmt.Sprintf("/api/v1/projects/%s/ogp.png", project.ID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			setup: func(mr MockRepository) string {
+				projectID := random.UUID()
+				mr.project.EXPECT().GetProject(guestCtx{}, projectID).Return(nil, repository.ErrNotFound)
+				return fmt.Sprintf("/api/v1/projects/%s/ogp.png", projectID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupOGPMock(t, "")
+
+			path := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, path, nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "image/png", rec.Header().Get(echo.HeaderContentType))
+
+				_, err := png.Decode(bytes.NewReader(rec.Body.Bytes()))
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOGPHandler_GetOEmbed(t *testing.T) {
+	t.Parallel()
+
+	const frontendURL = "https://portfolio.example.com"
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (path string, want *schema.OEmbed)
+		statusCode int
+	}{
+		{
+			name: "Success: user",
+			setup: func(mr MockRepository) (string, *schema.OEmbed) {
+				user := &domain.UserDetail{User: *domain.NewUser(random.UUID(), "user1", "User One", true)}
+				mr.user.EXPECT().GetUser(guestCtx{}, user.ID).Return(user, nil)
+
+				path := fmt.Sprintf("/api/v1/oembed?url=%s/users/%s", frontendURL, user.ID)
+				return path, &schema.OEmbed{
+					Type:            schema.Link,
+					Version:         "1.0",
+					Title:           "User One (@user1)",
+					AuthorName:      ptr(t, "user1"),
+					ProviderName:    "traPortfolio",
+					ProviderUrl:     ptr(t, frontendURL),
+					ThumbnailUrl:    fmt.Sprintf("http://example.com/api/v1/users/%s/ogp.png", user.ID),
+					ThumbnailWidth:  ogp.Width,
+					ThumbnailHeight: ogp.Height,
+				}
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Success: project in English",
+			setup: func(mr MockRepository) (string, *schema.OEmbed) {
+				project := &domain.ProjectDetail{Project: domain.Project{ID: random.UUID(), Name: "traPortfolio"}}
+				mr.project.EXPECT().GetProject(guestCtx{}, project.ID).Return(project, nil)
+
+				path := fmt.Sprintf("/api/v1/oembed?url=%s/projects/%s&format=json&maxwidth=600&lang=en", frontendURL, project.ID)
+				return path, &schema.OEmbed{
+					Type:            schema.Link,
+					Version:         "1.0",
+					Title:           "traPortfolio",
+					ProviderName:    "traPortfolio",
+					ProviderUrl:     ptr(t, frontendURL),
+					ThumbnailUrl:    fmt.Sprintf("http://example.com/api/v1/projects/%s/ogp.png?lang=en", project.ID),
+					ThumbnailWidth:  ogp.Width,
+					ThumbnailHeight: ogp.Height,
+				}
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Not Found: other origin",
+			setup: func(_ MockRepository) (string, *schema.OEmbed) {
+				return fmt.Sprintf("/api/v1/oembed?url=https://evil.example.com/users/%s", random.UUID()), nil
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Not Found: not a page of a user or a project",
+			setup: func(_ MockRepository) (string, *schema.OEmbed) {
+				return fmt.Sprintf("/api/v1/oembed?url=%s/contests/%s", frontendURL, random.UUID()), nil
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Not Found: user not exist",
+			setup: func(mr MockRepository) (string, *schema.OEmbed) {
+				userID := random.UUID()
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(nil, repository.ErrNotFound)
+				return fmt.Sprintf("/api/v1/oembed?url=%s/users/%s", frontendURL, userID), nil
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Not Implemented: xml",
+			setup: func(_ MockRepository) (string, *schema.OEmbed) {
+				return fmt.Sprintf("/api/v1/oembed?url=%s/users/%s&format=xml", frontendURL, random.UUID()), nil
+			},
+			statusCode: http.StatusNotImplemented,
+		},
+		{
+			name: "Bad Request: url is missing",
+			setup: func(_ MockRepository) (string, *schema.OEmbed) {
+				return "/api/v1/oembed", nil
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: invalid format",
+			setup: func(_ MockRepository) (string, *schema.OEmbed) {
+				return fmt.Sprintf("/api/v1/oembed?url=%s/users/%s&format=yaml", frontendURL, random.UUID()), nil
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupOGPMock(t, frontendURL+"/")
+
+			path, want := tt.setup(mr)
+
+			res := &schema.OEmbed{}
+			statusCode, _ := doRequest(t, api, http.MethodGet, path, nil, res)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if want != nil {
+				assert.Equal(t, want, res)
+			}
+		})
+	}
+}
+
+func Test_newUserCard(t *testing.T) {
+	t.Parallel()
+
+	featuredID := random.UUID()
+	user := &domain.UserDetail{
+		User: *domain.NewUser(random.UUID(), "user1", "User One", false),
+		Featured: []*domain.UserFeaturedItem{
+			{Type: domain.FeaturedItemContestTeam, ID: random.UUID(), Name: "ISUCON"},
+			{Type: domain.FeaturedItemProject, ID: featuredID, Name: "featured"},
+		},
+	}
+	// repositoryは新しい順に返す
+	projects := []*domain.UserProject{
+		{ID: random.UUID(), Name: "new", Duration: domain.NewYearWithSemesterDuration(2022, 1, 2023, 0)},
+		{ID: random.UUID(), Name: "old", Duration: domain.NewYearWithSemesterDuration(2020, 0, 2020, 1)},
+		{ID: featuredID, Name: "featured", Duration: domain.NewYearWithSemesterDuration(2019, 0, 2019, 1)},
+	}
+	contests := []*domain.UserContest{
+		{
+			Name:      "ISUCON 2022",
+			TimeStart: time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC),
+			Teams: []*domain.ContestTeamWithoutMembers{
+				{Name: "team2", Result: "1st"},
+				{Name: "team3"},
+			},
+		},
+		{
+			Name:      "ICPC 2021",
+			TimeStart: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC),
+			Teams:     []*domain.ContestTeamWithoutMembers{{Name: "team1", Result: "3rd"}},
+		},
+	}
+
+	want := &ogp.Card{
+		Title:    "@user1",
+		Subtitle: "",
+		Items:    []string{"featured", "new", "old"},
+		Badges:   []string{"ISUCON 2022: 1st", "ICPC 2021: 3rd"},
+		Footer:   "traPortfolio",
+	}
+	assert.Equal(t, want, newUserCard(user, projects, contests))
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/labstack/echo/v4"
@@ -30,12 +31,21 @@ func (h *ProjectHandler) GetProjects(c echo.Context) error {
 	args := repository.GetProjectsArgs{
 		Limit: optional.FromPtr((*int)(req.Limit)),
 	}
+	if req.Ids != nil {
+		args.IDs = uniqueIDs(*req.Ids)
+	}
 
 	projects, err := h.project.GetProjects(ctx, &args)
 	if err != nil {
 		return err
 	}
 
+	if args.IDs != nil {
+		var missing []uuid.UUID
+		projects, missing = sortByIDs(args.IDs, projects, projectIDOf)
+		setMissingIDsHeader(c, missing)
+	}
+
 	res := make([]schema.Project, len(projects))
 	for i, v := range projects {
 		res[i] = newProject(v.ID, v.Name, schema.ConvertDuration(v.Duration))
@@ -44,6 +54,57 @@ func (h *ProjectHandler) GetProjects(c echo.Context) error {
 	return c.JSON(http.StatusOK, res)
 }
 
+// BatchGetProjects POST /projects/batch
+func (h *ProjectHandler) BatchGetProjects(c echo.Context) error {
+	req := schema.BatchRequest{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	ids := uniqueIDs(req.Ids)
+	projects, err := h.project.GetProjects(ctx, &repository.GetProjectsArgs{IDs: ids})
+	if err != nil {
+		return err
+	}
+
+	projects, missing := sortByIDs(ids, projects, projectIDOf)
+	res := schema.ProjectsBatch{
+		Projects: make([]schema.Project, len(projects)),
+		Missing:  missing,
+	}
+	for i, v := range projects {
+		res.Projects[i] = newProject(v.ID, v.Name, schema.ConvertDuration(v.Duration))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func projectIDOf(p *domain.Project) uuid.UUID {
+	return p.ID
+}
+
+// GetProjectsFeed GET /feeds/projects.atom
+func (h *ProjectHandler) GetProjectsFeed(c echo.Context) error {
+	req := schema.GetProjectsFeedParams{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	projects, err := h.project.GetFeedProjects(ctx, optional.FromPtr((*int)(req.Limit)).ValueOr(feedDefaultLimit))
+	if err != nil {
+		return err
+	}
+
+	b, err := newProjectsFeed(repository.LangFrom(ctx), feedURL(c), projects).Encode(time.Now())
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, mimeApplicationAtom, b)
+}
+
 // GetProject GET /projects/:projectID
 func (h *ProjectHandler) GetProject(c echo.Context) error {
 	projectID, err := getID(c, keyProject)
@@ -65,14 +126,39 @@ func (h *ProjectHandler) GetProject(c echo.Context) error {
 		)
 	}
 
-	return c.JSON(http.StatusOK, newProjectDetail(
+	body, err := schema.ConvertMarkdown(project.Body)
+	if err != nil {
+		return err
+	}
+
+	return jsonWithETag(c, project.Version, newProjectDetail(
 		newProject(project.ID, project.Name, schema.ConvertDuration(project.Duration)),
 		project.Description,
+		body,
 		project.Link,
 		members,
+		project.Visibility,
+		project.Publish,
+		project.GitHubRepository,
 	))
 }
 
+// GetProjectMarkdown GET /projects/:projectID/export.md
+func (h *ProjectHandler) GetProjectMarkdown(c echo.Context) error {
+	projectID, err := getID(c, keyProject)
+	if err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	project, err := h.project.GetProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, mimeTextMarkdown, []byte(newProjectMarkdown(repository.LangFrom(ctx), project)))
+}
+
 // CreateProject POST /projects
 func (h *ProjectHandler) CreateProject(c echo.Context) error {
 	req := schema.CreateProjectRequest{}
@@ -82,10 +168,16 @@ func (h *ProjectHandler) CreateProject(c echo.Context) error {
 
 	createReq := repository.CreateProjectArgs{
 		Name:          req.Name,
+		NameEn:        optional.FromPtr(req.NameEn),
 		Description:   req.Description,
+		DescriptionEn: optional.FromPtr(req.DescriptionEn),
+		Body:          optional.FromPtr(req.Body),
 		Link:          optional.FromPtr(req.Link),
 		SinceYear:     req.Duration.Since.Year,
 		SinceSemester: int(req.Duration.Since.Semester),
+		Visibility:    optional.FromPtr((*domain.Visibility)(req.Visibility)),
+		Draft:         optional.FromPtr(req.Draft),
+		PublishAt:     optional.FromPtr(req.PublishAt),
 	}
 
 	if req.Duration.Until != nil {
@@ -123,9 +215,13 @@ func (h *ProjectHandler) EditProject(c echo.Context) error {
 	}
 
 	patchReq := repository.UpdateProjectArgs{
-		Name:        optional.FromPtr(req.Name),
-		Description: optional.FromPtr(req.Description),
-		Link:        optional.FromPtr(req.Link),
+		Name:          optional.FromPtr(req.Name),
+		NameEn:        optional.FromPtr(req.NameEn),
+		Description:   optional.FromPtr(req.Description),
+		DescriptionEn: optional.FromPtr(req.DescriptionEn),
+		Body:          optional.FromPtr(req.Body),
+		Link:          optional.FromPtr(req.Link),
+		Visibility:    optional.FromPtr((*domain.Visibility)(req.Visibility)),
 	}
 
 	if d := req.Duration; d != nil {
@@ -142,7 +238,7 @@ func (h *ProjectHandler) EditProject(c echo.Context) error {
 		}
 	}
 
-	ctx := c.Request().Context()
+	ctx := ifMatchContext(c)
 	{
 		old, err := h.project.GetProject(ctx, projectID)
 		if err != nil {
@@ -196,6 +292,84 @@ func (h *ProjectHandler) DeleteProject(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// PublishProject POST /projects/:projectID/publish
+func (h *ProjectHandler) PublishProject(c echo.Context) error {
+	projectID, err := getID(c, keyProject)
+	if err != nil {
+		return err
+	}
+
+	req := schema.PublishRequest{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	err = h.project.PublishProject(ctx, projectID, optional.FromPtr(req.PublishAt))
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetProjectRevisions GET /projects/:projectID/revisions
+func (h *ProjectHandler) GetProjectRevisions(c echo.Context) error {
+	projectID, err := getID(c, keyProject)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	revisions, err := h.project.GetProjectRevisions(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newRevisions(revisions))
+}
+
+// GetProjectRevisionDiff GET /projects/:projectID/revisions/diff
+func (h *ProjectHandler) GetProjectRevisionDiff(c echo.Context) error {
+	projectID, err := getID(c, keyProject)
+	if err != nil {
+		return err
+	}
+
+	req := schema.GetProjectRevisionDiffParams{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	diffs, err := h.project.GetProjectRevisionDiff(ctx, projectID, req.From, req.To)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newRevisionFieldDiffs(diffs))
+}
+
+// RestoreProjectRevision POST /projects/:projectID/revisions/:revision/restore
+func (h *ProjectHandler) RestoreProjectRevision(c echo.Context) error {
+	projectID, err := getID(c, keyProject)
+	if err != nil {
+		return err
+	}
+
+	revision, err := getRevision(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	if err := h.project.RestoreProjectRevision(ctx, projectID, revision); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
 // GetProjectMembers GET /projects/:projectID/members
 func (h *ProjectHandler) GetProjectMembers(c echo.Context) error {
 	projectID, err := getID(c, keyProject)
@@ -203,21 +377,22 @@ func (h *ProjectHandler) GetProjectMembers(c echo.Context) error {
 		return err
 	}
 
+	// ETagにプロジェクトの版番号を使うため、メンバーを含むプロジェクトの詳細情報を取得する
 	ctx := c.Request().Context()
-	members, err := h.project.GetProjectMembers(ctx, projectID)
+	project, err := h.project.GetProject(ctx, projectID)
 	if err != nil {
 		return err
 	}
 
-	res := make([]schema.ProjectMember, len(members))
-	for i, v := range members {
+	res := make([]schema.ProjectMember, len(project.Members))
+	for i, v := range project.Members {
 		res[i] = newProjectMember(
 			newUser(v.User.ID, v.User.Name, v.User.RealName()),
 			schema.ConvertDuration(v.Duration),
 		)
 	}
 
-	return c.JSON(http.StatusOK, res)
+	return jsonWithETag(c, project.Version, res)
 }
 
 // EditProjectMembers POST /projects/:projectID/members
@@ -261,7 +436,7 @@ func (h *ProjectHandler) EditProjectMembers(c echo.Context) error {
 		createMap[m.UserID] = struct{}{}
 	}
 
-	ctx := c.Request().Context()
+	ctx := ifMatchContext(c)
 	err = h.project.EditProjectMembers(ctx, projectID, createReq)
 	if err != nil {
 		return err
@@ -278,14 +453,44 @@ func newProject(id uuid.UUID, name string, duration schema.YearWithSemesterDurat
 	}
 }
 
-func newProjectDetail(project schema.Project, description string, link string, members []schema.ProjectMember) schema.ProjectDetail {
+func newProjectDetail(project schema.Project, description string, body schema.Markdown, link string, members []schema.ProjectMember, visibility domain.Visibility, publish domain.PublishState, gh *domain.GitHubRepository) schema.ProjectDetail {
+	var publishAt *time.Time
+	if t, ok := publish.PublishAt.V(); ok {
+		publishAt = &t
+	}
+
+	var githubRepository *schema.GitHubRepository
+	if gh != nil {
+		r := newGitHubRepository(gh)
+		githubRepository = &r
+	}
+
 	return schema.ProjectDetail{
+		Body:        body,
 		Description: description,
+		Draft:       !publish.IsPublished(time.Now()),
 		Duration:    project.Duration,
 		Link:        link,
 		Id:          project.Id,
 		Members:     members,
 		Name:        project.Name,
+		PublishAt:   publishAt,
+		Visibility:  schema.Visibility(visibility),
+
+		GithubRepository: githubRepository,
+	}
+}
+
+func newGitHubRepository(gh *domain.GitHubRepository) schema.GitHubRepository {
+	return schema.GitHubRepository{
+		Owner:       gh.Owner,
+		Name:        gh.Name,
+		Description: gh.Description,
+		Languages:   gh.Languages,
+		Stars:       gh.Stars,
+		License:     gh.License,
+		PushedAt:    gh.PushedAt,
+		FetchedAt:   gh.FetchedAt,
 	}
 }
 
@@ -1,16 +1,20 @@
 package handler
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/traPtitech/traPortfolio/internal/handler/schema"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
 	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
 
 	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
@@ -28,7 +32,7 @@ func setupProjectMock(t *testing.T) (MockRepository, API) {
 	ctrl := gomock.NewController(t)
 	project := mock_repository.NewMockProjectRepository(ctrl)
 	mr := MockRepository{project: project}
-	api := NewAPI(nil, nil, NewProjectHandler(project), nil, nil, nil)
+	api := NewAPI(nil, nil, NewProjectHandler(project), nil, nil, nil, nil, nil, nil)
 
 	return mr, api
 }
@@ -85,126 +89,908 @@ func TestProjectHandler_GetProjects(t *testing.T) {
 			},
 			statusCode: http.StatusOK,
 		},
+		{
+			name: "Bad Request: ids with limit",
+			setup: func(_ MockRepository) ([]*schema.Project, string) {
+				return nil, fmt.Sprintf("/api/v1/projects?ids=%s&limit=1", random.UUID())
+			},
+			statusCode: http.StatusBadRequest,
+		},
 		{
 			name: "Internal Error",
 			setup: func(mr MockRepository) ([]*schema.Project, string) {
 				mr.project.EXPECT().GetProjects(anyCtx{}, &repository.GetProjectsArgs{}).Return(nil, errInternal)
 				return nil, "/api/v1/projects"
 			},
-			statusCode: http.StatusInternalServerError,
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			r, api := setupProjectMock(t)
+
+			expectedHres, path := tt.setup(r)
+
+			hres := []*schema.Project(nil)
+			statusCode, _ := doRequest(t, api, http.MethodGet, path, nil, &hres)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, expectedHres, hres)
+		})
+	}
+}
+
+func TestProjectHandler_BatchGetProjects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (reqBody *schema.BatchRequest, hres *schema.ProjectsBatch)
+		statusCode int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (*schema.BatchRequest, *schema.ProjectsBatch) {
+				project1 := &domain.Project{ID: random.UUID(), Name: random.AlphaNumeric(), Duration: random.Duration()}
+				project2 := &domain.Project{ID: random.UUID(), Name: random.AlphaNumeric(), Duration: random.Duration()}
+				missing := random.UUID()
+				ids := []uuid.UUID{project2.ID, missing, project1.ID}
+
+				// 非公開のプロジェクトはリポジトリが返さないため、見つからなかったものとして扱う
+				mr.project.EXPECT().GetProjects(anyCtx{}, &repository.GetProjectsArgs{IDs: ids}).Return([]*domain.Project{project1, project2}, nil)
+
+				return &schema.BatchRequest{Ids: append(ids, project2.ID)}, &schema.ProjectsBatch{
+					Projects: []schema.Project{
+						{Id: project2.ID, Name: project2.Name, Duration: schema.ConvertDuration(project2.Duration)},
+						{Id: project1.ID, Name: project1.Name, Duration: schema.ConvertDuration(project1.Duration)},
+					},
+					Missing: []uuid.UUID{missing},
+				}
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "invalid id",
+			setup: func(_ MockRepository) (*schema.BatchRequest, *schema.ProjectsBatch) {
+				return &schema.BatchRequest{Ids: []uuid.UUID{uuid.Nil}}, nil
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "too many ids",
+			setup: func(_ MockRepository) (*schema.BatchRequest, *schema.ProjectsBatch) {
+				ids := make([]uuid.UUID, 1001)
+				for i := range ids {
+					ids[i] = random.UUID()
+				}
+
+				return &schema.BatchRequest{Ids: ids}, nil
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupProjectMock(t)
+
+			reqBody, hres := tt.setup(mr)
+
+			var resBody *schema.ProjectsBatch
+			statusCode, _ := doRequest(t, api, http.MethodPost, "/api/v1/projects/batch", reqBody, &resBody)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, hres, resBody)
+		})
+	}
+}
+
+func TestProjectHandler_GetProjectsFeed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (contains []string, path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) (contains []string, path string) {
+				project := &domain.FeedProject{
+					ID:          random.UUID(),
+					Name:        "traPortfolio",
+					Description: "部員のポートフォリオ",
+					CreatedAt:   time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:   time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC),
+				}
+
+				mr.project.EXPECT().GetFeedProjects(guestCtx{}, 20).Return([]*domain.FeedProject{project}, nil)
+
+				contains = []string{
+					`<feed xmlns="http://www.w3.org/2005/Atom">`,
+					"<id>http://example.com/api/v1/feeds/projects.atom</id>",
+					"<title>traP 新着プロジェクト</title>",
+					"<updated>2022-05-01T00:00:00Z</updated>",
+					fmt.Sprintf("<id>urn:uuid:%s</id>", project.ID),
+					"<title>traPortfolio</title>",
+					"<published>2022-04-01T00:00:00Z</published>",
+					`<content type="text">部員のポートフォリオ</content>`,
+				}
+				return contains, "/api/v1/feeds/projects.atom"
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Success: with limit",
+			setup: func(mr MockRepository) (contains []string, path string) {
+				mr.project.EXPECT().GetFeedProjects(guestCtx{}, 5).Return([]*domain.FeedProject{}, nil)
+				return []string{"<title>traP New Projects</title>"}, "/api/v1/feeds/projects.atom?limit=5&lang=en"
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Bad Request: invalid limit",
+			setup: func(_ MockRepository) (contains []string, path string) {
+				return nil, "/api/v1/feeds/projects.atom?limit=0"
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: too large limit",
+			setup: func(_ MockRepository) (contains []string, path string) {
+				return nil, "/api/v1/feeds/projects.atom?limit=101"
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Internal Error",
+			setup: func(mr MockRepository) (contains []string, path string) {
+				mr.project.EXPECT().GetFeedProjects(guestCtx{}, 20).Return(nil, errInternal)
+				return nil, "/api/v1/feeds/projects.atom"
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupProjectMock(t)
+
+			contains, path := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, path, nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "application/atom+xml; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				for _, v := range contains {
+					assert.Contains(t, rec.Body.String(), v)
+				}
+			}
+		})
+	}
+}
+
+func TestProjectHandler_GetProject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (*schema.ProjectDetail, string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) (*schema.ProjectDetail, string) {
+				duration := random.Duration()
+				projectID := random.UUID()
+				repo := domain.ProjectDetail{
+					Project: domain.Project{
+						ID:       projectID,
+						Name:     random.AlphaNumeric(),
+						Duration: duration,
+					},
+					Description: random.AlphaNumeric(),
+					Link:        random.RandURLString(),
+					Members: []*domain.UserWithDuration{
+						{
+							User:     *domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), random.Bool()),
+							Duration: random.Duration(),
+						},
+					},
+				}
+
+				var members []schema.ProjectMember
+				for _, v := range repo.Members {
+					members = append(members, schema.ProjectMember{
+						Duration: schema.ConvertDuration(v.Duration),
+						Id:       v.User.ID,
+						Name:     v.User.Name,
+						RealName: v.User.RealName(),
+					})
+				}
+				reqBody := &schema.ProjectDetail{
+					Description: repo.Description,
+					Duration:    schema.ConvertDuration(repo.Duration),
+					Id:          repo.ID,
+					Link:        repo.Link,
+					Members:     members,
+					Name:        repo.Name,
+				}
+
+				mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(&repo, nil)
+				return reqBody, fmt.Sprintf("/api/v1/projects/%s", projectID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Success: with markdown body",
+			setup: func(mr MockRepository) (*schema.ProjectDetail, string) {
+				projectID := random.UUID()
+				repo := domain.ProjectDetail{
+					Project: domain.Project{
+						ID:       projectID,
+						Name:     random.AlphaNumeric(),
+						Duration: random.Duration(),
+					},
+					Description: random.AlphaNumeric(),
+					Body:        "# title\n\n<script>alert(1)</script>",
+					Link:        random.RandURLString(),
+					Members:     []*domain.UserWithDuration{},
+				}
+
+				reqBody := &schema.ProjectDetail{
+					Body: schema.Markdown{
+						Source: repo.Body,
+						Html:   "<h1>title</h1>\n\n",
+					},
+					Description: repo.Description,
+					Duration:    schema.ConvertDuration(repo.Duration),
+					Id:          repo.ID,
+					Link:        repo.Link,
+					Members:     []schema.ProjectMember{},
+					Name:        repo.Name,
+				}
+
+				mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(&repo, nil)
+				return reqBody, fmt.Sprintf("/api/v1/projects/%s", projectID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Success: with github repository",
+			setup: func(mr MockRepository) (*schema.ProjectDetail, string) {
+				projectID := random.UUID()
+				repo := domain.ProjectDetail{
+					Project: domain.Project{
+						ID:       projectID,
+						Name:     random.AlphaNumeric(),
+						Duration: random.Duration(),
+					},
+					Description: random.AlphaNumeric(),
+					Link:        "https://github.com/traPtitech/traPortfolio",
+					Members:     []*domain.UserWithDuration{},
+					GitHubRepository: &domain.GitHubRepository{
+						Owner:       "traPtitech",
+						Name:        "traPortfolio",
+						Description: random.AlphaNumeric(),
+						Languages:   []string{"Go", "Shell"},
+						Stars:       10,
+						License:     "MIT",
+						PushedAt:    random.Time(),
+						FetchedAt:   random.Time(),
+					},
+				}
+
+				reqBody := &schema.ProjectDetail{
+					Description: repo.Description,
+					Duration:    schema.ConvertDuration(repo.Duration),
+					Id:          repo.ID,
+					Link:        repo.Link,
+					Members:     []schema.ProjectMember{},
+					Name:        repo.Name,
+					GithubRepository: &schema.GitHubRepository{
+						Owner:       repo.GitHubRepository.Owner,
+						Name:        repo.GitHubRepository.Name,
+						Description: repo.GitHubRepository.Description,
+						Languages:   repo.GitHubRepository.Languages,
+						Stars:       repo.GitHubRepository.Stars,
+						License:     repo.GitHubRepository.License,
+						PushedAt:    repo.GitHubRepository.PushedAt,
+						FetchedAt:   repo.GitHubRepository.FetchedAt,
+					},
+				}
+
+				mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(&repo, nil)
+				return reqBody, fmt.Sprintf("/api/v1/projects/%s", projectID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Bad Request: Validate error: invalid projectID",
+			setup: func(_ MockRepository) (*schema.ProjectDetail, string) {
+				return nil, fmt.Sprintf("/api/v1/projects/%s", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Internal Error",
+			setup: func(mr MockRepository) (*schema.ProjectDetail, string) {
+				projectID := random.UUID()
+				mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(nil, errInternal)
+				return nil, fmt.Sprintf("/api/v1/projects/%s", projectID)
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "Validation Error",
+			setup: func(_ MockRepository) (*schema.ProjectDetail, string) {
+				projectID := random.AlphaNumericN(36)
+				return nil, fmt.Sprintf("/api/v1/projects/%s", projectID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found Error",
+			setup: func(mr MockRepository) (*schema.ProjectDetail, string) {
+				projectID := random.UUID()
+				mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(nil, repository.ErrNotFound)
+				return nil, fmt.Sprintf("/api/v1/projects/%s", projectID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			r, api := setupProjectMock(t)
+
+			expectedHres, path := tt.setup(r)
+
+			var hres *schema.ProjectDetail
+			statusCode, _ := doRequest(t, api, http.MethodGet, path, nil, &hres)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, expectedHres, hres)
+		})
+	}
+}
+
+func TestProjectHandler_GetProjectMarkdown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (want string, path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) (want string, path string) {
+				projectID := random.UUID()
+				repo := domain.ProjectDetail{
+					Project: domain.Project{
+						ID:       projectID,
+						Name:     "traPortfolio",
+						Duration: domain.NewYearWithSemesterDuration(2021, 0, 0, 0),
+					},
+					Description: "部員のポートフォリオ",
+					Body:        "### 機能\n\n- プロフィール",
+					Link:        "https://github.com/traPtitech/traPortfolio",
+					Members: []*domain.UserWithDuration{
+						{
+							User:     *domain.NewUser(random.UUID(), "user1", "User One", true),
+							Duration: domain.NewYearWithSemesterDuration(2021, 0, 2022, 1),
+						},
+						{
+							User:     *domain.NewUser(random.UUID(), "user2", "User Two", false),
+							Duration: domain.NewYearWithSemesterDuration(2022, 1, 0, 0),
+						},
+					},
+					GitHubRepository: &domain.GitHubRepository{
+						Owner:     "traPtitech",
+						Name:      "traPortfolio",
+						Languages: []string{"Go", "TypeScript"},
+						Stars:     30,
+					},
+				}
+
+				mr.project.EXPECT().GetProject(guestCtx{}, projectID).Return(&repo, nil)
+
+				want = `# traPortfolio
+
+部員のポートフォリオ
+
+- 期間: 2021年度前期 〜 現在
+- リンク: <https://github.com/traPtitech/traPortfolio>
+- GitHub: [traPtitech/traPortfolio](https://github.com/traPtitech/traPortfolio) ★30 (Go, TypeScript)
+
+## 詳細
+
+### 機能
+
+- プロフィール
+
+## メンバー
+
+| メンバー | 参加期間 |
+| --- | --- |
+| User One (@user1) | 2021年度前期 〜 2022年度後期 |
+| @user2 | 2022年度後期 〜 現在 |
+`
+				return want, fmt.Sprintf("/api/v1/projects/%s/export.md", projectID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Success: without optional fields",
+			setup: func(mr MockRepository) (want string, path string) {
+				projectID := random.UUID()
+				repo := domain.ProjectDetail{
+					Project: domain.Project{
+						ID:       projectID,
+						Name:     "project1",
+						Duration: domain.NewYearWithSemesterDuration(2021, 0, 2021, 1),
+					},
+					Description: "description",
+					Members:     []*domain.UserWithDuration{},
+				}
+
+				mr.project.EXPECT().GetProject(guestCtx{}, projectID).Return(&repo, nil)
+
+				want = `# project1
+
+description
+
+- Period: Spring 2021 - Fall 2021
+`
+				return want, fmt.Sprintf("/api/v1/projects/%s/export.md?lang=en", projectID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Success: link with brackets",
+			setup: func(mr MockRepository) (want string, path string) {
+				projectID := random.UUID()
+				repo := domain.ProjectDetail{
+					Project: domain.Project{
+						ID:       projectID,
+						Name:     "project1",
+						Duration: domain.NewYearWithSemesterDuration(2021, 0, 2021, 1),
+					},
+					Link:    "https://example.com/a (b)><script>",
+					Members: []*domain.UserWithDuration{},
+				}
+
+				mr.project.EXPECT().GetProject(guestCtx{}, projectID).Return(&repo, nil)
+
+				want = `# project1
+
+- 期間: 2021年度前期 〜 2021年度後期
+- リンク: <https://example.com/a%20%28b%29%3E%3Cscript%3E>
+`
+				return want, fmt.Sprintf("/api/v1/projects/%s/export.md", projectID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			setup: func(mr MockRepository) (want string, path string) {
+				projectID := random.UUID()
+
+				mr.project.EXPECT().GetProject(guestCtx{}, projectID).Return(nil, repository.ErrNotFound)
+				return "", fmt.Sprintf("/api/v1/projects/%s/export.md", projectID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: invalid projectID",
+			setup: func(_ MockRepository) (want string, path string) {
+				return "", fmt.Sprintf("/api/v1/projects/%s/export.md", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupProjectMock(t)
+
+			want, path := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, path, nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "text/markdown; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestProjectHandler_CreateProject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (reqBody *schema.CreateProjectRequest, expectedResBody schema.Project, path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) (reqBody *schema.CreateProjectRequest, expectedResBody schema.Project, path string) {
+				duration := random.Duration()
+				reqBody = makeCreateProjectRequest(
+					t,
+					random.AlphaNumeric(),
+					schema.ConvertDuration(duration).Since,
+					schema.ConvertDuration(duration).Until,
+					random.AlphaNumeric(),
+					random.RandURLString(),
+				)
+				args := repository.CreateProjectArgs{
+					Name:          reqBody.Name,
+					Description:   reqBody.Description,
+					Link:          optional.FromPtr(reqBody.Link),
+					SinceYear:     reqBody.Duration.Since.Year,
+					SinceSemester: int(reqBody.Duration.Since.Semester),
+					UntilYear:     reqBody.Duration.Until.Year,
+					UntilSemester: int(reqBody.Duration.Until.Semester),
+				}
+				want := domain.ProjectDetail{
+					Project: domain.Project{
+						ID:   random.UUID(),
+						Name: args.Name,
+						Duration: domain.NewYearWithSemesterDuration(
+							args.SinceYear,
+							args.SinceSemester,
+							args.UntilYear,
+							args.UntilSemester,
+						),
+					},
+					Description: args.Description,
+					Link:        args.Link.ValueOrZero(),
+					Members:     nil,
+				}
+				expectedResBody = schema.Project{
+					Duration: schema.ConvertDuration(want.Duration),
+					Id:       want.ID,
+					Name:     want.Name,
+				}
+				mr.project.EXPECT().CreateProject(anyCtx{}, &args).Return(&want, nil)
+				return reqBody, expectedResBody, "/api/v1/projects"
+			},
+			statusCode: http.StatusCreated,
+		},
+		{
+			name: "Success: private",
+			setup: func(mr MockRepository) (reqBody *schema.CreateProjectRequest, expectedResBody schema.Project, path string) {
+				duration := random.Duration()
+				reqBody = makeCreateProjectRequest(
+					t,
+					random.AlphaNumeric(),
+					schema.ConvertDuration(duration).Since,
+					schema.ConvertDuration(duration).Until,
+					random.AlphaNumeric(),
+					random.RandURLString(),
+				)
+				visibility := schema.Visibility(domain.VisibilityPrivate)
+				reqBody.Visibility = &visibility
+				args := repository.CreateProjectArgs{
+					Name:          reqBody.Name,
+					Description:   reqBody.Description,
+					Link:          optional.FromPtr(reqBody.Link),
+					SinceYear:     reqBody.Duration.Since.Year,
+					SinceSemester: int(reqBody.Duration.Since.Semester),
+					UntilYear:     reqBody.Duration.Until.Year,
+					UntilSemester: int(reqBody.Duration.Until.Semester),
+					Visibility:    optional.From(domain.VisibilityPrivate),
+				}
+				want := domain.ProjectDetail{
+					Project: domain.Project{
+						ID:   random.UUID(),
+						Name: args.Name,
+						Duration: domain.NewYearWithSemesterDuration(
+							args.SinceYear,
+							args.SinceSemester,
+							args.UntilYear,
+							args.UntilSemester,
+						),
+						Visibility: domain.VisibilityPrivate,
+					},
+					Description: args.Description,
+					Link:        args.Link.ValueOrZero(),
+					Members:     nil,
+				}
+				expectedResBody = schema.Project{
+					Duration: schema.ConvertDuration(want.Duration),
+					Id:       want.ID,
+					Name:     want.Name,
+				}
+				mr.project.EXPECT().CreateProject(anyCtx{}, &args).Return(&want, nil)
+				return reqBody, expectedResBody, "/api/v1/projects"
+			},
+			statusCode: http.StatusCreated,
+		},
+		{
+			name: "BadRequest: invalid visibility",
+			setup: func(_ MockRepository) (reqBody *schema.CreateProjectRequest, expectedResBody schema.Project, path string) {
+				duration := random.Duration()
+				reqBody = makeCreateProjectRequest(
+					t,
+					random.AlphaNumeric(),
+					schema.ConvertDuration(duration).Since,
+					schema.ConvertDuration(duration).Until,
+					random.AlphaNumeric(),
+					random.RandURLString(),
+				)
+				visibility := schema.Visibility(domain.VisibilityLimit)
+				reqBody.Visibility = &visibility
+				return reqBody, schema.Project{}, "/api/v1/projects"
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Success: scheduled draft",
+			setup: func(mr MockRepository) (reqBody *schema.CreateProjectRequest, expectedResBody schema.Project, path string) {
+				duration := random.Duration()
+				reqBody = makeCreateProjectRequest(
+					t,
+					random.AlphaNumeric(),
+					schema.ConvertDuration(duration).Since,
+					schema.ConvertDuration(duration).Until,
+					random.AlphaNumeric(),
+					random.RandURLString(),
+				)
+				draft := true
+				publishAt := time.Date(2100, 4, 1, 0, 0, 0, 0, time.UTC)
+				reqBody.Draft = &draft
+				reqBody.PublishAt = &publishAt
+				args := repository.CreateProjectArgs{
+					Name:          reqBody.Name,
+					Description:   reqBody.Description,
+					Link:          optional.FromPtr(reqBody.Link),
+					SinceYear:     reqBody.Duration.Since.Year,
+					SinceSemester: int(reqBody.Duration.Since.Semester),
+					UntilYear:     reqBody.Duration.Until.Year,
+					UntilSemester: int(reqBody.Duration.Until.Semester),
+					Draft:         optional.From(true),
+					PublishAt:     optional.From(publishAt),
+				}
+				want := domain.ProjectDetail{
+					Project: domain.Project{
+						ID:   random.UUID(),
+						Name: args.Name,
+						Duration: domain.NewYearWithSemesterDuration(
+							args.SinceYear,
+							args.SinceSemester,
+							args.UntilYear,
+							args.UntilSemester,
+						),
+						Publish: domain.PublishState{Draft: true, PublishAt: args.PublishAt},
+					},
+					Description: args.Description,
+					Link:        args.Link.ValueOrZero(),
+					Members:     nil,
+				}
+				expectedResBody = schema.Project{
+					Duration: schema.ConvertDuration(want.Duration),
+					Id:       want.ID,
+					Name:     want.Name,
+				}
+				mr.project.EXPECT().CreateProject(anyCtx{}, &args).Return(&want, nil)
+				return reqBody, expectedResBody, "/api/v1/projects"
+			},
+			statusCode: http.StatusCreated,
+		},
+		{
+			name: "BadRequest: publishAt without draft",
+			setup: func(_ MockRepository) (reqBody *schema.CreateProjectRequest, expectedResBody schema.Project, path string) {
+				duration := random.Duration()
+				reqBody = makeCreateProjectRequest(
+					t,
+					random.AlphaNumeric(),
+					schema.ConvertDuration(duration).Since,
+					schema.ConvertDuration(duration).Until,
+					random.AlphaNumeric(),
+					random.RandURLString(),
+				)
+				publishAt := time.Date(2100, 4, 1, 0, 0, 0, 0, time.UTC)
+				reqBody.PublishAt = &publishAt
+				return reqBody, schema.Project{}, "/api/v1/projects"
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			r, api := setupProjectMock(t)
+
+			reqBody, res, path := tt.setup(r)
+
+			var resBody schema.Project
+			statusCode, _ := doRequest(t, api, http.MethodPost, path, reqBody, &resBody)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, resBody, res)
+		})
+	}
+}
+
+func TestProjectHandler_DeleteProject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) (path string) {
+				projectID := random.UUID()
+				mr.project.EXPECT().DeleteProject(anyCtx{}, projectID).Return(nil)
+				return fmt.Sprintf("/api/v1/projects/%s", projectID)
+			},
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name: "Bad Request: Invalid Project ID",
+			setup: func(mr MockRepository) (path string) {
+				return fmt.Sprintf("/api/v1/projects/%s", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			r, api := setupProjectMock(t)
+			s, api := setupProjectMock(t)
 
-			expectedHres, path := tt.setup(r)
+			path := tt.setup(s)
 
-			hres := []*schema.Project(nil)
-			statusCode, _ := doRequest(t, api, http.MethodGet, path, nil, &hres)
+			statusCode, _ := doRequest(t, api, http.MethodDelete, path, nil, nil)
 
 			// Assertion
 			assert.Equal(t, tt.statusCode, statusCode)
-			assert.Equal(t, expectedHres, hres)
 		})
 	}
 }
 
-func TestProjectHandler_GetProject(t *testing.T) {
+func TestProjectHandler_PublishProject(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name       string
-		setup      func(mr MockRepository) (*schema.ProjectDetail, string)
+		setup      func(mr MockRepository) (reqBody *schema.PublishRequest, path string)
 		statusCode int
 	}{
 		{
 			name: "Success",
-			setup: func(mr MockRepository) (*schema.ProjectDetail, string) {
-				duration := random.Duration()
+			setup: func(mr MockRepository) (*schema.PublishRequest, string) {
 				projectID := random.UUID()
-				repo := domain.ProjectDetail{
-					Project: domain.Project{
-						ID:       projectID,
-						Name:     random.AlphaNumeric(),
-						Duration: duration,
-					},
-					Description: random.AlphaNumeric(),
-					Link:        random.RandURLString(),
-					Members: []*domain.UserWithDuration{
-						{
-							User:     *domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), random.Bool()),
-							Duration: random.Duration(),
-						},
-					},
-				}
-
-				var members []schema.ProjectMember
-				for _, v := range repo.Members {
-					members = append(members, schema.ProjectMember{
-						Duration: schema.ConvertDuration(v.Duration),
-						Id:       v.User.ID,
-						Name:     v.User.Name,
-						RealName: v.User.RealName(),
-					})
-				}
-				reqBody := &schema.ProjectDetail{
-					Description: repo.Description,
-					Duration:    schema.ConvertDuration(repo.Duration),
-					Id:          repo.ID,
-					Link:        repo.Link,
-					Members:     members,
-					Name:        repo.Name,
-				}
-
-				mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(&repo, nil)
-				return reqBody, fmt.Sprintf("/api/v1/projects/%s", projectID)
+				mr.project.EXPECT().PublishProject(anyCtx{}, projectID, optional.Of[time.Time]{}).Return(nil)
+				return nil, fmt.Sprintf("/api/v1/projects/%s/publish", projectID)
 			},
-			statusCode: http.StatusOK,
+			statusCode: http.StatusNoContent,
 		},
 		{
-			name: "Bad Request: Validate error: invalid projectID",
-			setup: func(_ MockRepository) (*schema.ProjectDetail, string) {
-				return nil, fmt.Sprintf("/api/v1/projects/%s", invalidID)
+			name: "Success: scheduled",
+			setup: func(mr MockRepository) (*schema.PublishRequest, string) {
+				projectID := random.UUID()
+				publishAt := time.Date(2100, 4, 1, 0, 0, 0, 0, time.UTC)
+				mr.project.EXPECT().PublishProject(anyCtx{}, projectID, optional.From(publishAt)).Return(nil)
+				return &schema.PublishRequest{PublishAt: &publishAt}, fmt.Sprintf("/api/v1/projects/%s/publish", projectID)
 			},
-			statusCode: http.StatusBadRequest,
+			statusCode: http.StatusNoContent,
 		},
 		{
-			name: "Internal Error",
-			setup: func(mr MockRepository) (*schema.ProjectDetail, string) {
+			name: "Conflict: already published",
+			setup: func(mr MockRepository) (*schema.PublishRequest, string) {
 				projectID := random.UUID()
-				mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(nil, errInternal)
-				return nil, fmt.Sprintf("/api/v1/projects/%s", projectID)
+				mr.project.EXPECT().PublishProject(anyCtx{}, projectID, optional.Of[time.Time]{}).Return(repository.ErrAlreadyExists)
+				return nil, fmt.Sprintf("/api/v1/projects/%s/publish", projectID)
 			},
-			statusCode: http.StatusInternalServerError,
+			statusCode: http.StatusConflict,
 		},
 		{
-			name: "Validation Error",
-			setup: func(_ MockRepository) (*schema.ProjectDetail, string) {
-				projectID := random.AlphaNumericN(36)
-				return nil, fmt.Sprintf("/api/v1/projects/%s", projectID)
+			name: "Not Found",
+			setup: func(mr MockRepository) (*schema.PublishRequest, string) {
+				projectID := random.UUID()
+				mr.project.EXPECT().PublishProject(anyCtx{}, projectID, optional.Of[time.Time]{}).Return(repository.ErrNotFound)
+				return nil, fmt.Sprintf("/api/v1/projects/%s/publish", projectID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: Invalid Project ID",
+			setup: func(_ MockRepository) (*schema.PublishRequest, string) {
+				return nil, fmt.Sprintf("/api/v1/projects/%s/publish", invalidID)
 			},
 			statusCode: http.StatusBadRequest,
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			s, api := setupProjectMock(t)
+
+			reqBody, path := tt.setup(s)
+
+			statusCode, _ := doRequest(t, api, http.MethodPost, path, reqBody, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+		})
+	}
+}
+
+func TestProjectHandler_GetProjectRevisions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (hres []schema.Revision, path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) ([]schema.Revision, string) {
+				projectID := random.UUID()
+				createdAt := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+				revisions := []*domain.Revision{
+					{Number: 1, CreatedAt: createdAt},
+					{Number: 2, CreatedAt: createdAt.Add(time.Hour)},
+				}
+				mr.project.EXPECT().GetProjectRevisions(anyCtx{}, projectID).Return(revisions, nil)
+				hres := []schema.Revision{
+					{Revision: 1, CreatedAt: createdAt},
+					{Revision: 2, CreatedAt: createdAt.Add(time.Hour)},
+				}
+				return hres, fmt.Sprintf("/api/v1/projects/%s/revisions", projectID)
+			},
+			statusCode: http.StatusOK,
+		},
 		{
-			name: "Not Found Error",
-			setup: func(mr MockRepository) (*schema.ProjectDetail, string) {
+			name: "Forbidden",
+			setup: func(mr MockRepository) ([]schema.Revision, string) {
 				projectID := random.UUID()
-				mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(nil, repository.ErrNotFound)
-				return nil, fmt.Sprintf("/api/v1/projects/%s", projectID)
+				mr.project.EXPECT().GetProjectRevisions(anyCtx{}, projectID).Return(nil, repository.ErrForbidden)
+				return nil, fmt.Sprintf("/api/v1/projects/%s/revisions", projectID)
 			},
-			statusCode: http.StatusNotFound,
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name: "Bad Request: Invalid Project ID",
+			setup: func(_ MockRepository) ([]schema.Revision, string) {
+				return nil, fmt.Sprintf("/api/v1/projects/%s/revisions", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			r, api := setupProjectMock(t)
+			s, api := setupProjectMock(t)
 
-			expectedHres, path := tt.setup(r)
+			expectedHres, path := tt.setup(s)
 
-			var hres *schema.ProjectDetail
+			var hres []schema.Revision
 			statusCode, _ := doRequest(t, api, http.MethodGet, path, nil, &hres)
 
 			// Assertion
@@ -214,79 +1000,72 @@ func TestProjectHandler_GetProject(t *testing.T) {
 	}
 }
 
-func TestProjectHandler_CreateProject(t *testing.T) {
+func TestProjectHandler_GetProjectRevisionDiff(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name       string
-		setup      func(mr MockRepository) (reqBody *schema.CreateProjectRequest, expectedResBody schema.Project, path string)
+		setup      func(mr MockRepository) (hres []schema.RevisionFieldDiff, path string)
 		statusCode int
 	}{
 		{
 			name: "Success",
-			setup: func(mr MockRepository) (reqBody *schema.CreateProjectRequest, expectedResBody schema.Project, path string) {
-				duration := random.Duration()
-				reqBody = makeCreateProjectRequest(
-					t,
-					random.AlphaNumeric(),
-					schema.ConvertDuration(duration).Since,
-					schema.ConvertDuration(duration).Until,
-					random.AlphaNumeric(),
-					random.RandURLString(),
-				)
-				args := repository.CreateProjectArgs{
-					Name:          reqBody.Name,
-					Description:   reqBody.Description,
-					Link:          optional.FromPtr(reqBody.Link),
-					SinceYear:     reqBody.Duration.Since.Year,
-					SinceSemester: int(reqBody.Duration.Since.Semester),
-					UntilYear:     reqBody.Duration.Until.Year,
-					UntilSemester: int(reqBody.Duration.Until.Semester),
-				}
-				want := domain.ProjectDetail{
-					Project: domain.Project{
-						ID:   random.UUID(),
-						Name: args.Name,
-						Duration: domain.NewYearWithSemesterDuration(
-							args.SinceYear,
-							args.SinceSemester,
-							args.UntilYear,
-							args.UntilSemester,
-						),
-					},
-					Description: args.Description,
-					Link:        args.Link.ValueOrZero(),
-					Members:     nil,
+			setup: func(mr MockRepository) ([]schema.RevisionFieldDiff, string) {
+				projectID := random.UUID()
+				diffs := []*domain.RevisionFieldDiff{
+					{Field: "name", From: "before", To: "after"},
 				}
-				expectedResBody = schema.Project{
-					Duration: schema.ConvertDuration(want.Duration),
-					Id:       want.ID,
-					Name:     want.Name,
+				mr.project.EXPECT().GetProjectRevisionDiff(anyCtx{}, projectID, 1, 2).Return(diffs, nil)
+				hres := []schema.RevisionFieldDiff{
+					{Field: "name", From: "before", To: "after"},
 				}
-				mr.project.EXPECT().CreateProject(anyCtx{}, &args).Return(&want, nil)
-				return reqBody, expectedResBody, "/api/v1/projects"
+				return hres, fmt.Sprintf("/api/v1/projects/%s/revisions/diff?from=1&to=2", projectID)
 			},
-			statusCode: http.StatusCreated,
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			setup: func(mr MockRepository) ([]schema.RevisionFieldDiff, string) {
+				projectID := random.UUID()
+				mr.project.EXPECT().GetProjectRevisionDiff(anyCtx{}, projectID, 1, 5).Return(nil, repository.ErrNotFound)
+				return nil, fmt.Sprintf("/api/v1/projects/%s/revisions/diff?from=1&to=5", projectID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: missing to",
+			setup: func(_ MockRepository) ([]schema.RevisionFieldDiff, string) {
+				return nil, fmt.Sprintf("/api/v1/projects/%s/revisions/diff?from=1", random.UUID())
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: revision less than 1",
+			setup: func(_ MockRepository) ([]schema.RevisionFieldDiff, string) {
+				return nil, fmt.Sprintf("/api/v1/projects/%s/revisions/diff?from=0&to=1", random.UUID())
+			},
+			statusCode: http.StatusBadRequest,
 		},
 	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup mock
-			r, api := setupProjectMock(t)
+			s, api := setupProjectMock(t)
 
-			reqBody, res, path := tt.setup(r)
+			expectedHres, path := tt.setup(s)
 
-			var resBody schema.Project
-			statusCode, _ := doRequest(t, api, http.MethodPost, path, reqBody, &resBody)
+			var hres []schema.RevisionFieldDiff
+			statusCode, _ := doRequest(t, api, http.MethodGet, path, nil, &hres)
 
 			// Assertion
 			assert.Equal(t, tt.statusCode, statusCode)
-			assert.Equal(t, resBody, res)
+			assert.Equal(t, expectedHres, hres)
 		})
 	}
 }
 
-func TestProjectHandler_DeleteProject(t *testing.T) {
+func TestProjectHandler_RestoreProjectRevision(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
@@ -296,17 +1075,33 @@ func TestProjectHandler_DeleteProject(t *testing.T) {
 	}{
 		{
 			name: "Success",
-			setup: func(mr MockRepository) (path string) {
+			setup: func(mr MockRepository) string {
 				projectID := random.UUID()
-				mr.project.EXPECT().DeleteProject(anyCtx{}, projectID).Return(nil)
-				return fmt.Sprintf("/api/v1/projects/%s", projectID)
+				mr.project.EXPECT().RestoreProjectRevision(anyCtx{}, projectID, 3).Return(nil)
+				return fmt.Sprintf("/api/v1/projects/%s/revisions/3/restore", projectID)
 			},
 			statusCode: http.StatusNoContent,
 		},
 		{
-			name: "Bad Request: Invalid Project ID",
-			setup: func(mr MockRepository) (path string) {
-				return fmt.Sprintf("/api/v1/projects/%s", invalidID)
+			name: "Conflict: duplicated name",
+			setup: func(mr MockRepository) string {
+				projectID := random.UUID()
+				mr.project.EXPECT().RestoreProjectRevision(anyCtx{}, projectID, 1).Return(repository.ErrAlreadyExists)
+				return fmt.Sprintf("/api/v1/projects/%s/revisions/1/restore", projectID)
+			},
+			statusCode: http.StatusConflict,
+		},
+		{
+			name: "Bad Request: invalid revision",
+			setup: func(_ MockRepository) string {
+				return fmt.Sprintf("/api/v1/projects/%s/revisions/latest/restore", random.UUID())
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: revision less than 1",
+			setup: func(_ MockRepository) string {
+				return fmt.Sprintf("/api/v1/projects/%s/revisions/0/restore", random.UUID())
 			},
 			statusCode: http.StatusBadRequest,
 		},
@@ -319,7 +1114,7 @@ func TestProjectHandler_DeleteProject(t *testing.T) {
 
 			path := tt.setup(s)
 
-			statusCode, _ := doRequest(t, api, http.MethodDelete, path, nil, nil)
+			statusCode, _ := doRequest(t, api, http.MethodPost, path, nil, nil)
 
 			// Assertion
 			assert.Equal(t, tt.statusCode, statusCode)
@@ -486,3 +1281,119 @@ func TestProjectHandler_EditProjectMembers(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectHandler_GetProjectMembers_ETag(t *testing.T) {
+	t.Parallel()
+
+	mr, api := setupProjectMock(t)
+
+	project := domain.ProjectDetail{
+		Project: domain.Project{
+			ID:       random.UUID(),
+			Name:     random.AlphaNumeric(),
+			Duration: random.Duration(),
+		},
+		Members: []*domain.UserWithDuration{
+			{
+				User:     *domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), true), // 本名を公開する
+				Duration: random.Duration(),
+			},
+		},
+		Version: 3,
+	}
+	mr.project.EXPECT().GetProject(anyCtx{}, project.ID).Return(&project, nil).Times(5)
+
+	path := fmt.Sprintf("/api/v1/projects/%s/members", project.ID)
+	statusCode, rec := doRequest(t, api, http.MethodGet, path, nil, nil)
+	assert.Equal(t, http.StatusOK, statusCode)
+	etag := rec.Header().Get(headerETag)
+	assert.True(t, strings.HasPrefix(etag, `"3-`))
+	assert.Equal(t, headerAcceptLanguage, rec.Header().Get(echo.HeaderVary))
+
+	// 変わっていない場合は304を返す
+	statusCode, rec = doRequestWithHeader(t, api, http.MethodGet, path, nil, nil, map[string]string{headerIfNoneMatch: "W/" + etag})
+	assert.Equal(t, http.StatusNotModified, statusCode)
+	assert.Equal(t, etag, rec.Header().Get(headerETag))
+	assert.Empty(t, rec.Body.String())
+
+	// 版番号だけでは一致しない
+	statusCode, _ = doRequestWithHeader(t, api, http.MethodGet, path, nil, nil, map[string]string{headerIfNoneMatch: `"3"`})
+	assert.Equal(t, http.StatusOK, statusCode)
+
+	// 言語が違う場合は304を返さない
+	statusCode, rec = doRequestWithHeader(t, api, http.MethodGet, path, nil, nil, map[string]string{headerIfNoneMatch: etag, headerAcceptLanguage: "en"})
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.NotEqual(t, etag, rec.Header().Get(headerETag))
+
+	// 同期した本名などが変わった場合は、版番号が同じでも304を返さない
+	u := project.Members[0].User
+	project.Members[0].User = *domain.NewUser(u.ID, u.Name, random.AlphaNumeric(), u.Check)
+	statusCode, rec = doRequestWithHeader(t, api, http.MethodGet, path, nil, nil, map[string]string{headerIfNoneMatch: etag})
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.NotEqual(t, etag, rec.Header().Get(headerETag))
+}
+
+func TestProjectHandler_EditProjectMembers_IfMatch(t *testing.T) {
+	t.Parallel()
+
+	projectID := random.UUID()
+	userID := random.UUID()
+	userDuration := random.Duration()
+	reqBody := &schema.EditProjectMembersRequest{
+		Members: []schema.MemberIDWithYearWithSemesterDuration{
+			{
+				Duration: schema.ConvertDuration(userDuration),
+				UserId:   userID,
+			},
+		},
+	}
+	memberReq := []*repository.EditProjectMemberArgs{
+		{
+			UserID:        userID,
+			SinceYear:     userDuration.Since.Year,
+			SinceSemester: userDuration.Since.Semester,
+			UntilYear:     userDuration.Until.ValueOrZero().Year,
+			UntilSemester: userDuration.Until.ValueOrZero().Semester,
+		},
+	}
+
+	// 版番号はリポジトリの編集のトランザクション内で確かめる
+	const currentVersion = 3
+	editProjectMembers := func(ctx context.Context, _ uuid.UUID, _ []*repository.EditProjectMemberArgs) error {
+		return repository.CheckVersion(ctx, currentVersion)
+	}
+
+	tests := []struct {
+		name       string
+		ifMatch    string
+		statusCode int
+	}{
+		{"Success: not changed", `"3"`, http.StatusNoContent},
+		{"Success: one of the list", `"2", "3"`, http.StatusNoContent},
+		{"Success: etag of GET", `"3-0123456789abcdef"`, http.StatusNoContent},
+		{"Success: any", "*", http.StatusNoContent},
+		{"Success: without If-Match", "", http.StatusNoContent},
+		{"Precondition Failed: changed by another member", `"2"`, http.StatusPreconditionFailed},
+		{"Precondition Failed: etag of GET changed by another member", `"2-0123456789abcdef"`, http.StatusPreconditionFailed},
+		{"Precondition Failed: weak etag", `W/"3"`, http.StatusPreconditionFailed},
+		{"Precondition Failed: invalid etag", `"outdated"`, http.StatusPreconditionFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupProjectMock(t)
+
+			mr.project.EXPECT().EditProjectMembers(anyCtx{}, projectID, memberReq).DoAndReturn(editProjectMembers)
+
+			header := map[string]string{}
+			if tt.ifMatch != "" {
+				header[headerIfMatch] = tt.ifMatch
+			}
+			statusCode, _ := doRequestWithHeader(t, api, http.MethodPut, fmt.Sprintf("/api/v1/projects/%s/members", projectID), reqBody, nil, header)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler/schema"
+)
+
+func newResume(user *domain.UserDetail, projects []*domain.UserProject, contests []*domain.UserContest, groups []*domain.UserGroup) schema.Resume {
+	name := user.DisplayName()
+
+	profiles := make([]schema.ResumeProfile, len(user.Accounts))
+	for i, v := range user.Accounts {
+		profiles[i] = newResumeProfile(v)
+	}
+
+	resumeProjects := make([]schema.ResumeProject, len(projects))
+	for i, v := range projects {
+		startDate, endDate := resumeDuration(v.UserDuration)
+		resumeProjects[i] = schema.ResumeProject{
+			Name:      v.Name,
+			StartDate: startDate,
+			EndDate:   endDate,
+		}
+	}
+
+	awards := make([]schema.ResumeAward, 0, len(contests))
+	for _, c := range contests {
+		for _, t := range c.Teams {
+			awards = append(awards, schema.ResumeAward{
+				Title:   fmt.Sprintf("%s (%s)", c.Name, t.Name),
+				Date:    c.TimeStart.Format("2006-01-02"),
+				Awarder: c.Name,
+				Summary: t.Result,
+			})
+		}
+	}
+
+	volunteer := make([]schema.ResumeVolunteer, len(groups))
+	for i, v := range groups {
+		startDate, endDate := resumeDuration(v.Duration)
+		volunteer[i] = schema.ResumeVolunteer{
+			Organization: v.Name,
+			StartDate:    startDate,
+			EndDate:      endDate,
+		}
+	}
+
+	return schema.Resume{
+		Basics: schema.ResumeBasics{
+			Name:     name,
+			Summary:  user.Bio,
+			Profiles: profiles,
+		},
+		Projects:  resumeProjects,
+		Awards:    awards,
+		Volunteer: volunteer,
+	}
+}
+
+func newResumeProfile(account *domain.Account) schema.ResumeProfile {
+	return schema.ResumeProfile{
+		Network:  accountTypeLabel(account.Type),
+		Username: accountUsername(account),
+		Url:      account.URL,
+	}
+}
+
+// accountTypeLabel 登録されていない種類の場合はIDを返す
+func accountTypeLabel(accountType domain.AccountType) string {
+	if def, ok := domain.AccountTypes().Get(accountType); ok {
+		return def.Label
+	}
+
+	return fmt.Sprintf("%d", accountType)
+}
+
+// accountUsername ハンドルを持たない種類のアカウントは表示名で代用する
+func accountUsername(account *domain.Account) string {
+	if account.Handle == "" {
+		return account.DisplayName
+	}
+
+	return account.Handle
+}
+
+// resumeDuration 前期を4月から9月、後期を10月から翌年3月として期間をYYYY-MM形式の日付に変換する
+func resumeDuration(d domain.YearWithSemesterDuration) (startDate string, endDate *string) {
+	startMonth := 4
+	if d.Since.Semester == 1 {
+		startMonth = 10
+	}
+	startDate = fmt.Sprintf("%04d-%02d", d.Since.Year, startMonth)
+
+	if until, ok := d.Until.V(); ok {
+		e := fmt.Sprintf("%04d-09", until.Year)
+		if until.Semester == 1 {
+			e = fmt.Sprintf("%04d-03", until.Year+1)
+		}
+		endDate = &e
+	}
+
+	return startDate, endDate
+}
+
+// durationLabel 年度と前期、後期で表される期間を閲覧者の言語で表示用の文字列に変換する
+func durationLabel(lang domain.Lang, d domain.YearWithSemesterDuration) string {
+	since := semesterLabel(lang, d.Since)
+	until, ok := d.Until.V()
+	if !ok {
+		return lang.Pick(since+" 〜 現在", since+" - Present")
+	}
+
+	return lang.Pick(since+" 〜 ", since+" - ") + semesterLabel(lang, until)
+}
+
+func semesterLabel(lang domain.Lang, ys domain.YearWithSemester) string {
+	if ys.Semester == 1 {
+		return lang.Pick(fmt.Sprintf("%d年度後期", ys.Year), fmt.Sprintf("Fall %d", ys.Year))
+	}
+
+	return lang.Pick(fmt.Sprintf("%d年度前期", ys.Year), fmt.Sprintf("Spring %d", ys.Year))
+}
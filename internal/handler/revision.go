@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler/schema"
+)
+
+func newRevisions(revisions []*domain.Revision) []schema.Revision {
+	res := make([]schema.Revision, len(revisions))
+	for i, v := range revisions {
+		res[i] = schema.Revision{
+			Revision:  v.Number,
+			CreatedAt: v.CreatedAt,
+		}
+	}
+
+	return res
+}
+
+func newRevisionFieldDiffs(diffs []*domain.RevisionFieldDiff) []schema.RevisionFieldDiff {
+	res := make([]schema.RevisionFieldDiff, len(diffs))
+	for i, v := range diffs {
+		res[i] = schema.RevisionFieldDiff{
+			Field: v.Field,
+			From:  v.From,
+			To:    v.To,
+		}
+	}
+
+	return res
+}
@@ -8,6 +8,7 @@ import (
 	vd "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/traPtitech/traPortfolio/internal/handler/graphql"
 	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
 )
 
@@ -27,50 +28,21 @@ func Setup(isProduction bool, e *echo.Echo, api API, opts ...Option) error {
 
 	apiGroup := e.Group("/api")
 	setupV1API(apiGroup, api, isProduction)
+	setupGraphQLAPI(apiGroup, api)
+	setupRPCAPI(e, api)
 
 	return nil
 }
 
 func newHTTPErrorHandler(e *echo.Echo) echo.HTTPErrorHandler {
 	return func(err error, c echo.Context) {
-		var (
-			code int
-			herr *echo.HTTPError
-		)
-
-		switch {
-		case errors.Is(err, repository.ErrNilID):
-			fallthrough
-		case errors.Is(err, repository.ErrInvalidID):
-			fallthrough
-		case errors.Is(err, repository.ErrInvalidArg):
-			fallthrough
-		case errors.Is(err, repository.ErrBind):
-			fallthrough
-		case errors.Is(err, repository.ErrValidate):
-			code = http.StatusBadRequest
-
-		case errors.Is(err, repository.ErrAlreadyExists):
-			code = http.StatusConflict
-
-		case errors.Is(err, repository.ErrUnauthorized):
-			code = http.StatusUnauthorized
-
-		case errors.Is(err, repository.ErrForbidden):
-			code = http.StatusForbidden
-
-		case errors.Is(err, repository.ErrNotFound):
-			code = http.StatusNotFound
-
-		case errors.Is(err, repository.ErrDBInternal):
-			fallthrough
-		default:
+		var herr *echo.HTTPError
+
+		code := errorStatus(err)
+		if code == http.StatusInternalServerError {
 			e.Logger.Error(err)
-			code = http.StatusInternalServerError
 			herr = echo.NewHTTPError(code, http.StatusText(code)).SetInternal(err)
-		}
-
-		if herr == nil {
+		} else {
 			herr = echo.NewHTTPError(
 				code,
 				fmt.Sprintf("%s: %s", http.StatusText(code), err.Error()),
@@ -81,6 +53,46 @@ func newHTTPErrorHandler(e *echo.Echo) echo.HTTPErrorHandler {
 	}
 }
 
+// errorStatus リポジトリのエラーに対応するステータスコード
+// GraphQLやgRPCのAPIのエラーの種類もここから決める
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, repository.ErrNilID):
+		fallthrough
+	case errors.Is(err, repository.ErrInvalidID):
+		fallthrough
+	case errors.Is(err, repository.ErrInvalidArg):
+		fallthrough
+	case errors.Is(err, repository.ErrBind):
+		fallthrough
+	case errors.Is(err, repository.ErrValidate):
+		return http.StatusBadRequest
+
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return http.StatusConflict
+
+	case errors.Is(err, repository.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed
+
+	case errors.Is(err, repository.ErrUnauthorized):
+		return http.StatusUnauthorized
+
+	case errors.Is(err, repository.ErrForbidden):
+		return http.StatusForbidden
+
+	case errors.Is(err, repository.ErrNotFound):
+		return http.StatusNotFound
+
+	case errors.Is(err, graphql.ErrEventDisabled):
+		return http.StatusNotImplemented
+
+	case errors.Is(err, repository.ErrDBInternal):
+		fallthrough
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 type binderWithValidation struct{}
 
 var _ echo.Binder = (*binderWithValidation)(nil)
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/traPortfolio/internal/handler/rpc"
+)
+
+type RPCHandler struct {
+	server *rpc.Server
+}
+
+// NewRPCHandler creates a RPCHandler
+func NewRPCHandler(server *rpc.Server) *RPCHandler {
+	return &RPCHandler{server: server}
+}
+
+// handlers RESTのAPIと同様に、リポジトリのエラーを対応するステータスコードに変換する
+func (h *RPCHandler) handlers(logger echo.Logger) map[string]http.Handler {
+	return h.server.Handlers(connect.WithInterceptors(newRPCErrorInterceptor(logger)))
+}
+
+func newRPCErrorInterceptor(logger echo.Logger) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			res, err := next(ctx, req)
+			if err == nil {
+				return res, nil
+			}
+
+			var cerr *connect.Error
+			if errors.As(err, &cerr) {
+				return nil, err
+			}
+
+			code := rpcErrorCode(err)
+			if code == connect.CodeInternal {
+				// RESTのAPIと同様に、内部のエラーの詳細は返さずログに出力する
+				logger.Error(err)
+				return nil, connect.NewError(code, errors.New(http.StatusText(http.StatusInternalServerError)))
+			}
+
+			return nil, connect.NewError(code, err)
+		}
+	}
+}
+
+// rpcErrorCode RESTのAPIのステータスコードに対応するエラーコード
+func rpcErrorCode(err error) connect.Code {
+	switch errorStatus(err) {
+	case http.StatusBadRequest:
+		return connect.CodeInvalidArgument
+	case http.StatusConflict:
+		return connect.CodeAlreadyExists
+	case http.StatusPreconditionFailed:
+		return connect.CodeFailedPrecondition
+	case http.StatusUnauthorized:
+		return connect.CodeUnauthenticated
+	case http.StatusForbidden:
+		return connect.CodePermissionDenied
+	case http.StatusNotFound:
+		return connect.CodeNotFound
+	case http.StatusNotImplemented:
+		return connect.CodeUnimplemented
+	default:
+		return connect.CodeInternal
+	}
+}
@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	pb "github.com/traPtitech/traPortfolio/internal/handler/rpc/gen/traportfolio/v1"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+type contestService struct {
+	*Server
+}
+
+func (s *contestService) ListContests(ctx context.Context, req *connect.Request[pb.ListContestsRequest]) (*connect.Response[pb.ListContestsResponse], error) {
+	contests, err := s.contest.GetContests(ctx, &repository.GetContestsArgs{
+		Limit: optionalInt(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Contest, len(contests))
+	for i, c := range contests {
+		res[i] = newContest(*c)
+	}
+
+	return connect.NewResponse(&pb.ListContestsResponse{Contests: res}), nil
+}
+
+func (s *contestService) GetContest(ctx context.Context, req *connect.Request[pb.GetContestRequest]) (*connect.Response[pb.GetContestResponse], error) {
+	contestID, err := parseID(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	contest, err := s.contest.GetContest(ctx, contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.GetContestResponse{
+		Contest: &pb.ContestDetail{
+			Contest:     newContest(contest.Contest),
+			Link:        contest.Link,
+			Description: contest.Description,
+			Teams:       newContestTeams(contest.ContestTeams),
+		},
+	}), nil
+}
+
+func (s *contestService) ListContestTeams(ctx context.Context, req *connect.Request[pb.ListContestTeamsRequest]) (*connect.Response[pb.ListContestTeamsResponse], error) {
+	contestID, err := parseID(req.Msg.ContestId)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.contest.GetContestTeams(ctx, contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.ListContestTeamsResponse{Teams: newContestTeams(teams)}), nil
+}
+
+func (s *contestService) GetContestTeam(ctx context.Context, req *connect.Request[pb.GetContestTeamRequest]) (*connect.Response[pb.GetContestTeamResponse], error) {
+	contestID, err := parseID(req.Msg.ContestId)
+	if err != nil {
+		return nil, err
+	}
+
+	teamID, err := parseID(req.Msg.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := s.contest.GetContestTeam(ctx, contestID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	// RESTのAPIと同様に、メンバーは別に取得する
+	members, err := s.contest.GetContestTeamMembers(ctx, contestID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	team.Members = members
+
+	return connect.NewResponse(&pb.GetContestTeamResponse{
+		Team: &pb.ContestTeamDetail{
+			Team:        newContestTeam(team.ContestTeam),
+			Link:        team.Link,
+			Description: team.Description,
+		},
+	}), nil
+}
+
+func newContest(c domain.Contest) *pb.Contest {
+	return &pb.Contest{
+		Id:       c.ID.String(),
+		Name:     c.Name,
+		Duration: newDuration(c.TimeStart, c.TimeEnd),
+	}
+}
+
+func newContestTeam(t domain.ContestTeam) *pb.ContestTeam {
+	return &pb.ContestTeam{
+		Id:      t.ID.String(),
+		Name:    t.Name,
+		Result:  t.Result,
+		Members: newUsers(t.Members),
+	}
+}
+
+func newContestTeams(teams []*domain.ContestTeam) []*pb.ContestTeam {
+	res := make([]*pb.ContestTeam, len(teams))
+	for i, t := range teams {
+		res[i] = newContestTeam(*t)
+	}
+
+	return res
+}
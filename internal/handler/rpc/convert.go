@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	pb "github.com/traPtitech/traPortfolio/internal/handler/rpc/gen/traportfolio/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newYearWithSemester(ys domain.YearWithSemester) *pb.YearWithSemester {
+	return &pb.YearWithSemester{
+		Year:     int32(ys.Year),
+		Semester: int32(ys.Semester),
+	}
+}
+
+// newYearWithSemesterDuration 継続中の場合はuntilを設定しない
+func newYearWithSemesterDuration(d domain.YearWithSemesterDuration) *pb.YearWithSemesterDuration {
+	res := &pb.YearWithSemesterDuration{Since: newYearWithSemester(d.Since)}
+	if until, ok := d.Until.V(); ok {
+		res.Until = newYearWithSemester(until)
+	}
+
+	return res
+}
+
+func newDuration(since time.Time, until time.Time) *pb.Duration {
+	return &pb.Duration{
+		Since: timestamppb.New(since),
+		Until: timestamppb.New(until),
+	}
+}
+
+func newUser(u domain.User) *pb.User {
+	return &pb.User{
+		Id:       u.ID.String(),
+		Name:     u.Name,
+		RealName: u.RealName(),
+	}
+}
+
+func newUsers(users []*domain.User) []*pb.User {
+	res := make([]*pb.User, len(users))
+	for i, u := range users {
+		res[i] = newUser(*u)
+	}
+
+	return res
+}
+
+func newUsersWithDuration(users []*domain.UserWithDuration) []*pb.UserWithDuration {
+	res := make([]*pb.UserWithDuration, len(users))
+	for i, u := range users {
+		res[i] = &pb.UserWithDuration{
+			User:     newUser(u.User),
+			Duration: newYearWithSemesterDuration(u.Duration),
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	pb "github.com/traPtitech/traPortfolio/internal/handler/rpc/gen/traportfolio/v1"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+type groupService struct {
+	*Server
+}
+
+func (s *groupService) ListGroups(ctx context.Context, req *connect.Request[pb.ListGroupsRequest]) (*connect.Response[pb.ListGroupsResponse], error) {
+	groups, err := s.group.GetGroups(ctx, &repository.GetGroupsArgs{
+		Limit: optionalInt(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Group, len(groups))
+	for i, g := range groups {
+		res[i] = &pb.Group{
+			Id:   g.ID.String(),
+			Name: g.Name,
+		}
+	}
+
+	return connect.NewResponse(&pb.ListGroupsResponse{Groups: res}), nil
+}
+
+func (s *groupService) GetGroup(ctx context.Context, req *connect.Request[pb.GetGroupRequest]) (*connect.Response[pb.GetGroupResponse], error) {
+	groupID, err := parseID(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.group.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	// RESTのAPIと同様に、リポジトリから取得できないメンバーの名前などをユーザー一覧で補う
+	users, err := s.user.GetUsers(ctx, &repository.GetUsersArgs{})
+	if err != nil {
+		return nil, err
+	}
+
+	umap := make(map[uuid.UUID]*domain.User, len(users))
+	for _, u := range users {
+		umap[u.ID] = u
+	}
+
+	for _, m := range group.Members {
+		if u, ok := umap[m.User.ID]; ok {
+			m.User = *u
+		}
+	}
+	for i, a := range group.Admin {
+		if u, ok := umap[a.ID]; ok {
+			group.Admin[i] = u
+		}
+	}
+
+	return connect.NewResponse(&pb.GetGroupResponse{
+		Group: &pb.GroupDetail{
+			Group: &pb.Group{
+				Id:   group.ID.String(),
+				Name: group.Name,
+			},
+			Link:        group.Link,
+			Description: group.Description,
+			Admins:      newUsers(group.Admin),
+			Members:     newUsersWithDuration(group.Members),
+		},
+	}), nil
+}
@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	pb "github.com/traPtitech/traPortfolio/internal/handler/rpc/gen/traportfolio/v1"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+type projectService struct {
+	*Server
+}
+
+func (s *projectService) ListProjects(ctx context.Context, req *connect.Request[pb.ListProjectsRequest]) (*connect.Response[pb.ListProjectsResponse], error) {
+	projects, err := s.project.GetProjects(ctx, &repository.GetProjectsArgs{
+		Limit: optionalInt(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.Project, len(projects))
+	for i, p := range projects {
+		res[i] = &pb.Project{
+			Id:       p.ID.String(),
+			Name:     p.Name,
+			Duration: newYearWithSemesterDuration(p.Duration),
+		}
+	}
+
+	return connect.NewResponse(&pb.ListProjectsResponse{Projects: res}), nil
+}
+
+func (s *projectService) GetProject(ctx context.Context, req *connect.Request[pb.GetProjectRequest]) (*connect.Response[pb.GetProjectResponse], error) {
+	projectID, err := parseID(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.project.GetProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.GetProjectResponse{
+		Project: &pb.ProjectDetail{
+			Project: &pb.Project{
+				Id:       project.ID.String(),
+				Name:     project.Name,
+				Duration: newYearWithSemesterDuration(project.Duration),
+			},
+			Description: project.Description,
+			Link:        project.Link,
+			Members:     newUsersWithDuration(project.Members),
+		},
+	}), nil
+}
@@ -0,0 +1,68 @@
+//go:generate go run github.com/bufbuild/buf/cmd/buf@latest generate ../../../docs/proto --template buf.gen.yaml
+
+// Package rpc RESTのAPIと同じリポジトリを使う、Connect(gRPC互換)の読み取り専用のサービス
+package rpc
+
+import (
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/handler/rpc/gen/traportfolio/v1/traportfoliov1connect"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+// Server ユーザー、プロジェクト、コンテスト、班のサービス
+type Server struct {
+	user    repository.UserRepository
+	project repository.ProjectRepository
+	contest repository.ContestRepository
+	group   repository.GroupRepository
+}
+
+func NewServer(user repository.UserRepository, project repository.ProjectRepository, contest repository.ContestRepository, group repository.GroupRepository) *Server {
+	return &Server{
+		user:    user,
+		project: project,
+		contest: contest,
+		group:   group,
+	}
+}
+
+// Handlers サービスのパスの接頭辞ごとのハンドラー
+// 各ハンドラーはConnect、gRPC、gRPC-Webのいずれのプロトコルのリクエストも受け付ける
+func (s *Server) Handlers(opts ...connect.HandlerOption) map[string]http.Handler {
+	handlers := make(map[string]http.Handler, 4)
+
+	path, h := traportfoliov1connect.NewUserServiceHandler(&userService{s}, opts...)
+	handlers[path] = h
+	path, h = traportfoliov1connect.NewProjectServiceHandler(&projectService{s}, opts...)
+	handlers[path] = h
+	path, h = traportfoliov1connect.NewContestServiceHandler(&contestService{s}, opts...)
+	handlers[path] = h
+	path, h = traportfoliov1connect.NewGroupServiceHandler(&groupService{s}, opts...)
+	handlers[path] = h
+
+	return handlers
+}
+
+func parseID(id string) (uuid.UUID, error) {
+	u, err := uuid.FromString(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %s", repository.ErrInvalidID, err.Error())
+	} else if u.IsNil() {
+		return uuid.Nil, repository.ErrNilID
+	}
+
+	return u, nil
+}
+
+func optionalInt(v *int32) optional.Of[int] {
+	if v == nil {
+		return optional.Of[int]{}
+	}
+
+	return optional.From(int(*v))
+}
@@ -0,0 +1,142 @@
+package rpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	pb "github.com/traPtitech/traPortfolio/internal/handler/rpc/gen/traportfolio/v1"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+type userService struct {
+	*Server
+}
+
+func (s *userService) ListUsers(ctx context.Context, req *connect.Request[pb.ListUsersRequest]) (*connect.Response[pb.ListUsersResponse], error) {
+	users, err := s.user.GetUsers(ctx, &repository.GetUsersArgs{
+		IncludeSuspended: optional.FromPtr(req.Msg.IncludeSuspended),
+		Name:             optional.FromPtr(req.Msg.Name),
+		Limit:            optionalInt(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.ListUsersResponse{Users: newUsers(users)}), nil
+}
+
+func (s *userService) GetUser(ctx context.Context, req *connect.Request[pb.GetUserRequest]) (*connect.Response[pb.GetUserResponse], error) {
+	userID, err := parseID(req.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.user.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pb.GetUserResponse{User: newUserDetail(user)}), nil
+}
+
+func (s *userService) ListUserProjects(ctx context.Context, req *connect.Request[pb.ListUserProjectsRequest]) (*connect.Response[pb.ListUserProjectsResponse], error) {
+	userID, err := parseID(req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.user.GetProjects(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.UserProject, len(projects))
+	for i, p := range projects {
+		res[i] = &pb.UserProject{
+			Id:           p.ID.String(),
+			Name:         p.Name,
+			Duration:     newYearWithSemesterDuration(p.Duration),
+			UserDuration: newYearWithSemesterDuration(p.UserDuration),
+		}
+	}
+
+	return connect.NewResponse(&pb.ListUserProjectsResponse{Projects: res}), nil
+}
+
+func (s *userService) ListUserContests(ctx context.Context, req *connect.Request[pb.ListUserContestsRequest]) (*connect.Response[pb.ListUserContestsResponse], error) {
+	userID, err := parseID(req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	contests, err := s.user.GetContests(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.UserContest, len(contests))
+	for i, c := range contests {
+		teams := make([]*pb.UserContestTeam, len(c.Teams))
+		for j, t := range c.Teams {
+			teams[j] = &pb.UserContestTeam{
+				Id:     t.ID.String(),
+				Name:   t.Name,
+				Result: t.Result,
+			}
+		}
+
+		res[i] = &pb.UserContest{
+			Id:       c.ID.String(),
+			Name:     c.Name,
+			Duration: newDuration(c.TimeStart, c.TimeEnd),
+			Teams:    teams,
+		}
+	}
+
+	return connect.NewResponse(&pb.ListUserContestsResponse{Contests: res}), nil
+}
+
+func (s *userService) ListUserGroups(ctx context.Context, req *connect.Request[pb.ListUserGroupsRequest]) (*connect.Response[pb.ListUserGroupsResponse], error) {
+	userID, err := parseID(req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.user.GetGroupsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*pb.UserGroup, len(groups))
+	for i, g := range groups {
+		res[i] = &pb.UserGroup{
+			Id:       g.ID.String(),
+			Name:     g.Name,
+			Duration: newYearWithSemesterDuration(g.Duration),
+		}
+	}
+
+	return connect.NewResponse(&pb.ListUserGroupsResponse{Groups: res}), nil
+}
+
+func newUserDetail(user *domain.UserDetail) *pb.UserDetail {
+	accounts := make([]*pb.Account, len(user.Accounts))
+	for i, a := range user.Accounts {
+		accounts[i] = &pb.Account{
+			Id:          a.ID.String(),
+			DisplayName: a.DisplayName,
+			Type:        int32(a.Type),
+			Url:         a.URL,
+			Handle:      a.Handle,
+		}
+	}
+
+	return &pb.UserDetail{
+		User:     newUser(user.User),
+		State:    int32(user.State),
+		Bio:      user.Bio,
+		Accounts: accounts,
+	}
+}
@@ -0,0 +1,339 @@
+package handler
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler/rpc"
+	pb "github.com/traPtitech/traPortfolio/internal/handler/rpc/gen/traportfolio/v1"
+	"github.com/traPtitech/traPortfolio/internal/handler/rpc/gen/traportfolio/v1/traportfoliov1connect"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository/mock_repository"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func setupRPCMock(t *testing.T) (MockRepository, *httptest.Server) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mr := MockRepository{
+		user:    mock_repository.NewMockUserRepository(ctrl),
+		project: mock_repository.NewMockProjectRepository(ctrl),
+		contest: mock_repository.NewMockContestRepository(ctrl),
+		group:   mock_repository.NewMockGroupRepository(ctrl),
+	}
+	api := NewAPI(nil, nil, nil, nil, nil, nil, nil, nil, NewRPCHandler(rpc.NewServer(mr.user, mr.project, mr.contest, mr.group)))
+
+	e := echo.New()
+	assert.NoError(t, Setup(false, e, api))
+	s := httptest.NewServer(h2c.NewHandler(e, &http2.Server{}))
+	t.Cleanup(s.Close)
+
+	return mr, s
+}
+
+// memberCtx メンバーとしてのリクエストであることを確かめる
+type memberCtx struct{}
+
+func (memberCtx) Matches(v interface{}) bool {
+	ctx, ok := v.(context.Context)
+	return ok && repository.IsMember(ctx)
+}
+
+func (memberCtx) String() string {
+	return "is Context with member"
+}
+
+func TestRPCHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		setup func(mr MockRepository) (call func(ctx context.Context, url string) (proto.Message, error), want proto.Message)
+		code  connect.Code
+	}{
+		{
+			name: "success: ListUsers",
+			setup: func(mr MockRepository) (func(ctx context.Context, url string) (proto.Message, error), proto.Message) {
+				user := domain.NewUser(random.UUID(), "user1", "User One", true)
+				mr.user.EXPECT().GetUsers(anyCtx{}, &repository.GetUsersArgs{
+					Name:  optional.From("user1"),
+					Limit: optional.From(10),
+				}).Return([]*domain.User{user}, nil)
+
+				call := func(ctx context.Context, url string) (proto.Message, error) {
+					res, err := traportfoliov1connect.NewUserServiceClient(http.DefaultClient, url, connect.WithProtoJSON()).
+						ListUsers(ctx, connect.NewRequest(&pb.ListUsersRequest{Name: proto.String("user1"), Limit: proto.Int32(10)}))
+					if err != nil {
+						return nil, err
+					}
+					return res.Msg, nil
+				}
+				want := &pb.ListUsersResponse{Users: []*pb.User{{Id: user.ID.String(), Name: "user1", RealName: "User One"}}}
+
+				return call, want
+			},
+		},
+		{
+			name: "success: GetUser as member",
+			setup: func(mr MockRepository) (func(ctx context.Context, url string) (proto.Message, error), proto.Message) {
+				userID := random.UUID()
+				accountID := random.UUID()
+				mr.user.EXPECT().GetUser(memberCtx{}, userID).Return(&domain.UserDetail{
+					User:     *domain.NewUser(userID, "user1", "User One", true),
+					State:    domain.TraqStateActive,
+					Bio:      "Hello",
+					Accounts: []*domain.Account{{ID: accountID, DisplayName: "user1", Type: domain.GITHUB, Handle: "user1", URL: "https://github.com/user1"}},
+				}, nil)
+
+				call := func(ctx context.Context, url string) (proto.Message, error) {
+					req := connect.NewRequest(&pb.GetUserRequest{Id: userID.String()})
+					req.Header().Set("X-Forwarded-User", "user1")
+					res, err := traportfoliov1connect.NewUserServiceClient(http.DefaultClient, url).GetUser(ctx, req)
+					if err != nil {
+						return nil, err
+					}
+					return res.Msg, nil
+				}
+				want := &pb.GetUserResponse{User: &pb.UserDetail{
+					User:     &pb.User{Id: userID.String(), Name: "user1", RealName: "User One"},
+					State:    int32(domain.TraqStateActive),
+					Bio:      "Hello",
+					Accounts: []*pb.Account{{Id: accountID.String(), DisplayName: "user1", Type: int32(domain.GITHUB), Url: "https://github.com/user1", Handle: "user1"}},
+				}}
+
+				return call, want
+			},
+		},
+		{
+			name: "success: ListUserProjects",
+			setup: func(mr MockRepository) (func(ctx context.Context, url string) (proto.Message, error), proto.Message) {
+				userID := random.UUID()
+				projectID := random.UUID()
+				mr.user.EXPECT().GetProjects(anyCtx{}, userID).Return([]*domain.UserProject{
+					{ID: projectID, Name: "project1", Duration: domain.NewYearWithSemesterDuration(2022, 0, 0, 0), UserDuration: domain.NewYearWithSemesterDuration(2022, 1, 2023, 0)},
+				}, nil)
+
+				call := func(ctx context.Context, url string) (proto.Message, error) {
+					res, err := traportfoliov1connect.NewUserServiceClient(http.DefaultClient, url).
+						ListUserProjects(ctx, connect.NewRequest(&pb.ListUserProjectsRequest{UserId: userID.String()}))
+					if err != nil {
+						return nil, err
+					}
+					return res.Msg, nil
+				}
+				want := &pb.ListUserProjectsResponse{Projects: []*pb.UserProject{{
+					Id:           projectID.String(),
+					Name:         "project1",
+					Duration:     &pb.YearWithSemesterDuration{Since: &pb.YearWithSemester{Year: 2022, Semester: 0}},
+					UserDuration: &pb.YearWithSemesterDuration{Since: &pb.YearWithSemester{Year: 2022, Semester: 1}, Until: &pb.YearWithSemester{Year: 2023, Semester: 0}},
+				}}}
+
+				return call, want
+			},
+		},
+		{
+			name: "success: GetContestTeam",
+			setup: func(mr MockRepository) (func(ctx context.Context, url string) (proto.Message, error), proto.Message) {
+				contestID := random.UUID()
+				teamID := random.UUID()
+				member := domain.NewUser(random.UUID(), "user1", "User One", false)
+				mr.contest.EXPECT().GetContestTeam(anyCtx{}, contestID, teamID).Return(&domain.ContestTeamDetail{
+					ContestTeam: domain.ContestTeam{ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{ID: teamID, ContestID: contestID, Name: "team1", Result: "優勝"}},
+					Link:        "https://example.com",
+					Description: "team",
+				}, nil)
+				mr.contest.EXPECT().GetContestTeamMembers(anyCtx{}, contestID, teamID).Return([]*domain.User{member}, nil)
+
+				call := func(ctx context.Context, url string) (proto.Message, error) {
+					res, err := traportfoliov1connect.NewContestServiceClient(http.DefaultClient, url).
+						GetContestTeam(ctx, connect.NewRequest(&pb.GetContestTeamRequest{ContestId: contestID.String(), TeamId: teamID.String()}))
+					if err != nil {
+						return nil, err
+					}
+					return res.Msg, nil
+				}
+				want := &pb.GetContestTeamResponse{Team: &pb.ContestTeamDetail{
+					Team:        &pb.ContestTeam{Id: teamID.String(), Name: "team1", Result: "優勝", Members: []*pb.User{{Id: member.ID.String(), Name: "user1"}}},
+					Link:        "https://example.com",
+					Description: "team",
+				}}
+
+				return call, want
+			},
+		},
+		{
+			name: "success: ListContests",
+			setup: func(mr MockRepository) (func(ctx context.Context, url string) (proto.Message, error), proto.Message) {
+				contest := &domain.Contest{
+					ID:        random.UUID(),
+					Name:      "contest1",
+					TimeStart: time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC),
+					TimeEnd:   time.Date(2022, 8, 2, 0, 0, 0, 0, time.UTC),
+				}
+				mr.contest.EXPECT().GetContests(anyCtx{}, &repository.GetContestsArgs{}).Return([]*domain.Contest{contest}, nil)
+
+				call := func(ctx context.Context, url string) (proto.Message, error) {
+					res, err := traportfoliov1connect.NewContestServiceClient(http.DefaultClient, url).
+						ListContests(ctx, connect.NewRequest(&pb.ListContestsRequest{}))
+					if err != nil {
+						return nil, err
+					}
+					return res.Msg, nil
+				}
+				want := &pb.ListContestsResponse{Contests: []*pb.Contest{{
+					Id:       contest.ID.String(),
+					Name:     "contest1",
+					Duration: &pb.Duration{Since: timestamppb.New(contest.TimeStart), Until: timestamppb.New(contest.TimeEnd)},
+				}}}
+
+				return call, want
+			},
+		},
+		{
+			name: "success: GetGroup fills members with user names",
+			setup: func(mr MockRepository) (func(ctx context.Context, url string) (proto.Message, error), proto.Message) {
+				user := domain.NewUser(random.UUID(), "user1", "User One", true)
+				groupID := random.UUID()
+				mr.group.EXPECT().GetGroup(anyCtx{}, groupID).Return(&domain.GroupDetail{
+					ID:      groupID,
+					Name:    "group1",
+					Admin:   []*domain.User{{ID: user.ID}},
+					Members: []*domain.UserWithDuration{{User: domain.User{ID: user.ID}, Duration: domain.NewYearWithSemesterDuration(2021, 0, 2021, 1)}},
+				}, nil)
+				mr.user.EXPECT().GetUsers(anyCtx{}, &repository.GetUsersArgs{}).Return([]*domain.User{user}, nil)
+
+				call := func(ctx context.Context, url string) (proto.Message, error) {
+					res, err := traportfoliov1connect.NewGroupServiceClient(http.DefaultClient, url).
+						GetGroup(ctx, connect.NewRequest(&pb.GetGroupRequest{Id: groupID.String()}))
+					if err != nil {
+						return nil, err
+					}
+					return res.Msg, nil
+				}
+				pbUser := &pb.User{Id: user.ID.String(), Name: "user1", RealName: "User One"}
+				want := &pb.GetGroupResponse{Group: &pb.GroupDetail{
+					Group:  &pb.Group{Id: groupID.String(), Name: "group1"},
+					Admins: []*pb.User{pbUser},
+					Members: []*pb.UserWithDuration{{
+						User:     pbUser,
+						Duration: &pb.YearWithSemesterDuration{Since: &pb.YearWithSemester{Year: 2021, Semester: 0}, Until: &pb.YearWithSemester{Year: 2021, Semester: 1}},
+					}},
+				}}
+
+				return call, want
+			},
+		},
+		{
+			name: "not found",
+			setup: func(mr MockRepository) (func(ctx context.Context, url string) (proto.Message, error), proto.Message) {
+				projectID := random.UUID()
+				mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(nil, repository.ErrNotFound)
+
+				call := func(ctx context.Context, url string) (proto.Message, error) {
+					_, err := traportfoliov1connect.NewProjectServiceClient(http.DefaultClient, url).
+						GetProject(ctx, connect.NewRequest(&pb.GetProjectRequest{Id: projectID.String()}))
+					return nil, err
+				}
+
+				return call, nil
+			},
+			code: connect.CodeNotFound,
+		},
+		{
+			name: "invalid id",
+			setup: func(_ MockRepository) (func(ctx context.Context, url string) (proto.Message, error), proto.Message) {
+				call := func(ctx context.Context, url string) (proto.Message, error) {
+					_, err := traportfoliov1connect.NewUserServiceClient(http.DefaultClient, url).
+						GetUser(ctx, connect.NewRequest(&pb.GetUserRequest{Id: invalidID}))
+					return nil, err
+				}
+
+				return call, nil
+			},
+			code: connect.CodeInvalidArgument,
+		},
+		{
+			name: "internal error",
+			setup: func(mr MockRepository) (func(ctx context.Context, url string) (proto.Message, error), proto.Message) {
+				mr.group.EXPECT().GetGroups(anyCtx{}, &repository.GetGroupsArgs{}).Return(nil, errInternal)
+
+				call := func(ctx context.Context, url string) (proto.Message, error) {
+					_, err := traportfoliov1connect.NewGroupServiceClient(http.DefaultClient, url).
+						ListGroups(ctx, connect.NewRequest(&pb.ListGroupsRequest{}))
+					return nil, err
+				}
+
+				return call, nil
+			},
+			code: connect.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, s := setupRPCMock(t)
+
+			call, want := tt.setup(mr)
+			got, err := call(context.Background(), s.URL)
+
+			// Assertion
+			if tt.code != 0 {
+				assert.Equal(t, tt.code, connect.CodeOf(err))
+				if tt.code == connect.CodeInternal {
+					assert.NotContains(t, err.Error(), errInternal.Error())
+				}
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.True(t, proto.Equal(want, got), "want %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestRPCHandler_GRPC(t *testing.T) {
+	t.Parallel()
+
+	mr, s := setupRPCMock(t)
+	projectID := random.UUID()
+	mr.project.EXPECT().GetProject(anyCtx{}, projectID).Return(&domain.ProjectDetail{
+		Project: domain.Project{ID: projectID, Name: "project1", Duration: domain.NewYearWithSemesterDuration(2022, 0, 0, 0)},
+		Link:    "https://example.com",
+	}, nil)
+
+	// gRPCのクライアントと同様に、TLSを使わないHTTP/2で接続する
+	client := &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		},
+	}}
+	res, err := traportfoliov1connect.NewProjectServiceClient(client, s.URL, connect.WithGRPC()).
+		GetProject(context.Background(), connect.NewRequest(&pb.GetProjectRequest{Id: projectID.String()}))
+
+	if assert.NoError(t, err) {
+		want := &pb.GetProjectResponse{Project: &pb.ProjectDetail{
+			Project: &pb.Project{Id: projectID.String(), Name: "project1", Duration: &pb.YearWithSemesterDuration{Since: &pb.YearWithSemester{Year: 2022}}},
+			Link:    "https://example.com",
+		}}
+		assert.True(t, proto.Equal(want, res.Msg), "want %v, got %v", want, res.Msg)
+	}
+}
@@ -4,6 +4,7 @@ package schema
 
 import (
 	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/markdown"
 )
 
 func ConvertDuration(d domain.YearWithSemesterDuration) YearWithSemesterDuration {
@@ -29,3 +30,15 @@ func ConvertDuration(d domain.YearWithSemesterDuration) YearWithSemesterDuration
 		Until: &until,
 	}
 }
+
+func ConvertMarkdown(src string) (Markdown, error) {
+	html, err := markdown.ToHTML(src)
+	if err != nil {
+		return Markdown{}, err
+	}
+
+	return Markdown{
+		Source: src,
+		Html:   html,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid"
+)
+
+// IDList カンマ区切りのUUIDのクエリパラメーター
+type IDList []uuid.UUID
+
+// UnmarshalText Echoのクエリパラメーターのバインドで使われる
+func (l *IDList) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*l = IDList{}
+		return nil
+	}
+
+	parts := strings.Split(string(text), ",")
+	ids := make(IDList, len(parts))
+	for i, p := range parts {
+		id, err := uuid.FromString(p)
+		if err != nil {
+			return err
+		}
+		ids[i] = id
+	}
+	*l = ids
+
+	return nil
+}
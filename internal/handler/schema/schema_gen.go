@@ -9,6 +9,32 @@ import (
 	"github.com/gofrs/uuid"
 )
 
+// Defines values for ContestTeamImportAction.
+const (
+	Create ContestTeamImportAction = "create"
+	Update ContestTeamImportAction = "update"
+)
+
+// Defines values for CvSection.
+const (
+	Accounts CvSection = "accounts"
+	Contests CvSection = "contests"
+	Groups   CvSection = "groups"
+	Profile  CvSection = "profile"
+	Projects CvSection = "projects"
+)
+
+// Defines values for OEmbedType.
+const (
+	Link OEmbedType = "link"
+)
+
+// Defines values for OEmbedFormat.
+const (
+	JSON OEmbedFormat = "json"
+	XML  OEmbedFormat = "xml"
+)
+
 // Defines values for Semester.
 const (
 	First  Semester = 0
@@ -27,25 +53,83 @@ type Account struct {
 	// DisplayName 外部アカウントの表示名
 	DisplayName string `json:"displayName"`
 
+	// Handle アカウントのハンドル。ハンドルを持たない種類の場合は空文字列
+	Handle string `json:"handle"`
+
 	// Id アカウントUUID
 	Id uuid.UUID `json:"id"`
 
 	// Type アカウントの種類
+	// 利用できる種類とそのIDは`GET /account-types`で取得できます
+	// 組み込みの種類のIDは以下の通りで、変更されることはありません
+	// 0: ホームページ, 1: ブログ, 2: Twitter, 3: Facebook, 4: pixiv, 5: GitHub, 6: Qiita, 7: Zenn,
+	// 8: AtCoder, 9: SoundCloud, 10: Hack The Box, 11: CTFtime, 12: Bluesky, 13: mixi2
 	Type AccountType `json:"type"`
 
-	// Url アカウントurl
+	// Url アカウントurl(正規化済み)
 	Url string `json:"url"`
 }
 
+// AccountStats 外部サービスから取得したアカウントの成績
+type AccountStats struct {
+	// Color レーティングに対応する色
+	Color string `json:"color"`
+
+	// FetchedAt 成績を取得した日時
+	FetchedAt time.Time `json:"fetchedAt"`
+
+	// MaxRating 最高レーティング
+	MaxRating int `json:"maxRating"`
+
+	// RatedContests レーティングの対象となったコンテストへの参加回数
+	RatedContests int `json:"ratedContests"`
+
+	// Rating 現在のレーティング
+	Rating int `json:"rating"`
+}
+
 // AccountType アカウントの種類
+// 利用できる種類とそのIDは`GET /account-types`で取得できます
+// 組み込みの種類のIDは以下の通りで、変更されることはありません
+// 0: ホームページ, 1: ブログ, 2: Twitter, 3: Facebook, 4: pixiv, 5: GitHub, 6: Qiita, 7: Zenn,
+// 8: AtCoder, 9: SoundCloud, 10: Hack The Box, 11: CTFtime, 12: Bluesky, 13: mixi2
 type AccountType = uint8
 
+// AccountTypeDefinition 外部アカウントの種類(サービス)の定義
+type AccountTypeDefinition struct {
+	// HandlePattern URLからハンドルを取り出す正規表現。最初のグループがハンドルになります。ハンドルを持たない種類では省略されます
+	HandlePattern *string `json:"handlePattern,omitempty"`
+
+	// Icon アイコンのキー
+	Icon string `json:"icon"`
+
+	// Id アカウントの種類
+	// 利用できる種類とそのIDは`GET /account-types`で取得できます
+	// 組み込みの種類のIDは以下の通りで、変更されることはありません
+	// 0: ホームページ, 1: ブログ, 2: Twitter, 3: Facebook, 4: pixiv, 5: GitHub, 6: Qiita, 7: Zenn,
+	// 8: AtCoder, 9: SoundCloud, 10: Hack The Box, 11: CTFtime, 12: Bluesky, 13: mixi2
+	Id AccountType `json:"id"`
+
+	// Label 表示名
+	Label string `json:"label"`
+
+	// UrlPattern アカウントのURLが満たすべき正規表現
+	UrlPattern string `json:"urlPattern"`
+
+	// UrlTemplate ハンドルから正規化されたURLを組み立てるテンプレート。`{handle}`がハンドルに置き換えられます。ハンドルを持たない種類では省略されます
+	UrlTemplate *string `json:"urlTemplate,omitempty"`
+}
+
 // AddAccountRequest 新規アカウントリクエスト
 type AddAccountRequest struct {
 	// DisplayName 外部アカウントの表示名
 	DisplayName string `json:"displayName"`
 
 	// Type アカウントの種類
+	// 利用できる種類とそのIDは`GET /account-types`で取得できます
+	// 組み込みの種類のIDは以下の通りで、変更されることはありません
+	// 0: ホームページ, 1: ブログ, 2: Twitter, 3: Facebook, 4: pixiv, 5: GitHub, 6: Qiita, 7: Zenn,
+	// 8: AtCoder, 9: SoundCloud, 10: Hack The Box, 11: CTFtime, 12: Bluesky, 13: mixi2
 	Type AccountType `json:"type"`
 
 	// Url アカウントurl
@@ -54,17 +138,38 @@ type AddAccountRequest struct {
 
 // AddContestTeamRequest 新規コンテストチームリクエスト
 type AddContestTeamRequest struct {
+	// Body Markdownで書かれた詳細な説明
+	Body *string `json:"body,omitempty"`
+
 	// Description チーム情報
 	Description string `json:"description"`
 
+	// DescriptionEn 英語のチーム情報
+	DescriptionEn *string `json:"descriptionEn,omitempty"`
+
 	// Link コンテストチームの説明が載っているページへのリンク
 	Link *string `json:"link,omitempty"`
 
 	// Name チーム名
 	Name string `json:"name"`
 
+	// NameEn 英語のチーム名
+	NameEn *string `json:"nameEn,omitempty"`
+
 	// Result 順位などの結果
 	Result *string `json:"result,omitempty"`
+
+	// Visibility 公開範囲設定
+	// 0 全て公開
+	// 1 メンバーを伏せて公開
+	// 2 外部に非公開
+	Visibility *Visibility `json:"visibility,omitempty"`
+}
+
+// BatchRequest UUIDを指定した一括取得リクエスト
+type BatchRequest struct {
+	// Ids 取得するもののUUIDの配列(この順に返す)
+	Ids []uuid.UUID `json:"ids"`
 }
 
 // Contest コンテスト情報
@@ -81,9 +186,15 @@ type Contest struct {
 
 // ContestDetail defines model for ContestDetail.
 type ContestDetail struct {
+	// Body Markdownで書かれた本文とそれをサニタイズしたHTML
+	Body Markdown `json:"body"`
+
 	// Description コンテストの説明
 	Description string `json:"description"`
 
+	// Draft 公開前の下書き(公開予約中を含む)かどうか。下書きはメンバーのみが閲覧できます
+	Draft bool `json:"draft"`
+
 	// Duration イベントやコンテストなどの存続期間
 	Duration Duration `json:"duration"`
 
@@ -96,8 +207,17 @@ type ContestDetail struct {
 	// Name コンテスト名
 	Name string `json:"name"`
 
+	// PublishAt 公開(予定)日時
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+
 	// Teams コンテストチーム
 	Teams []ContestTeam `json:"teams"`
+
+	// Visibility 公開範囲設定
+	// 0 全て公開
+	// 1 メンバーを伏せて公開
+	// 2 外部に非公開
+	Visibility Visibility `json:"visibility"`
 }
 
 // ContestTeam defines model for ContestTeam.
@@ -117,6 +237,9 @@ type ContestTeam struct {
 
 // ContestTeamDetail defines model for ContestTeamDetail.
 type ContestTeamDetail struct {
+	// Body Markdownで書かれた本文とそれをサニタイズしたHTML
+	Body Markdown `json:"body"`
+
 	// Description チーム情報
 	Description string `json:"description"`
 
@@ -134,6 +257,62 @@ type ContestTeamDetail struct {
 
 	// Result 順位などの結果
 	Result string `json:"result"`
+
+	// Visibility 公開範囲設定
+	// 0 全て公開
+	// 1 メンバーを伏せて公開
+	// 2 外部に非公開
+	Visibility Visibility `json:"visibility"`
+}
+
+// ContestTeamImportAction 一括登録の各行で行われる操作
+// create 新しく作成
+// update 同じ名前のチームを更新
+type ContestTeamImportAction string
+
+// ContestTeamImportResult コンテストチームの一括登録の結果
+type ContestTeamImportResult struct {
+	// Applied 変更が適用されたかどうか
+	Applied bool                         `json:"applied"`
+	Rows    []ContestTeamImportRowResult `json:"rows"`
+}
+
+// ContestTeamImportRow 一括登録する1チーム分の情報。既存のチームを更新する場合は指定した項目だけを更新します
+type ContestTeamImportRow struct {
+	// Description チーム情報
+	Description *string `json:"description,omitempty"`
+
+	// Link コンテストチームの説明が載っているページへのリンク
+	Link *string `json:"link,omitempty"`
+
+	// Members メンバーのtraQ ID。指定した場合は所属するメンバーを置き換えます
+	Members *[]string `json:"members,omitempty"`
+
+	// Name チーム名。コンテストに同じ名前のチームがある場合はそのチームを更新します
+	Name string `json:"name"`
+
+	// Result 順位などの結果
+	Result *string `json:"result,omitempty"`
+}
+
+// ContestTeamImportRowResult 一括登録の1行分の結果
+type ContestTeamImportRowResult struct {
+	// Action 一括登録の各行で行われる操作
+	// create 新しく作成
+	// update 同じ名前のチームを更新
+	Action ContestTeamImportAction `json:"action"`
+
+	// Errors この行のエラー
+	Errors []string `json:"errors"`
+
+	// Name チーム名
+	Name string `json:"name"`
+
+	// Row 1から始まる行番号。CSVの場合は見出し行を含めません
+	Row int `json:"row"`
+
+	// TeamId コンテストチームUUID。dry runで新しく作成するチームの場合は省略されます
+	TeamId *uuid.UUID `json:"teamId,omitempty"`
 }
 
 // ContestTeamWithoutMembers コンテストチーム情報(チームメンバーなし)
@@ -148,11 +327,29 @@ type ContestTeamWithoutMembers struct {
 	Result string `json:"result"`
 }
 
+// ContestsBatch コンテストの一括取得結果
+type ContestsBatch struct {
+	// Contests リクエストした順のコンテスト
+	Contests []Contest `json:"contests"`
+
+	// Missing 存在しないか閲覧できないため、結果に含まれなかったUUID(リクエストした順)
+	Missing MissingIds `json:"missing"`
+}
+
 // CreateContestRequest 新規コンテストリクエスト
 type CreateContestRequest struct {
+	// Body Markdownで書かれた詳細な説明
+	Body *string `json:"body,omitempty"`
+
 	// Description コンテスト説明
 	Description string `json:"description"`
 
+	// DescriptionEn 英語のコンテスト説明
+	DescriptionEn *string `json:"descriptionEn,omitempty"`
+
+	// Draft 下書きとして作成するかどうか。下書きはメンバーのみが閲覧でき、公開APIで公開します
+	Draft *bool `json:"draft,omitempty"`
+
 	// Duration イベントやコンテストなどの存続期間
 	Duration Duration `json:"duration"`
 
@@ -161,13 +358,34 @@ type CreateContestRequest struct {
 
 	// Name コンテスト名
 	Name string `json:"name"`
+
+	// NameEn 英語のコンテスト名
+	NameEn *string `json:"nameEn,omitempty"`
+
+	// PublishAt 下書きの公開予定日時。draftがtrueの場合のみ指定できます
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+
+	// Visibility 公開範囲設定
+	// 0 全て公開
+	// 1 メンバーを伏せて公開
+	// 2 外部に非公開
+	Visibility *Visibility `json:"visibility,omitempty"`
 }
 
 // CreateProjectRequest 新規プロジェクトリクエスト
 type CreateProjectRequest struct {
+	// Body Markdownで書かれた詳細な説明
+	Body *string `json:"body,omitempty"`
+
 	// Description プロジェクト説明
 	Description string `json:"description"`
 
+	// DescriptionEn 英語のプロジェクト説明
+	DescriptionEn *string `json:"descriptionEn,omitempty"`
+
+	// Draft 下書きとして作成するかどうか。下書きはメンバーのみが閲覧でき、公開APIで公開します
+	Draft *bool `json:"draft,omitempty"`
+
 	// Duration 班やプロジェクトの期間
 	// 年と前期/後期がある
 	// untilがなかった場合存続中
@@ -178,8 +396,28 @@ type CreateProjectRequest struct {
 
 	// Name プロジェクト名
 	Name string `json:"name"`
+
+	// NameEn 英語のプロジェクト名
+	NameEn *string `json:"nameEn,omitempty"`
+
+	// PublishAt 下書きの公開予定日時。draftがtrueの場合のみ指定できます
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+
+	// Visibility 公開範囲設定
+	// 0 全て公開
+	// 1 メンバーを伏せて公開
+	// 2 外部に非公開
+	Visibility *Visibility `json:"visibility,omitempty"`
 }
 
+// CvSection PDF形式の履歴書に含める項目
+// profile プロフィール
+// accounts アカウント
+// projects プロジェクト
+// contests コンテスト
+// groups 班
+type CvSection string
+
 // Duration イベントやコンテストなどの存続期間
 type Duration struct {
 	// Since 期間始まり
@@ -192,9 +430,15 @@ type Duration struct {
 
 // EditContestRequest コンテスト情報変更リクエスト
 type EditContestRequest struct {
+	// Body Markdownで書かれた詳細な説明
+	Body *string `json:"body,omitempty"`
+
 	// Description コンテスト説明
 	Description *string `json:"description,omitempty"`
 
+	// DescriptionEn 英語のコンテスト説明
+	DescriptionEn *string `json:"descriptionEn,omitempty"`
+
 	// Duration イベントやコンテストなどの存続期間
 	Duration *Duration `json:"duration,omitempty"`
 
@@ -203,6 +447,15 @@ type EditContestRequest struct {
 
 	// Name コンテスト名
 	Name *string `json:"name,omitempty"`
+
+	// NameEn 英語のコンテスト名
+	NameEn *string `json:"nameEn,omitempty"`
+
+	// Visibility 公開範囲設定
+	// 0 全て公開
+	// 1 メンバーを伏せて公開
+	// 2 外部に非公開
+	Visibility *Visibility `json:"visibility,omitempty"`
 }
 
 // EditContestTeamMembersRequest コンテストチームメンバー修正リクエスト
@@ -213,17 +466,32 @@ type EditContestTeamMembersRequest struct {
 
 // EditContestTeamRequest コンテストチーム情報修正リクエスト
 type EditContestTeamRequest struct {
+	// Body Markdownで書かれた詳細な説明
+	Body *string `json:"body,omitempty"`
+
 	// Description チーム情報
 	Description *string `json:"description,omitempty"`
 
+	// DescriptionEn 英語のチーム情報
+	DescriptionEn *string `json:"descriptionEn,omitempty"`
+
 	// Link コンテストチームの説明が載っているページへのリンク
 	Link *string `json:"link,omitempty"`
 
 	// Name チーム名
 	Name *string `json:"name,omitempty"`
 
+	// NameEn 英語のチーム名
+	NameEn *string `json:"nameEn,omitempty"`
+
 	// Result 順位などの結果
 	Result *string `json:"result,omitempty"`
+
+	// Visibility 公開範囲設定
+	// 0 全て公開
+	// 1 メンバーを伏せて公開
+	// 2 外部に非公開
+	Visibility *Visibility `json:"visibility,omitempty"`
 }
 
 // EditEventRequest イベント情報修正リクエスト
@@ -242,9 +510,15 @@ type EditProjectMembersRequest struct {
 
 // EditProjectRequest プロジェクト変更リクエスト
 type EditProjectRequest struct {
+	// Body Markdownで書かれた詳細な説明
+	Body *string `json:"body,omitempty"`
+
 	// Description プロジェクト説明
 	Description *string `json:"description,omitempty"`
 
+	// DescriptionEn 英語のプロジェクト説明
+	DescriptionEn *string `json:"descriptionEn,omitempty"`
+
 	// Duration 班やプロジェクトの期間
 	// 年と前期/後期がある
 	// untilがなかった場合存続中
@@ -255,6 +529,15 @@ type EditProjectRequest struct {
 
 	// Name プロジェクト名
 	Name *string `json:"name,omitempty"`
+
+	// NameEn 英語のプロジェクト名
+	NameEn *string `json:"nameEn,omitempty"`
+
+	// Visibility 公開範囲設定
+	// 0 全て公開
+	// 1 メンバーを伏せて公開
+	// 2 外部に非公開
+	Visibility *Visibility `json:"visibility,omitempty"`
 }
 
 // EditUserAccountRequest アカウント変更リクエスト
@@ -263,17 +546,30 @@ type EditUserAccountRequest struct {
 	DisplayName *string `json:"displayName,omitempty"`
 
 	// Type アカウントの種類
+	// 利用できる種類とそのIDは`GET /account-types`で取得できます
+	// 組み込みの種類のIDは以下の通りで、変更されることはありません
+	// 0: ホームページ, 1: ブログ, 2: Twitter, 3: Facebook, 4: pixiv, 5: GitHub, 6: Qiita, 7: Zenn,
+	// 8: AtCoder, 9: SoundCloud, 10: Hack The Box, 11: CTFtime, 12: Bluesky, 13: mixi2
 	Type *AccountType `json:"type,omitempty"`
 
 	// Url アカウントurl
 	Url *string `json:"url,omitempty"`
 }
 
+// EditUserFeaturedItemsRequest 固定表示するプロジェクトやコンテストの変更リクエスト
+type EditUserFeaturedItemsRequest struct {
+	// Items 固定表示するもの(表示順)
+	Items []FeaturedItemID `json:"items"`
+}
+
 // EditUserRequest ユーザー情報変更リクエスト
 type EditUserRequest struct {
 	// Bio 自己紹介(biography)
 	Bio *string `json:"bio,omitempty"`
 
+	// BioEn 英語の自己紹介(biography)
+	BioEn *string `json:"bioEn,omitempty"`
+
 	// Check 本名を公開するかどうか
 	// true: 公開
 	// false: 非公開
@@ -331,6 +627,50 @@ type EventDetail struct {
 // 2 外部に非公開
 type EventLevel = uint8
 
+// FeaturedItemID 固定表示するプロジェクトやコンテストチーム
+type FeaturedItemID struct {
+	// Id プロジェクトuuidまたはコンテストチームuuid
+	Id uuid.UUID `json:"id"`
+
+	// Type 固定表示するものの種類
+	// 0 プロジェクト
+	// 1 コンテストチーム
+	Type FeaturedItemType `json:"type"`
+}
+
+// FeaturedItemType 固定表示するものの種類
+// 0 プロジェクト
+// 1 コンテストチーム
+type FeaturedItemType = uint8
+
+// GitHubRepository プロジェクトのリンク先のGitHubリポジトリの情報
+// 定期的に取得しているため、最新の情報とは限りません
+type GitHubRepository struct {
+	// Description リポジトリの説明
+	Description string `json:"description"`
+
+	// FetchedAt 情報を取得した日時
+	FetchedAt time.Time `json:"fetchedAt"`
+
+	// Languages 主な言語(コード量の多い順)
+	Languages []string `json:"languages"`
+
+	// License ライセンスのSPDX識別子。不明な場合は空文字列
+	License string `json:"license"`
+
+	// Name リポジトリ名
+	Name string `json:"name"`
+
+	// Owner リポジトリのオーナー
+	Owner string `json:"owner"`
+
+	// PushedAt 最終push日時
+	PushedAt time.Time `json:"pushedAt"`
+
+	// Stars スター数
+	Stars int `json:"stars"`
+}
+
 // Group 班情報
 type Group struct {
 	// Id 班uuid
@@ -378,6 +718,21 @@ type GroupMember struct {
 	RealName string `json:"realName"`
 }
 
+// ImportContestTeamsRequest コンテストチームの一括登録リクエスト
+type ImportContestTeamsRequest struct {
+	// Rows 登録するチーム。最大100件
+	Rows []ContestTeamImportRow `json:"rows"`
+}
+
+// Markdown Markdownで書かれた本文とそれをサニタイズしたHTML
+type Markdown struct {
+	// Html サニタイズ済みのHTML
+	Html string `json:"html"`
+
+	// Source Markdownのソース
+	Source string `json:"source"`
+}
+
 // MemberIDWithYearWithSemesterDuration プロジェクトメンバーのユーザーUUID(期間含む)
 type MemberIDWithYearWithSemesterDuration struct {
 	// Duration 班やプロジェクトの期間
@@ -387,6 +742,41 @@ type MemberIDWithYearWithSemesterDuration struct {
 	UserId   uuid.UUID                `json:"userId"`
 }
 
+// MissingIds 存在しないか閲覧できないため、結果に含まれなかったUUID(リクエストした順)
+type MissingIds = []uuid.UUID
+
+// OEmbed oEmbed 1.0のlink形式のレスポンス
+type OEmbed struct {
+	// AuthorName ユーザーの場合のみ、traQ ID
+	AuthorName *string `json:"author_name,omitempty"`
+
+	// ProviderName 常にtraPortfolio
+	ProviderName string `json:"provider_name"`
+
+	// ProviderUrl フロントエンドのURLが設定されている場合のみ
+	ProviderUrl     *string `json:"provider_url,omitempty"`
+	ThumbnailHeight int     `json:"thumbnail_height"`
+
+	// ThumbnailUrl プレビュー画像のURL
+	ThumbnailUrl   string `json:"thumbnail_url"`
+	ThumbnailWidth int    `json:"thumbnail_width"`
+
+	// Title ユーザー名またはプロジェクト名
+	Title string `json:"title"`
+
+	// Type 常にlink
+	Type OEmbedType `json:"type"`
+
+	// Version 常に1.0
+	Version string `json:"version"`
+}
+
+// OEmbedType 常にlink
+type OEmbedType string
+
+// OEmbedFormat oEmbedのレスポンスの形式
+type OEmbedFormat string
+
 // Project プロジェクト情報
 type Project struct {
 	// Duration 班やプロジェクトの期間
@@ -403,14 +793,24 @@ type Project struct {
 
 // ProjectDetail defines model for ProjectDetail.
 type ProjectDetail struct {
+	// Body Markdownで書かれた本文とそれをサニタイズしたHTML
+	Body Markdown `json:"body"`
+
 	// Description プロジェクト説明
 	Description string `json:"description"`
 
+	// Draft 公開前の下書き(公開予約中を含む)かどうか。下書きはメンバーのみが閲覧できます
+	Draft bool `json:"draft"`
+
 	// Duration 班やプロジェクトの期間
 	// 年と前期/後期がある
 	// untilがなかった場合存続中
 	Duration YearWithSemesterDuration `json:"duration"`
 
+	// GithubRepository プロジェクトのリンク先のGitHubリポジトリの情報
+	// 定期的に取得しているため、最新の情報とは限りません
+	GithubRepository *GitHubRepository `json:"githubRepository,omitempty"`
+
 	// Id プロジェクトuuid
 	Id uuid.UUID `json:"id"`
 
@@ -422,6 +822,15 @@ type ProjectDetail struct {
 
 	// Name プロジェクト名
 	Name string `json:"name"`
+
+	// PublishAt 公開(予定)日時
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+
+	// Visibility 公開範囲設定
+	// 0 全て公開
+	// 1 メンバーを伏せて公開
+	// 2 外部に非公開
+	Visibility Visibility `json:"visibility"`
 }
 
 // ProjectMember defines model for ProjectMember.
@@ -441,6 +850,120 @@ type ProjectMember struct {
 	RealName string `json:"realName"`
 }
 
+// ProjectsBatch プロジェクトの一括取得結果
+type ProjectsBatch struct {
+	// Missing 存在しないか閲覧できないため、結果に含まれなかったUUID(リクエストした順)
+	Missing MissingIds `json:"missing"`
+
+	// Projects リクエストした順のプロジェクト
+	Projects []Project `json:"projects"`
+}
+
+// PublishRequest 下書きの公開リクエスト
+type PublishRequest struct {
+	// PublishAt 公開予定日時。省略した場合や過去の日時を指定した場合は即時に公開します
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+}
+
+// Resume JSON Resume形式のポートフォリオ
+type Resume struct {
+	// Awards コンテストへの参加と結果
+	Awards []ResumeAward `json:"awards"`
+
+	// Basics JSON Resumeの基本情報
+	Basics ResumeBasics `json:"basics"`
+
+	// Projects 参加したプロジェクト
+	Projects []ResumeProject `json:"projects"`
+
+	// Volunteer 所属した班
+	Volunteer []ResumeVolunteer `json:"volunteer"`
+}
+
+// ResumeAward JSON Resumeの受賞歴。コンテストのチームごとに1つ
+type ResumeAward struct {
+	// Awarder コンテスト名
+	Awarder string `json:"awarder"`
+
+	// Date コンテストの開始日(YYYY-MM-DD)
+	Date string `json:"date"`
+
+	// Summary チームの結果
+	Summary string `json:"summary"`
+
+	// Title コンテスト名とチーム名
+	Title string `json:"title"`
+}
+
+// ResumeBasics JSON Resumeの基本情報
+type ResumeBasics struct {
+	// Name 本名の公開を許可している場合は本名、そうでない場合はユーザー名
+	Name string `json:"name"`
+
+	// Profiles 外部アカウント
+	Profiles []ResumeProfile `json:"profiles"`
+
+	// Summary 自己紹介
+	Summary string `json:"summary"`
+}
+
+// ResumeProfile JSON Resumeの外部アカウント
+type ResumeProfile struct {
+	// Network サービス名
+	Network string `json:"network"`
+
+	// Url アカウントのURL
+	Url string `json:"url"`
+
+	// Username ハンドル。ハンドルを持たない種類の場合は表示名
+	Username string `json:"username"`
+}
+
+// ResumeProject JSON Resumeのプロジェクト
+type ResumeProject struct {
+	// EndDate ユーザーがプロジェクトから離れた時期(YYYY-MM)。参加中の場合は省略されます
+	EndDate *string `json:"endDate,omitempty"`
+
+	// Name プロジェクト名
+	Name string `json:"name"`
+
+	// StartDate ユーザーがプロジェクトに参加した時期(YYYY-MM)
+	StartDate string `json:"startDate"`
+}
+
+// ResumeVolunteer JSON Resumeのボランティア活動。班への所属を表します
+type ResumeVolunteer struct {
+	// EndDate 班から離れた時期(YYYY-MM)。所属中の場合は省略されます
+	EndDate *string `json:"endDate,omitempty"`
+
+	// Organization 班名
+	Organization string `json:"organization"`
+
+	// StartDate 班に所属した時期(YYYY-MM)
+	StartDate string `json:"startDate"`
+}
+
+// Revision 編集履歴の版
+type Revision struct {
+	// CreatedAt 版の作成日時
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Revision 1から始まる版番号
+	Revision int `json:"revision"`
+}
+
+// RevisionFieldDiff 2つの版の間で値が異なるフィールド
+type RevisionFieldDiff struct {
+	// Field フィールド名
+	Field string `json:"field"`
+
+	// From 比較元の版での値。文字列以外の値はJSONで表現されます
+	From string `json:"from"`
+
+	// To 比較先の版での値。文字列以外の値はJSONで表現されます
+	To string `json:"to"`
+}
+
 // Semester 0: 前期
 // 1: 後期
 type Semester int32
@@ -486,6 +1009,9 @@ type UserDetail struct {
 	// Bio 自己紹介(biography)
 	Bio string `json:"bio"`
 
+	// Featured プロフィールの上部に固定表示するプロジェクトやコンテストへの参加(表示順)
+	Featured []UserFeaturedItem `json:"featured"`
+
 	// Id ユーザーUUID
 	Id uuid.UUID `json:"id"`
 
@@ -502,6 +1028,26 @@ type UserDetail struct {
 	State UserAccountState `json:"state"`
 }
 
+// UserFeaturedItem プロフィールの上部に固定表示するプロジェクトやコンテストへの参加
+type UserFeaturedItem struct {
+	// ContestId コンテストuuid(コンテストチームの場合のみ)
+	ContestId *uuid.UUID `json:"contestId,omitempty"`
+
+	// Id プロジェクトuuidまたはコンテストチームuuid
+	Id uuid.UUID `json:"id"`
+
+	// Name プロジェクト名またはコンテスト名
+	Name string `json:"name"`
+
+	// TeamName コンテストチーム名(コンテストチームの場合のみ)
+	TeamName *string `json:"teamName,omitempty"`
+
+	// Type 固定表示するものの種類
+	// 0 プロジェクト
+	// 1 コンテストチーム
+	Type FeaturedItemType `json:"type"`
+}
+
 // UserGroup defines model for UserGroup.
 type UserGroup struct {
 	// Duration 班やプロジェクトの期間
@@ -535,6 +1081,21 @@ type UserProject struct {
 	UserDuration YearWithSemesterDuration `json:"userDuration"`
 }
 
+// UsersBatch ユーザーの一括取得結果
+type UsersBatch struct {
+	// Missing 存在しないか閲覧できないため、結果に含まれなかったUUID(リクエストした順)
+	Missing MissingIds `json:"missing"`
+
+	// Users リクエストした順のユーザー
+	Users []User `json:"users"`
+}
+
+// Visibility 公開範囲設定
+// 0 全て公開
+// 1 メンバーを伏せて公開
+// 2 外部に非公開
+type Visibility = uint8
+
 // YearWithSemester 年度と前期/後期
 type YearWithSemester struct {
 	// Semester 0: 前期
@@ -560,27 +1121,66 @@ type AccountIdInPath = uuid.UUID
 // ContestIdInPath defines model for contestIdInPath.
 type ContestIdInPath = uuid.UUID
 
+// CvSectionsInQuery defines model for cvSectionsInQuery.
+type CvSectionsInQuery = []CvSection
+
+// DryRunInQuery defines model for dryRunInQuery.
+type DryRunInQuery = bool
+
 // EventIdInPath defines model for eventIdInPath.
 type EventIdInPath = uuid.UUID
 
+// FeedLimitInQuery defines model for feedLimitInQuery.
+type FeedLimitInQuery = int
+
+// FromRevisionInQuery defines model for fromRevisionInQuery.
+type FromRevisionInQuery = int
+
 // GroupIdInPath defines model for groupIdInPath.
 type GroupIdInPath = uuid.UUID
 
+// IdsInQuery defines model for idsInQuery.
+type IdsInQuery = IDList
+
+// IfMatchInHeader defines model for ifMatchInHeader.
+type IfMatchInHeader = string
+
+// IfNoneMatchInHeader defines model for ifNoneMatchInHeader.
+type IfNoneMatchInHeader = string
+
 // IncludeSuspendedInQuery defines model for includeSuspendedInQuery.
 type IncludeSuspendedInQuery = bool
 
 // LimitInQuery defines model for limitInQuery.
 type LimitInQuery = int
 
+// MaxHeightInQuery defines model for maxHeightInQuery.
+type MaxHeightInQuery = int
+
+// MaxWidthInQuery defines model for maxWidthInQuery.
+type MaxWidthInQuery = int
+
 // NameInQuery defines model for nameInQuery.
 type NameInQuery = string
 
+// OembedFormatInQuery oEmbedのレスポンスの形式
+type OembedFormatInQuery = OEmbedFormat
+
+// OembedUrlInQuery defines model for oembedUrlInQuery.
+type OembedUrlInQuery = string
+
 // ProjectIdInPath defines model for projectIdInPath.
 type ProjectIdInPath = uuid.UUID
 
+// RevisionInPath defines model for revisionInPath.
+type RevisionInPath = int
+
 // TeamIdInPath defines model for teamIdInPath.
 type TeamIdInPath = uuid.UUID
 
+// ToRevisionInQuery defines model for toRevisionInQuery.
+type ToRevisionInQuery = int
+
 // UserIdInPath defines model for userIdInPath.
 type UserIdInPath = uuid.UUID
 
@@ -588,6 +1188,75 @@ type UserIdInPath = uuid.UUID
 type GetContestsParams struct {
 	// Limit 取得数の上限
 	Limit *LimitInQuery `form:"limit,omitempty" json:"limit,omitempty" query:"limit"`
+
+	// Ids 取得するもののUUID(カンマ区切り、この順に返す)。limitとは同時に指定できない
+	Ids *IdsInQuery `form:"ids,omitempty" json:"ids,omitempty" query:"ids"`
+}
+
+// GetContestParams defines parameters for GetContest.
+type GetContestParams struct {
+	// IfNoneMatch 以前に取得したレスポンスのETag。リソースが変わっていない場合は304を返します
+	IfNoneMatch *IfNoneMatchInHeader `json:"If-None-Match,omitempty"`
+}
+
+// EditContestParams defines parameters for EditContest.
+type EditContestParams struct {
+	// IfMatch 編集前に取得したレスポンスのETag。指定した場合、その後にリソースが変更されていれば412を返します
+	IfMatch *IfMatchInHeader `json:"If-Match,omitempty"`
+}
+
+// ImportContestTeamsParams defines parameters for ImportContestTeams.
+type ImportContestTeamsParams struct {
+	// DryRun 変更を適用せずに結果だけを返すかどうか
+	DryRun *DryRunInQuery `form:"dryRun,omitempty" json:"dryRun,omitempty" query:"dryRun"`
+}
+
+// GetContestRevisionDiffParams defines parameters for GetContestRevisionDiff.
+type GetContestRevisionDiffParams struct {
+	// From 比較元の版番号
+	From FromRevisionInQuery `form:"from" json:"from" query:"from"`
+
+	// To 比較先の版番号
+	To ToRevisionInQuery `form:"to" json:"to" query:"to"`
+}
+
+// GetContestTeamsParams defines parameters for GetContestTeams.
+type GetContestTeamsParams struct {
+	// IfNoneMatch 以前に取得したレスポンスのETag。リソースが変わっていない場合は304を返します
+	IfNoneMatch *IfNoneMatchInHeader `json:"If-None-Match,omitempty"`
+}
+
+// GetContestTeamParams defines parameters for GetContestTeam.
+type GetContestTeamParams struct {
+	// IfNoneMatch 以前に取得したレスポンスのETag。リソースが変わっていない場合は304を返します
+	IfNoneMatch *IfNoneMatchInHeader `json:"If-None-Match,omitempty"`
+}
+
+// EditContestTeamParams defines parameters for EditContestTeam.
+type EditContestTeamParams struct {
+	// IfMatch 編集前に取得したレスポンスのETag。指定した場合、その後にリソースが変更されていれば412を返します
+	IfMatch *IfMatchInHeader `json:"If-Match,omitempty"`
+}
+
+// GetContestTeamMembersParams defines parameters for GetContestTeamMembers.
+type GetContestTeamMembersParams struct {
+	// IfNoneMatch 以前に取得したレスポンスのETag。リソースが変わっていない場合は304を返します
+	IfNoneMatch *IfNoneMatchInHeader `json:"If-None-Match,omitempty"`
+}
+
+// EditContestTeamMembersParams defines parameters for EditContestTeamMembers.
+type EditContestTeamMembersParams struct {
+	// IfMatch 編集前に取得したレスポンスのETag。指定した場合、その後にリソースが変更されていれば412を返します
+	IfMatch *IfMatchInHeader `json:"If-Match,omitempty"`
+}
+
+// GetContestTeamRevisionDiffParams defines parameters for GetContestTeamRevisionDiff.
+type GetContestTeamRevisionDiffParams struct {
+	// From 比較元の版番号
+	From FromRevisionInQuery `form:"from" json:"from" query:"from"`
+
+	// To 比較先の版番号
+	To ToRevisionInQuery `form:"to" json:"to" query:"to"`
 }
 
 // GetEventsParams defines parameters for GetEvents.
@@ -596,16 +1265,79 @@ type GetEventsParams struct {
 	Limit *LimitInQuery `form:"limit,omitempty" json:"limit,omitempty" query:"limit"`
 }
 
+// GetContestsFeedParams defines parameters for GetContestsFeed.
+type GetContestsFeedParams struct {
+	// Limit 取得数の上限
+	Limit *FeedLimitInQuery `form:"limit,omitempty" json:"limit,omitempty" query:"limit"`
+}
+
+// GetProjectsFeedParams defines parameters for GetProjectsFeed.
+type GetProjectsFeedParams struct {
+	// Limit 取得数の上限
+	Limit *FeedLimitInQuery `form:"limit,omitempty" json:"limit,omitempty" query:"limit"`
+}
+
 // GetGroupsParams defines parameters for GetGroups.
 type GetGroupsParams struct {
 	// Limit 取得数の上限
 	Limit *LimitInQuery `form:"limit,omitempty" json:"limit,omitempty" query:"limit"`
 }
 
+// GetOEmbedParams defines parameters for GetOEmbed.
+type GetOEmbedParams struct {
+	// Url プレビューするユーザーまたはプロジェクトのページのURL
+	Url OembedUrlInQuery `form:"url" json:"url" query:"url"`
+
+	// Format レスポンスの形式
+	Format *OembedFormatInQuery `form:"format,omitempty" json:"format,omitempty" query:"format"`
+
+	// Maxwidth 埋め込む要素の最大の幅。link形式では使われません
+	Maxwidth *MaxWidthInQuery `form:"maxwidth,omitempty" json:"maxwidth,omitempty" query:"maxwidth"`
+
+	// Maxheight 埋め込む要素の最大の高さ。link形式では使われません
+	Maxheight *MaxHeightInQuery `form:"maxheight,omitempty" json:"maxheight,omitempty" query:"maxheight"`
+}
+
 // GetProjectsParams defines parameters for GetProjects.
 type GetProjectsParams struct {
 	// Limit 取得数の上限
 	Limit *LimitInQuery `form:"limit,omitempty" json:"limit,omitempty" query:"limit"`
+
+	// Ids 取得するもののUUID(カンマ区切り、この順に返す)。limitとは同時に指定できない
+	Ids *IdsInQuery `form:"ids,omitempty" json:"ids,omitempty" query:"ids"`
+}
+
+// GetProjectParams defines parameters for GetProject.
+type GetProjectParams struct {
+	// IfNoneMatch 以前に取得したレスポンスのETag。リソースが変わっていない場合は304を返します
+	IfNoneMatch *IfNoneMatchInHeader `json:"If-None-Match,omitempty"`
+}
+
+// EditProjectParams defines parameters for EditProject.
+type EditProjectParams struct {
+	// IfMatch 編集前に取得したレスポンスのETag。指定した場合、その後にリソースが変更されていれば412を返します
+	IfMatch *IfMatchInHeader `json:"If-Match,omitempty"`
+}
+
+// GetProjectMembersParams defines parameters for GetProjectMembers.
+type GetProjectMembersParams struct {
+	// IfNoneMatch 以前に取得したレスポンスのETag。リソースが変わっていない場合は304を返します
+	IfNoneMatch *IfNoneMatchInHeader `json:"If-None-Match,omitempty"`
+}
+
+// EditProjectMembersParams defines parameters for EditProjectMembers.
+type EditProjectMembersParams struct {
+	// IfMatch 編集前に取得したレスポンスのETag。指定した場合、その後にリソースが変更されていれば412を返します
+	IfMatch *IfMatchInHeader `json:"If-Match,omitempty"`
+}
+
+// GetProjectRevisionDiffParams defines parameters for GetProjectRevisionDiff.
+type GetProjectRevisionDiffParams struct {
+	// From 比較元の版番号
+	From FromRevisionInQuery `form:"from" json:"from" query:"from"`
+
+	// To 比較先の版番号
+	To ToRevisionInQuery `form:"to" json:"to" query:"to"`
 }
 
 // GetUsersParams defines parameters for GetUsers.
@@ -618,4 +1350,31 @@ type GetUsersParams struct {
 
 	// Limit 取得数の上限
 	Limit *LimitInQuery `form:"limit,omitempty" json:"limit,omitempty" query:"limit"`
+
+	// Ids 取得するもののUUID(カンマ区切り、この順に返す)。limitとは同時に指定できない
+	Ids *IdsInQuery `form:"ids,omitempty" json:"ids,omitempty" query:"ids"`
+}
+
+// GetUserParams defines parameters for GetUser.
+type GetUserParams struct {
+	// IfNoneMatch 以前に取得したレスポンスのETag。リソースが変わっていない場合は304を返します
+	IfNoneMatch *IfNoneMatchInHeader `json:"If-None-Match,omitempty"`
+}
+
+// EditUserParams defines parameters for EditUser.
+type EditUserParams struct {
+	// IfMatch 編集前に取得したレスポンスのETag。指定した場合、その後にリソースが変更されていれば412を返します
+	IfMatch *IfMatchInHeader `json:"If-Match,omitempty"`
+}
+
+// GetUserCvParams defines parameters for GetUserCv.
+type GetUserCvParams struct {
+	// Sections 履歴書に含める項目
+	Sections *CvSectionsInQuery `form:"sections,omitempty" json:"sections,omitempty" query:"sections"`
+}
+
+// EditUserFeaturedItemsParams defines parameters for EditUserFeaturedItems.
+type EditUserFeaturedItemsParams struct {
+	// IfMatch 編集前に取得したレスポンスのETag。指定した場合、その後にリソースが変更されていれば412を返します
+	IfMatch *IfMatchInHeader `json:"If-Match,omitempty"`
 }
@@ -12,28 +12,85 @@ var (
 	vdRuleNameLength        = vd.RuneLength(1, 32)
 	vdRuleDisplayNameLength = vd.RuneLength(1, 256) // 外部アカウントのアカウント名文字数上限
 	vdRuleDescriptionLength = vd.RuneLength(1, 256)
+	vdRuleBodyLength        = vd.RuneLength(0, 10000) // Markdownの本文文字数上限
 	vdRuleResultLength      = vd.RuneLength(0, 32)
-	vdRuleAccountTypeMax    = vd.Max(domain.AccountLimit - 1)
+	vdRuleAccountType       = vd.By(validateAccountType) // 登録されている外部アカウントの種類か
 	vdRuleEventLevelMax     = vd.Max(uint8(domain.EventLevelLimit) - 1)
+	vdRuleVisibilityMax     = vd.Max(uint8(domain.VisibilityLimit) - 1)
+	vdRuleFeaturedItemMax   = vd.Max(uint8(domain.FeaturedItemLimit) - 1)
+	vdRuleImportRowsLength  = vd.Length(1, 100)  // 一括登録できる行数の上限
+	vdRuleQueryIDsLength    = vd.Length(1, 100)  // クエリパラメーターで一括取得できる数の上限
+	vdRuleBatchIDsLength    = vd.Length(1, 1000) // リクエストボディで一括取得できる数の上限
+	vdRuleFeedLimitMax      = vd.Max(100)        // フィードで取得できる数の上限
 )
 
+// errIDsWithLimit idsで指定したものの一部だけを黙って返さないよう、limitとの併用は受け付けない
+var errIDsWithLimit = errors.New("ids cannot be specified with limit")
+
+func validateAccountType(value interface{}) error {
+	v, isNil := vd.Indirect(value)
+	if isNil {
+		return nil
+	}
+	if _, ok := domain.AccountTypes().Get(domain.AccountType(v.(AccountType))); !ok {
+		return vd.NewError("validation_account_type_invalid", "must be a registered account type")
+	}
+
+	return nil
+}
+
+// validateAccountURL URLまたはハンドルをaccountTypeのアカウントとして正規化できるか
+// 正規化前の入力はハンドルや大文字を含むURLの場合があるため、正規化した結果のURLを検証する
+// accountTypeが指定されていないか登録されていない場合はリポジトリで既存の種類を使って検証する
+func validateAccountURL(accountType *AccountType) vd.RuleFunc {
+	return func(value interface{}) error {
+		v, isNil := vd.Indirect(value)
+		if isNil || accountType == nil {
+			return nil
+		}
+		d, ok := domain.AccountTypes().Get(domain.AccountType(*accountType))
+		if !ok {
+			return nil
+		}
+
+		_, canonicalURL, err := d.Normalize(v.(string))
+		if err != nil {
+			return vd.NewError("validation_account_url_invalid", "must be a valid URL or handle of the account type")
+		}
+
+		return is.URL.Validate(canonicalURL)
+	}
+}
+
 // path parameter structs
 
 func (p GetUsersParams) Validate() error {
 	if p.IncludeSuspended != nil && p.Name != nil {
 		return errors.New("include_suspended and name cannot be specified at the same time")
 	}
+	if p.Ids != nil && (p.IncludeSuspended != nil || p.Name != nil) {
+		return errors.New("ids cannot be specified with include_suspended or name")
+	}
+	if p.Ids != nil && p.Limit != nil {
+		return errIDsWithLimit
+	}
 
 	return vd.ValidateStruct(&p,
 		vd.Field(&p.IncludeSuspended),
 		vd.Field(&p.Name, vd.NilOrNotEmpty),
 		vd.Field(&p.Limit, vd.Min(1), vd.NilOrNotEmpty),
+		vd.Field(&p.Ids, vd.NilOrNotEmpty, vdRuleQueryIDsLength),
 	)
 }
 
 func (p GetProjectsParams) Validate() error {
+	if p.Ids != nil && p.Limit != nil {
+		return errIDsWithLimit
+	}
+
 	return vd.ValidateStruct(&p,
 		vd.Field(&p.Limit, vd.Min(1), vd.NilOrNotEmpty),
+		vd.Field(&p.Ids, vd.NilOrNotEmpty, vdRuleQueryIDsLength),
 	)
 }
 
@@ -44,8 +101,65 @@ func (p GetGroupsParams) Validate() error {
 }
 
 func (p GetContestsParams) Validate() error {
+	if p.Ids != nil && p.Limit != nil {
+		return errIDsWithLimit
+	}
+
 	return vd.ValidateStruct(&p,
 		vd.Field(&p.Limit, vd.Min(1), vd.NilOrNotEmpty),
+		vd.Field(&p.Ids, vd.NilOrNotEmpty, vdRuleQueryIDsLength),
+	)
+}
+
+func (p GetProjectsFeedParams) Validate() error {
+	return vd.ValidateStruct(&p,
+		vd.Field(&p.Limit, vd.Min(1), vdRuleFeedLimitMax, vd.NilOrNotEmpty),
+	)
+}
+
+func (p GetOEmbedParams) Validate() error {
+	return vd.ValidateStruct(&p,
+		vd.Field(&p.Url, vd.Required, is.URL),
+		vd.Field(&p.Format, vd.In(JSON, XML)),
+		vd.Field(&p.Maxwidth, vd.Min(1), vd.NilOrNotEmpty),
+		vd.Field(&p.Maxheight, vd.Min(1), vd.NilOrNotEmpty),
+	)
+}
+
+func (p GetContestsFeedParams) Validate() error {
+	return vd.ValidateStruct(&p,
+		vd.Field(&p.Limit, vd.Min(1), vdRuleFeedLimitMax, vd.NilOrNotEmpty),
+	)
+}
+
+func (p GetProjectRevisionDiffParams) Validate() error {
+	return vd.ValidateStruct(&p,
+		vd.Field(&p.From, vd.Required, vd.Min(1)),
+		vd.Field(&p.To, vd.Required, vd.Min(1)),
+	)
+}
+
+func (p GetContestRevisionDiffParams) Validate() error {
+	return vd.ValidateStruct(&p,
+		vd.Field(&p.From, vd.Required, vd.Min(1)),
+		vd.Field(&p.To, vd.Required, vd.Min(1)),
+	)
+}
+
+func (p GetContestTeamRevisionDiffParams) Validate() error {
+	return vd.ValidateStruct(&p,
+		vd.Field(&p.From, vd.Required, vd.Min(1)),
+		vd.Field(&p.To, vd.Required, vd.Min(1)),
+	)
+}
+
+func (p GetUserCvParams) Validate() error {
+	if p.Sections == nil {
+		return nil
+	}
+
+	return vd.Validate(*p.Sections,
+		vd.Each(vd.In(Profile, Accounts, Projects, Contests, Groups)),
 	)
 }
 
@@ -54,17 +168,21 @@ func (p GetContestsParams) Validate() error {
 func (r AddAccountRequest) Validate() error {
 	return vd.ValidateStruct(&r,
 		vd.Field(&r.DisplayName, vd.Required, vdRuleDisplayNameLength),
-		vd.Field(&r.Type, vdRuleAccountTypeMax),
-		vd.Field(&r.Url, vd.Required, is.URL),
+		vd.Field(&r.Type, vdRuleAccountType),
+		vd.Field(&r.Url, vd.Required, vd.By(validateAccountURL(&r.Type))),
 	)
 }
 
 func (r AddContestTeamRequest) Validate() error {
 	return vd.ValidateStruct(&r,
+		vd.Field(&r.Body, vdRuleBodyLength),
 		vd.Field(&r.Description, vd.Required, vdRuleDescriptionLength),
+		vd.Field(&r.DescriptionEn, vdRuleDescriptionLength),
 		vd.Field(&r.Link, is.URL),
 		vd.Field(&r.Name, vd.Required, vdRuleNameLength),
+		vd.Field(&r.NameEn, vdRuleNameLength),
 		vd.Field(&r.Result, vdRuleResultLength),
+		vd.Field(&r.Visibility, vdRuleVisibilityMax),
 	)
 }
 
@@ -76,54 +194,108 @@ func (r EditProjectMembersRequest) Validate() error {
 
 func (r CreateContestRequest) Validate() error {
 	return vd.ValidateStruct(&r,
+		vd.Field(&r.Body, vdRuleBodyLength),
 		vd.Field(&r.Description, vd.Required, vdRuleDescriptionLength),
+		vd.Field(&r.DescriptionEn, vdRuleDescriptionLength),
+		vd.Field(&r.Draft),
 		vd.Field(&r.Duration, vd.Required),
 		vd.Field(&r.Link, is.URL),
 		vd.Field(&r.Name, vd.Required, vdRuleNameLength),
+		vd.Field(&r.NameEn, vdRuleNameLength),
+		vd.Field(&r.PublishAt, vd.When(r.Draft == nil || !*r.Draft, vd.Nil)),
+		vd.Field(&r.Visibility, vdRuleVisibilityMax),
 	)
 }
 
 func (r CreateProjectRequest) Validate() error {
 	return vd.ValidateStruct(&r,
+		vd.Field(&r.Body, vdRuleBodyLength),
 		vd.Field(&r.Description, vd.Required, vdRuleDescriptionLength),
+		vd.Field(&r.DescriptionEn, vdRuleDescriptionLength),
+		vd.Field(&r.Draft),
 		vd.Field(&r.Duration, vd.Required),
 		vd.Field(&r.Link, is.URL),
 		vd.Field(&r.Name, vd.Required, vdRuleNameLength),
+		vd.Field(&r.NameEn, vdRuleNameLength),
+		vd.Field(&r.PublishAt, vd.When(r.Draft == nil || !*r.Draft, vd.Nil)),
+		vd.Field(&r.Visibility, vdRuleVisibilityMax),
 	)
 }
 
 func (r EditUserAccountRequest) Validate() error {
 	return vd.ValidateStruct(&r,
 		vd.Field(&r.DisplayName, vd.NilOrNotEmpty, vdRuleDisplayNameLength),
-		vd.Field(&r.Type, vdRuleAccountTypeMax),
-		vd.Field(&r.Url, vd.NilOrNotEmpty, is.URL),
+		vd.Field(&r.Type, vdRuleAccountType),
+		vd.Field(&r.Url, vd.NilOrNotEmpty, vd.By(validateAccountURL(r.Type))),
 	)
 }
 
 func (r EditContestRequest) Validate() error {
 	return vd.ValidateStruct(&r,
+		vd.Field(&r.Body, vdRuleBodyLength),
 		vd.Field(&r.Description, vd.NilOrNotEmpty, vdRuleDescriptionLength),
+		vd.Field(&r.DescriptionEn, vdRuleDescriptionLength),
 		vd.Field(&r.Duration, vd.NilOrNotEmpty),
 		vd.Field(&r.Link, is.URL),
 		vd.Field(&r.Name, vd.NilOrNotEmpty, vdRuleNameLength),
+		vd.Field(&r.NameEn, vdRuleNameLength),
+		vd.Field(&r.Visibility, vdRuleVisibilityMax),
 	)
 }
 
 func (r EditContestTeamRequest) Validate() error {
 	return vd.ValidateStruct(&r,
+		vd.Field(&r.Body, vdRuleBodyLength),
 		vd.Field(&r.Description, vd.NilOrNotEmpty, vdRuleDescriptionLength),
+		vd.Field(&r.DescriptionEn, vdRuleDescriptionLength),
 		vd.Field(&r.Link, is.URL),
 		vd.Field(&r.Name, vd.NilOrNotEmpty, vdRuleNameLength),
+		vd.Field(&r.NameEn, vdRuleNameLength),
 		vd.Field(&r.Result, vdRuleResultLength),
+		vd.Field(&r.Visibility, vdRuleVisibilityMax),
 	)
 }
 
+// ContestTeamImportRow 各行の検証はハンドラーで行単位のエラーとして扱う
+func (r ContestTeamImportRow) Validate() error {
+	if err := vd.ValidateStruct(&r,
+		vd.Field(&r.Name, vd.Required, vdRuleNameLength),
+		vd.Field(&r.Result, vdRuleResultLength),
+		vd.Field(&r.Link, is.URL),
+		vd.Field(&r.Description, vdRuleDescriptionLength),
+	); err != nil {
+		return err
+	}
+	if r.Members == nil {
+		return nil
+	}
+
+	if err := vd.Validate(*r.Members, vd.Each(vd.Required, vdRuleNameLength)); err != nil {
+		return vd.Errors{"members": err}
+	}
+
+	return nil
+}
+
 func (r EditContestTeamMembersRequest) Validate() error {
 	return vd.ValidateStruct(&r,
 		vd.Field(&r.Members, vd.NotNil, vd.Each(vd.Required, is.UUIDv4)),
 	)
 }
 
+func (r BatchRequest) Validate() error {
+	return vd.ValidateStruct(&r,
+		vd.Field(&r.Ids, vd.Required, vdRuleBatchIDsLength, vd.Each(vd.Required, is.UUIDv4)),
+	)
+}
+
+func (r ImportContestTeamsRequest) Validate() error {
+	return vd.ValidateStruct(&r,
+		// 1行のエラーでリクエスト全体を拒否しないよう、各行はここでは検証しない
+		vd.Field(&r.Rows, vd.Required, vdRuleImportRowsLength, vd.Skip),
+	)
+}
+
 func (r EditEventRequest) Validate() error {
 	return vd.ValidateStruct(&r,
 		vd.Field(&r.Level, vdRuleEventLevelMax),
@@ -132,16 +304,27 @@ func (r EditEventRequest) Validate() error {
 
 func (r EditProjectRequest) Validate() error {
 	return vd.ValidateStruct(&r,
+		vd.Field(&r.Body, vdRuleBodyLength),
 		vd.Field(&r.Description, vd.NilOrNotEmpty, vdRuleDescriptionLength),
+		vd.Field(&r.DescriptionEn, vdRuleDescriptionLength),
 		vd.Field(&r.Duration, vd.NilOrNotEmpty),
 		vd.Field(&r.Link, is.URL),
 		vd.Field(&r.Name, vd.NilOrNotEmpty, vdRuleNameLength),
+		vd.Field(&r.NameEn, vdRuleNameLength),
+		vd.Field(&r.Visibility, vdRuleVisibilityMax),
+	)
+}
+
+func (r EditUserFeaturedItemsRequest) Validate() error {
+	return vd.ValidateStruct(&r,
+		vd.Field(&r.Items, vd.NotNil, vd.Length(0, domain.UserFeaturedItemsLimit)),
 	)
 }
 
 func (r EditUserRequest) Validate() error {
 	return vd.ValidateStruct(&r,
 		vd.Field(&r.Bio, vdRuleDescriptionLength),
+		vd.Field(&r.BioEn, vdRuleDescriptionLength),
 		vd.Field(&r.Check),
 	)
 }
@@ -165,6 +348,13 @@ func (r Duration) Validate() error {
 	return nil
 }
 
+func (r FeaturedItemID) Validate() error {
+	return vd.ValidateStruct(&r,
+		vd.Field(&r.Id, vd.Required, is.UUIDv4),
+		vd.Field(&r.Type, vdRuleFeaturedItemMax),
+	)
+}
+
 func (r MemberIDWithYearWithSemesterDuration) Validate() error {
 	return vd.ValidateStruct(&r,
 		vd.Field(&r.Duration),
@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/markdown"
+)
+
+const snapshotBasePath = "/api/v1"
+
+// SnapshotOptions WriteSnapshotの設定
+type SnapshotOptions struct {
+	HTML bool // ユーザーとプロジェクトのページをHTMLでも書き出すかどうか
+}
+
+// snapshotWriter 実際のルーターにリクエストを送り、レスポンスをそのままファイルに書き出す
+// APIと同じハンドラーを通すため、書き出した内容は同じ時点のAPIのレスポンスと一致する
+type snapshotWriter struct {
+	ctx  context.Context
+	e    *echo.Echo
+	dir  string
+	opts SnapshotOptions
+}
+
+// WriteSnapshot 公開されているユーザー、プロジェクト、コンテスト、班の情報を/api/v1と同じパスの静的なファイルとしてdirに書き出す
+// DBのメンテナンス中などにCDNやGitHub Pagesから配信するためのもので、メンバーでない閲覧者と同じ情報だけを日本語で書き出す
+// /users/:userID のように拡張子を持たないパスは users/:userID/index.json に書き出す
+func WriteSnapshot(ctx context.Context, api API, dir string, opts SnapshotOptions) error {
+	e := echo.New()
+	if err := Setup(true, e, api); err != nil {
+		return err
+	}
+
+	w := &snapshotWriter{ctx: ctx, e: e, dir: dir, opts: opts}
+
+	if err := w.write("/account-types"); err != nil {
+		return err
+	}
+
+	userIDs, err := w.writeList("/users")
+	if err != nil {
+		return err
+	}
+	for _, id := range userIDs {
+		if err := w.writeUser(id); err != nil {
+			return err
+		}
+	}
+
+	projectIDs, err := w.writeList("/projects")
+	if err != nil {
+		return err
+	}
+	for _, id := range projectIDs {
+		if err := w.writeProject(id); err != nil {
+			return err
+		}
+	}
+
+	contestIDs, err := w.writeList("/contests")
+	if err != nil {
+		return err
+	}
+	for _, id := range contestIDs {
+		if err := w.writeContest(id); err != nil {
+			return err
+		}
+	}
+
+	groupIDs, err := w.writeList("/groups")
+	if err != nil {
+		return err
+	}
+	for _, id := range groupIDs {
+		if err := w.write(fmt.Sprintf("/groups/%s", id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *snapshotWriter) writeUser(id uuid.UUID) error {
+	p := fmt.Sprintf("/users/%s", id)
+	for _, sub := range []string{"", "/projects", "/contests", "/groups", "/resume.json"} {
+		if err := w.write(p + sub); err != nil {
+			return err
+		}
+	}
+
+	accountIDs, err := w.writeList(p + "/accounts")
+	if err != nil {
+		return err
+	}
+	for _, accountID := range accountIDs {
+		if err := w.write(fmt.Sprintf("%s/accounts/%s", p, accountID)); err != nil {
+			return err
+		}
+	}
+
+	if w.opts.HTML {
+		return w.writeHTML(p)
+	}
+
+	return nil
+}
+
+func (w *snapshotWriter) writeProject(id uuid.UUID) error {
+	p := fmt.Sprintf("/projects/%s", id)
+	for _, sub := range []string{"", "/members"} {
+		if err := w.write(p + sub); err != nil {
+			return err
+		}
+	}
+
+	if w.opts.HTML {
+		return w.writeHTML(p)
+	}
+
+	return nil
+}
+
+func (w *snapshotWriter) writeContest(id uuid.UUID) error {
+	p := fmt.Sprintf("/contests/%s", id)
+	if err := w.write(p); err != nil {
+		return err
+	}
+
+	teamIDs, err := w.writeList(p + "/teams")
+	if err != nil {
+		return err
+	}
+	for _, teamID := range teamIDs {
+		tp := fmt.Sprintf("%s/teams/%s", p, teamID)
+		for _, sub := range []string{"", "/members"} {
+			if err := w.write(tp + sub); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeList 一覧を書き出し、含まれる要素のIDを返す
+func (w *snapshotWriter) writeList(p string) ([]uuid.UUID, error) {
+	b, err := w.get(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []struct {
+		ID uuid.UUID `json:"id"`
+	}
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, fmt.Errorf("decode GET %s: %w", p, err)
+	}
+
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+
+	return ids, w.writeFile(p, b)
+}
+
+func (w *snapshotWriter) write(p string) error {
+	b, err := w.get(p)
+	if err != nil {
+		return err
+	}
+
+	return w.writeFile(p, b)
+}
+
+// writeHTML Markdown形式のページをHTMLに変換して index.html に書き出す
+func (w *snapshotWriter) writeHTML(p string) error {
+	b, err := w.get(p + "/export.md")
+	if err != nil {
+		return err
+	}
+
+	body, err := markdown.ToHTML(string(b))
+	if err != nil {
+		return err
+	}
+
+	page := fmt.Sprintf("<!DOCTYPE html>\n<html lang=\"ja\">\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n%s</body>\n</html>\n", html.EscapeString(path.Base(p)), body)
+
+	return w.writeFileAs(filepath.Join(w.dir, filepath.FromSlash(snapshotBasePath+p), "index.html"), []byte(page))
+}
+
+func (w *snapshotWriter) get(p string) ([]byte, error) {
+	req := httptest.NewRequest(http.MethodGet, snapshotBasePath+p, nil).WithContext(w.ctx)
+	rec := httptest.NewRecorder()
+	w.e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %d %s", p, rec.Code, rec.Body.String())
+	}
+
+	return rec.Body.Bytes(), nil
+}
+
+func (w *snapshotWriter) writeFile(p string, b []byte) error {
+	name := filepath.Join(w.dir, filepath.FromSlash(snapshotBasePath+p))
+	if path.Ext(p) == "" {
+		name = filepath.Join(name, "index.json")
+	}
+
+	return w.writeFileAs(name, b)
+}
+
+func (w *snapshotWriter) writeFileAs(name string, b []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, b, 0o644)
+}
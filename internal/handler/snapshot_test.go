@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository/mock_repository"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWriteSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mr := MockRepository{
+		user:    mock_repository.NewMockUserRepository(ctrl),
+		project: mock_repository.NewMockProjectRepository(ctrl),
+		contest: mock_repository.NewMockContestRepository(ctrl),
+		group:   mock_repository.NewMockGroupRepository(ctrl),
+	}
+	api := NewAPI(nil, NewUserHandler(mr.user, nil, nil), NewProjectHandler(mr.project), nil, NewContestHandler(mr.contest), NewGroupHandler(mr.group, mr.user), nil, nil, nil)
+
+	user := domain.NewUser(random.UUID(), "user1", "User One", true)
+	account := &domain.Account{ID: random.UUID(), DisplayName: "user1", Type: domain.TWITTER, Handle: "user1", URL: "https://twitter.com/user1"}
+	project := &domain.Project{ID: random.UUID(), Name: "project1", Duration: domain.NewYearWithSemesterDuration(2022, 0, 2022, 1)}
+	members := []*domain.UserWithDuration{{User: *user, Duration: project.Duration}}
+	contest := &domain.Contest{ID: random.UUID(), Name: "contest1", TimeStart: time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC), TimeEnd: time.Date(2022, 8, 2, 0, 0, 0, 0, time.UTC)}
+	team := &domain.ContestTeam{ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{ID: random.UUID(), ContestID: contest.ID, Name: "team1", Result: "優勝"}, Members: []*domain.User{user}}
+	group := &domain.Group{ID: random.UUID(), Name: "group1"}
+
+	// 書き出した後にAPIのレスポンスと比較するため、呼び出し回数は問わない
+	mr.user.EXPECT().GetUsers(anyCtx{}, gomock.Any()).Return([]*domain.User{user}, nil).AnyTimes()
+	mr.user.EXPECT().GetUser(anyCtx{}, user.ID).Return(&domain.UserDetail{User: *user, Bio: "Hello", Accounts: []*domain.Account{account}}, nil).AnyTimes()
+	mr.user.EXPECT().GetAccounts(anyCtx{}, user.ID).Return([]*domain.Account{account}, nil).AnyTimes()
+	mr.user.EXPECT().GetAccount(anyCtx{}, user.ID, account.ID).Return(account, nil).AnyTimes()
+	mr.user.EXPECT().GetProjects(anyCtx{}, user.ID).Return([]*domain.UserProject{{ID: project.ID, Name: project.Name, Duration: project.Duration, UserDuration: project.Duration}}, nil).AnyTimes()
+	mr.user.EXPECT().GetContests(anyCtx{}, user.ID).Return([]*domain.UserContest{{ID: contest.ID, Name: contest.Name, TimeStart: contest.TimeStart, TimeEnd: contest.TimeEnd, Teams: []*domain.ContestTeamWithoutMembers{&team.ContestTeamWithoutMembers}}}, nil).AnyTimes()
+	mr.user.EXPECT().GetGroupsByUserID(anyCtx{}, user.ID).Return([]*domain.UserGroup{{ID: group.ID, Name: group.Name, Duration: project.Duration}}, nil).AnyTimes()
+	mr.project.EXPECT().GetProjects(anyCtx{}, gomock.Any()).Return([]*domain.Project{project}, nil).AnyTimes()
+	mr.project.EXPECT().GetProject(anyCtx{}, project.ID).Return(&domain.ProjectDetail{Project: *project, Description: "<script>alert(1)</script>", Members: members}, nil).AnyTimes()
+	mr.project.EXPECT().GetProjectMembers(anyCtx{}, project.ID).Return(members, nil).AnyTimes()
+	mr.contest.EXPECT().GetContests(anyCtx{}, gomock.Any()).Return([]*domain.Contest{contest}, nil).AnyTimes()
+	mr.contest.EXPECT().GetContest(anyCtx{}, contest.ID).Return(&domain.ContestDetail{Contest: *contest, ContestTeams: []*domain.ContestTeam{team}}, nil).AnyTimes()
+	mr.contest.EXPECT().GetContestTeams(anyCtx{}, contest.ID).Return([]*domain.ContestTeam{team}, nil).AnyTimes()
+	mr.contest.EXPECT().GetContestTeam(anyCtx{}, contest.ID, team.ID).Return(&domain.ContestTeamDetail{ContestTeam: *team}, nil).AnyTimes()
+	mr.contest.EXPECT().GetContestTeamMembers(anyCtx{}, contest.ID, team.ID).Return(team.Members, nil).AnyTimes()
+	mr.group.EXPECT().GetGroups(anyCtx{}, gomock.Any()).Return([]*domain.Group{group}, nil).AnyTimes()
+	mr.group.EXPECT().GetGroup(anyCtx{}, group.ID).Return(&domain.GroupDetail{ID: group.ID, Name: group.Name, Admin: []*domain.User{user}, Members: members}, nil).AnyTimes()
+
+	dir := t.TempDir()
+	assert.NoError(t, WriteSnapshot(context.Background(), api, dir, SnapshotOptions{HTML: true}))
+
+	files := map[string]string{
+		"/account-types":                  "account-types/index.json",
+		"/users":                          "users/index.json",
+		fmt.Sprintf("/users/%s", user.ID): fmt.Sprintf("users/%s/index.json", user.ID),
+		fmt.Sprintf("/users/%s/accounts", user.ID):                fmt.Sprintf("users/%s/accounts/index.json", user.ID),
+		fmt.Sprintf("/users/%s/accounts/%s", user.ID, account.ID): fmt.Sprintf("users/%s/accounts/%s/index.json", user.ID, account.ID),
+		fmt.Sprintf("/users/%s/projects", user.ID):                fmt.Sprintf("users/%s/projects/index.json", user.ID),
+		fmt.Sprintf("/users/%s/contests", user.ID):                fmt.Sprintf("users/%s/contests/index.json", user.ID),
+		fmt.Sprintf("/users/%s/groups", user.ID):                  fmt.Sprintf("users/%s/groups/index.json", user.ID),
+		fmt.Sprintf("/users/%s/resume.json", user.ID):             fmt.Sprintf("users/%s/resume.json", user.ID),
+		"/projects":                                                       "projects/index.json",
+		fmt.Sprintf("/projects/%s", project.ID):                           fmt.Sprintf("projects/%s/index.json", project.ID),
+		fmt.Sprintf("/projects/%s/members", project.ID):                   fmt.Sprintf("projects/%s/members/index.json", project.ID),
+		"/contests":                                                       "contests/index.json",
+		fmt.Sprintf("/contests/%s", contest.ID):                           fmt.Sprintf("contests/%s/index.json", contest.ID),
+		fmt.Sprintf("/contests/%s/teams", contest.ID):                     fmt.Sprintf("contests/%s/teams/index.json", contest.ID),
+		fmt.Sprintf("/contests/%s/teams/%s", contest.ID, team.ID):         fmt.Sprintf("contests/%s/teams/%s/index.json", contest.ID, team.ID),
+		fmt.Sprintf("/contests/%s/teams/%s/members", contest.ID, team.ID): fmt.Sprintf("contests/%s/teams/%s/members/index.json", contest.ID, team.ID),
+		"/groups":                           "groups/index.json",
+		fmt.Sprintf("/groups/%s", group.ID): fmt.Sprintf("groups/%s/index.json", group.ID),
+	}
+	for path, file := range files {
+		got, err := os.ReadFile(filepath.Join(dir, "api", "v1", filepath.FromSlash(file)))
+		if !assert.NoError(t, err, path) {
+			continue
+		}
+
+		statusCode, rec := doRequest(t, api, http.MethodGet, "/api/v1"+path, nil, nil)
+		assert.Equal(t, http.StatusOK, statusCode, path)
+		assert.Equal(t, rec.Body.String(), string(got), path)
+	}
+
+	userHTML, err := os.ReadFile(filepath.Join(dir, "api", "v1", "users", user.ID.String(), "index.html"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(userHTML), "<h1>User One (@user1)</h1>")
+
+	projectHTML, err := os.ReadFile(filepath.Join(dir, "api", "v1", "projects", project.ID.String(), "index.html"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(projectHTML), "<script>")
+}
+
+func TestWriteSnapshot_Error(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	user := mock_repository.NewMockUserRepository(ctrl)
+	api := NewAPI(nil, NewUserHandler(user, nil, nil), nil, nil, nil, nil, nil, nil, nil)
+
+	user.EXPECT().GetUsers(anyCtx{}, gomock.Any()).Return(nil, errInternal)
+
+	err := WriteSnapshot(context.Background(), api, t.TempDir(), SnapshotOptions{})
+	assert.ErrorContains(t, err, "GET /users: 500")
+}
@@ -15,11 +15,12 @@ import (
 )
 
 type MockRepository struct {
-	user    *mock_repository.MockUserRepository
-	event   *mock_repository.MockEventRepository
-	contest *mock_repository.MockContestRepository
-	group   *mock_repository.MockGroupRepository
-	project *mock_repository.MockProjectRepository
+	user         *mock_repository.MockUserRepository
+	event        *mock_repository.MockEventRepository
+	contest      *mock_repository.MockContestRepository
+	group        *mock_repository.MockGroupRepository
+	project      *mock_repository.MockProjectRepository
+	accountStats *mock_repository.MockAccountStatsRepository
 }
 
 func doRequest(t *testing.T, api API, method, path string, reqBody interface{}, resBody interface{}) (int, *httptest.ResponseRecorder) {
@@ -51,6 +52,23 @@ func doRequestWithHeader(t *testing.T, api API, method, path string, reqBody int
 	return rec.Code, rec
 }
 
+// doRawRequest JSON以外のリクエストボディを送る
+func doRawRequest(t *testing.T, api API, method, path, contentType, reqBody string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, contentType)
+	rec := httptest.NewRecorder()
+
+	e := echo.New()
+
+	err := Setup(false, e, api)
+	assert.NoError(t, err)
+	e.ServeHTTP(rec, req)
+
+	return rec
+}
+
 func requestEncode(t *testing.T, body interface{}) *strings.Reader {
 	t.Helper()
 
@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/handler/schema"
@@ -13,12 +15,13 @@ import (
 )
 
 type UserHandler struct {
-	user  repository.UserRepository
-	event repository.EventRepository
+	user         repository.UserRepository
+	event        repository.EventRepository
+	accountStats repository.AccountStatsRepository
 }
 
-func NewUserHandler(user repository.UserRepository, event repository.EventRepository) *UserHandler {
-	return &UserHandler{user, event}
+func NewUserHandler(user repository.UserRepository, event repository.EventRepository, accountStats repository.AccountStatsRepository) *UserHandler {
+	return &UserHandler{user, event, accountStats}
 }
 
 // GetUsers GET /users
@@ -34,12 +37,21 @@ func (h *UserHandler) GetUsers(c echo.Context) error {
 		Name:             optional.FromPtr((*string)(req.Name)),
 		Limit:            optional.FromPtr((*int)(req.Limit)),
 	}
+	if req.Ids != nil {
+		args.IDs = uniqueIDs(*req.Ids)
+	}
 
 	users, err := h.user.GetUsers(ctx, &args)
 	if err != nil {
 		return err
 	}
 
+	if args.IDs != nil {
+		var missing []uuid.UUID
+		users, missing = sortByIDs(args.IDs, users, userIDOf)
+		setMissingIDsHeader(c, missing)
+	}
+
 	res := make([]schema.User, len(users))
 	for i, v := range users {
 		res[i] = newUser(v.ID, v.Name, v.RealName())
@@ -48,6 +60,36 @@ func (h *UserHandler) GetUsers(c echo.Context) error {
 	return c.JSON(http.StatusOK, res)
 }
 
+// BatchGetUsers POST /users/batch
+func (h *UserHandler) BatchGetUsers(c echo.Context) error {
+	req := schema.BatchRequest{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	ids := uniqueIDs(req.Ids)
+	users, err := h.user.GetUsers(ctx, &repository.GetUsersArgs{IDs: ids})
+	if err != nil {
+		return err
+	}
+
+	users, missing := sortByIDs(ids, users, userIDOf)
+	res := schema.UsersBatch{
+		Users:   make([]schema.User, len(users)),
+		Missing: missing,
+	}
+	for i, v := range users {
+		res.Users[i] = newUser(v.ID, v.Name, v.RealName())
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func userIDOf(u *domain.User) uuid.UUID {
+	return u.ID
+}
+
 // SyncUsers POST /users/sync
 func (h *UserHandler) SyncUsers(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -73,12 +115,18 @@ func (h *UserHandler) GetUser(c echo.Context) error {
 
 	accounts := make([]schema.Account, len(user.Accounts))
 	for i, v := range user.Accounts {
-		accounts[i] = newAccount(v.ID, v.DisplayName, schema.AccountType(v.Type), v.URL)
+		accounts[i] = newAccount(v.ID, v.DisplayName, schema.AccountType(v.Type), v.URL, v.Handle)
 	}
 
-	return c.JSON(http.StatusOK, newUserDetail(
+	featured := make([]schema.UserFeaturedItem, len(user.Featured))
+	for i, v := range user.Featured {
+		featured[i] = newUserFeaturedItem(v)
+	}
+
+	return jsonWithETag(c, user.Version, newUserDetail(
 		newUser(user.ID, user.Name, user.RealName()),
 		accounts,
+		featured,
 		user.Bio,
 		user.State,
 	))
@@ -96,10 +144,11 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 		return err
 	}
 
-	ctx := c.Request().Context()
+	ctx := ifMatchContext(c)
 	u := repository.UpdateUserArgs{
-		Description: optional.FromPtr(req.Bio),
-		Check:       optional.FromPtr(req.Check),
+		Description:   optional.FromPtr(req.Bio),
+		DescriptionEn: optional.FromPtr(req.BioEn),
+		Check:         optional.FromPtr(req.Check),
 	}
 
 	if err := h.user.UpdateUser(ctx, userID, &u); err != nil {
@@ -108,6 +157,43 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// EditUserFeaturedItems PUT /users/:userID/featured
+func (h *UserHandler) EditUserFeaturedItems(c echo.Context) error {
+	userID, err := getID(c, keyUserID)
+	if err != nil {
+		return err
+	}
+
+	req := schema.EditUserFeaturedItemsRequest{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	editMap := make(map[repository.EditUserFeaturedItemArgs]struct{}, len(req.Items))
+	editReq := make([]*repository.EditUserFeaturedItemArgs, 0, len(req.Items))
+	for _, v := range req.Items {
+		item := repository.EditUserFeaturedItemArgs{
+			Type: domain.FeaturedItemType(v.Type),
+			ID:   v.Id,
+		}
+
+		// 重複していないかどうか
+		if _, ok := editMap[item]; ok {
+			return repository.ErrInvalidArg
+		}
+
+		editReq = append(editReq, &item)
+		editMap[item] = struct{}{}
+	}
+
+	ctx := ifMatchContext(c)
+	if err := h.user.EditUserFeaturedItems(ctx, userID, editReq); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
 // GetUserAccounts GET /users/:userID/accounts
 func (h *UserHandler) GetUserAccounts(c echo.Context) error {
 	userID, err := getID(c, keyUserID)
@@ -123,7 +209,7 @@ func (h *UserHandler) GetUserAccounts(c echo.Context) error {
 
 	res := make([]schema.Account, len(accounts))
 	for i, v := range accounts {
-		res[i] = newAccount(v.ID, v.DisplayName, schema.AccountType(v.Type), v.URL)
+		res[i] = newAccount(v.ID, v.DisplayName, schema.AccountType(v.Type), v.URL, v.Handle)
 	}
 
 	return c.JSON(http.StatusOK, res)
@@ -147,7 +233,28 @@ func (h *UserHandler) GetUserAccount(c echo.Context) error {
 		return err
 	}
 
-	return c.JSON(http.StatusOK, newAccount(account.ID, account.DisplayName, schema.AccountType(account.Type), account.URL))
+	return c.JSON(http.StatusOK, newAccount(account.ID, account.DisplayName, schema.AccountType(account.Type), account.URL, account.Handle))
+}
+
+// GetUserAccountStats GET /users/:userID/accounts/:accountID/stats
+func (h *UserHandler) GetUserAccountStats(c echo.Context) error {
+	userID, err := getID(c, keyUserID)
+	if err != nil {
+		return err
+	}
+
+	accountID, err := getID(c, keyUserAccountID)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	stats, err := h.accountStats.GetAccountStats(ctx, userID, accountID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newAccountStats(stats))
 }
 
 // AddUserAccount POST /users/:userID/accounts
@@ -173,7 +280,7 @@ func (h *UserHandler) AddUserAccount(c echo.Context) error {
 		return err
 	}
 
-	return c.JSON(http.StatusCreated, newAccount(account.ID, account.DisplayName, schema.AccountType(account.Type), account.URL))
+	return c.JSON(http.StatusCreated, newAccount(account.ID, account.DisplayName, schema.AccountType(account.Type), account.URL, account.Handle))
 }
 
 // EditUserAccount PATCH /users/:userID/accounts/:accountID
@@ -231,6 +338,18 @@ func (h *UserHandler) DeleteUserAccount(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// GetAccountTypes GET /account-types
+func (h *UserHandler) GetAccountTypes(c echo.Context) error {
+	defs := domain.AccountTypes().All()
+
+	res := make([]schema.AccountTypeDefinition, len(defs))
+	for i, v := range defs {
+		res[i] = newAccountTypeDefinition(v)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
 // GetUserProjects GET /users/:userID/projects
 func (h *UserHandler) GetUserProjects(c echo.Context) error {
 	userID, err := getID(c, keyUserID)
@@ -307,6 +426,154 @@ func (h *UserHandler) GetUserGroups(c echo.Context) error {
 	return c.JSON(http.StatusOK, res)
 }
 
+// GetUserResume GET /users/:userID/resume.json
+func (h *UserHandler) GetUserResume(c echo.Context) error {
+	userID, err := getID(c, keyUserID)
+	if err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	user, err := h.user.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	projects, err := h.user.GetProjects(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	contests, err := h.user.GetContests(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	groups, err := h.user.GetGroupsByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, newResume(user, projects, contests, groups))
+}
+
+// GetUserCv GET /users/:userID/cv.pdf
+func (h *UserHandler) GetUserCv(c echo.Context) error {
+	userID, err := getID(c, keyUserID)
+	if err != nil {
+		return err
+	}
+
+	req := schema.GetUserCvParams{}
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	user, err := h.user.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	sections := cvSections(req.Sections)
+	content := cvContent{user: user}
+	if sections[schema.Projects] {
+		if content.projects, err = h.user.GetProjects(ctx, userID); err != nil {
+			return err
+		}
+	}
+	if sections[schema.Contests] {
+		if content.contests, err = h.user.GetContests(ctx, userID); err != nil {
+			return err
+		}
+	}
+	if sections[schema.Groups] {
+		if content.groups, err = h.user.GetGroupsByUserID(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	b, err := newCV(repository.LangFrom(ctx), sections, content)
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("inline; filename=%q", user.Name+"-cv.pdf"))
+
+	return c.Blob(http.StatusOK, "application/pdf", b)
+}
+
+// GetUserMarkdown GET /users/:userID/export.md
+func (h *UserHandler) GetUserMarkdown(c echo.Context) error {
+	userID, err := getID(c, keyUserID)
+	if err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	user, err := h.user.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	projects, err := h.user.GetProjects(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	contests, err := h.user.GetContests(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, mimeTextMarkdown, []byte(newUserMarkdown(repository.LangFrom(ctx), user, projects, contests)))
+}
+
+// GetUserBadge GET /users/:userID/badge.svg
+func (h *UserHandler) GetUserBadge(c echo.Context) error {
+	userID, err := getID(c, keyUserID)
+	if err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	if _, err := h.user.GetUser(ctx, userID); err != nil {
+		return err
+	}
+
+	projects, err := h.user.GetProjects(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return respondBadge(c, newUserProjectsBadge(repository.LangFrom(ctx), projects))
+}
+
+// GetUserContestsBadge GET /users/:userID/badges/contests.svg
+func (h *UserHandler) GetUserContestsBadge(c echo.Context) error {
+	userID, err := getID(c, keyUserID)
+	if err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	if _, err := h.user.GetUser(ctx, userID); err != nil {
+		return err
+	}
+
+	contests, err := h.user.GetContests(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return respondBadge(c, newUserContestsBadge(repository.LangFrom(ctx), contests))
+}
+
+func respondBadge(c echo.Context, b []byte) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(badgeCacheMaxAge.Seconds())))
+
+	return c.Blob(http.StatusOK, mimeImageSVG, b)
+}
+
 // GetUserEvents GET /users/:userID/events
 func (h *UserHandler) GetUserEvents(c echo.Context) error {
 	userID, err := getID(c, keyUserID)
@@ -328,6 +595,29 @@ func (h *UserHandler) GetUserEvents(c echo.Context) error {
 	return c.JSON(http.StatusOK, res)
 }
 
+// GetUserEventsCalendar GET /users/:userID/events.ics
+func (h *UserHandler) GetUserEventsCalendar(c echo.Context) error {
+	userID, err := getID(c, keyUserID)
+	if err != nil {
+		return err
+	}
+
+	ctx := publicContext(c)
+	user, err := h.user.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	events, err := h.event.GetUserEvents(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	cal := newUserEventsCalendar(repository.LangFrom(ctx), user, events)
+
+	return c.Blob(http.StatusOK, mimeTextCalendar, cal.Encode(time.Now()))
+}
+
 // GetMe GET /users/me
 func (h *UserHandler) GetMe(c echo.Context) error {
 	name, ok := c.Get(keyUserName).(string)
@@ -354,12 +644,18 @@ func (h *UserHandler) GetMe(c echo.Context) error {
 
 	accounts := make([]schema.Account, len(user.Accounts))
 	for i, v := range user.Accounts {
-		accounts[i] = newAccount(v.ID, v.DisplayName, schema.AccountType(v.Type), v.URL)
+		accounts[i] = newAccount(v.ID, v.DisplayName, schema.AccountType(v.Type), v.URL, v.Handle)
+	}
+
+	featured := make([]schema.UserFeaturedItem, len(user.Featured))
+	for i, v := range user.Featured {
+		featured[i] = newUserFeaturedItem(v)
 	}
 
 	return c.JSON(http.StatusOK, newUserDetail(
 		newUser(user.ID, user.Name, user.RealName()),
 		accounts,
+		featured,
 		user.Bio,
 		user.State,
 	))
@@ -373,10 +669,11 @@ func newUser(id uuid.UUID, name string, realName string) schema.User {
 	}
 }
 
-func newUserDetail(user schema.User, accounts []schema.Account, bio string, state domain.TraQState) schema.UserDetail {
+func newUserDetail(user schema.User, accounts []schema.Account, featured []schema.UserFeaturedItem, bio string, state domain.TraQState) schema.UserDetail {
 	return schema.UserDetail{
 		Accounts: accounts,
 		Bio:      bio,
+		Featured: featured,
 		Id:       user.Id,
 		Name:     user.Name,
 		RealName: user.RealName,
@@ -384,15 +681,56 @@ func newUserDetail(user schema.User, accounts []schema.Account, bio string, stat
 	}
 }
 
-func newAccount(id uuid.UUID, displayName string, atype schema.AccountType, url string) schema.Account {
+func newUserFeaturedItem(item *domain.UserFeaturedItem) schema.UserFeaturedItem {
+	res := schema.UserFeaturedItem{
+		Id:   item.ID,
+		Name: item.Name,
+		Type: schema.FeaturedItemType(item.Type),
+	}
+	if item.Type == domain.FeaturedItemContestTeam {
+		res.ContestId = &item.ContestID
+		res.TeamName = &item.TeamName
+	}
+
+	return res
+}
+
+func newAccount(id uuid.UUID, displayName string, atype schema.AccountType, url string, handle string) schema.Account {
 	return schema.Account{
 		Id:          id,
 		DisplayName: displayName,
 		Type:        atype,
 		Url:         url,
+		Handle:      handle,
 	}
 }
 
+func newAccountStats(stats *domain.AccountStats) schema.AccountStats {
+	return schema.AccountStats{
+		Rating:        stats.Rating,
+		MaxRating:     stats.MaxRating,
+		Color:         stats.Color,
+		RatedContests: stats.RatedContests,
+		FetchedAt:     stats.FetchedAt,
+	}
+}
+
+func newAccountTypeDefinition(def *domain.AccountTypeDefinition) schema.AccountTypeDefinition {
+	res := schema.AccountTypeDefinition{
+		Id:         schema.AccountType(def.ID),
+		Label:      def.Label,
+		UrlPattern: def.URLPattern.String(),
+		Icon:       def.Icon,
+	}
+	if def.HandlePattern != nil {
+		p := def.HandlePattern.String()
+		res.HandlePattern = &p
+		res.UrlTemplate = &def.URLTemplate
+	}
+
+	return res
+}
+
 func newUserProject(id uuid.UUID, name string, duration schema.YearWithSemesterDuration, userDuration schema.YearWithSemesterDuration) schema.UserProject {
 	return schema.UserProject{
 		Duration:     duration,
@@ -7,7 +7,10 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/handler/schema"
@@ -24,8 +27,9 @@ func setupUserMock(t *testing.T) (MockRepository, API) {
 	ctrl := gomock.NewController(t)
 	user := mock_repository.NewMockUserRepository(ctrl)
 	event := mock_repository.NewMockEventRepository(ctrl)
-	mr := MockRepository{user: user, event: event}
-	api := NewAPI(nil, NewUserHandler(user, event), nil, nil, nil, nil)
+	accountStats := mock_repository.NewMockAccountStatsRepository(ctrl)
+	mr := MockRepository{user: user, event: event, accountStats: accountStats}
+	api := NewAPI(nil, NewUserHandler(user, event, accountStats), nil, nil, nil, nil, nil, nil, nil)
 
 	return mr, api
 }
@@ -116,6 +120,54 @@ func TestUserHandler_GetUsers(t *testing.T) {
 			},
 			statusCode: http.StatusOK,
 		},
+		{
+			name: "Success_WithOpts_Ids",
+			setup: func(mr MockRepository) (hres []*schema.User, path string) {
+				user1 := domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), random.Bool())
+				user2 := domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), random.Bool())
+				hresUsers := []*schema.User{
+					{Id: user2.ID, Name: user2.Name, RealName: user2.RealName()},
+					{Id: user1.ID, Name: user1.Name, RealName: user1.RealName()},
+				}
+
+				// 重複したIDは1回だけ問い合わせ、リポジトリの結果の順によらずリクエストした順に返す
+				args := repository.GetUsersArgs{
+					IDs: []uuid.UUID{user2.ID, user1.ID},
+				}
+
+				mr.user.EXPECT().GetUsers(anyCtx{}, &args).Return([]*domain.User{user1, user2}, nil)
+				return hresUsers, fmt.Sprintf("/api/v1/users?ids=%s,%s,%s", user2.ID, user1.ID, user2.ID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "invalid args: ids with limit",
+			setup: func(_ MockRepository) (hres []*schema.User, path string) {
+				return nil, fmt.Sprintf("/api/v1/users?ids=%s&limit=1", random.UUID())
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid args: ids with name",
+			setup: func(_ MockRepository) (hres []*schema.User, path string) {
+				return nil, fmt.Sprintf("/api/v1/users?ids=%s&name=%s", random.UUID(), random.AlphaNumeric())
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid ids",
+			setup: func(_ MockRepository) (hres []*schema.User, path string) {
+				return nil, fmt.Sprintf("/api/v1/users?ids=%s,%s", random.UUID(), invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "empty ids",
+			setup: func(_ MockRepository) (hres []*schema.User, path string) {
+				return nil, "/api/v1/users?ids="
+			},
+			statusCode: http.StatusBadRequest,
+		},
 		{
 			name: "invalid args: multiple options",
 			setup: func(_ MockRepository) (hres []*schema.User, path string) {
@@ -166,6 +218,98 @@ func TestUserHandler_GetUsers(t *testing.T) {
 	}
 }
 
+func TestUserHandler_GetUsers_MissingIDs(t *testing.T) {
+	t.Parallel()
+
+	mr, api := setupUserMock(t)
+
+	user := domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), random.Bool())
+	missing1 := random.UUID()
+	missing2 := random.UUID()
+	mr.user.EXPECT().GetUsers(anyCtx{}, &repository.GetUsersArgs{IDs: []uuid.UUID{missing1, user.ID, missing2}}).Return([]*domain.User{user}, nil)
+
+	var resBody []*schema.User
+	statusCode, rec := doRequest(t, api, http.MethodGet, fmt.Sprintf("/api/v1/users?ids=%s,%s,%s", missing1, user.ID, missing2), nil, &resBody)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.Equal(t, []*schema.User{{Id: user.ID, Name: user.Name, RealName: user.RealName()}}, resBody)
+	assert.Equal(t, fmt.Sprintf("%s,%s", missing1, missing2), rec.Header().Get(headerMissingIDs))
+}
+
+func TestUserHandler_BatchGetUsers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (reqBody *schema.BatchRequest, hres *schema.UsersBatch)
+		statusCode int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (*schema.BatchRequest, *schema.UsersBatch) {
+				user1 := domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), random.Bool())
+				user2 := domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), random.Bool())
+				missing := random.UUID()
+				ids := []uuid.UUID{user2.ID, missing, user1.ID}
+
+				mr.user.EXPECT().GetUsers(anyCtx{}, &repository.GetUsersArgs{IDs: ids}).Return([]*domain.User{user1, user2}, nil)
+
+				return &schema.BatchRequest{Ids: ids}, &schema.UsersBatch{
+					Users: []schema.User{
+						{Id: user2.ID, Name: user2.Name, RealName: user2.RealName()},
+						{Id: user1.ID, Name: user1.Name, RealName: user1.RealName()},
+					},
+					Missing: []uuid.UUID{missing},
+				}
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "success: all missing",
+			setup: func(mr MockRepository) (*schema.BatchRequest, *schema.UsersBatch) {
+				ids := []uuid.UUID{random.UUID()}
+				mr.user.EXPECT().GetUsers(anyCtx{}, &repository.GetUsersArgs{IDs: ids}).Return([]*domain.User{}, nil)
+
+				return &schema.BatchRequest{Ids: ids}, &schema.UsersBatch{Users: []schema.User{}, Missing: ids}
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "empty ids",
+			setup: func(_ MockRepository) (*schema.BatchRequest, *schema.UsersBatch) {
+				return &schema.BatchRequest{Ids: []uuid.UUID{}}, nil
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "internal error",
+			setup: func(mr MockRepository) (*schema.BatchRequest, *schema.UsersBatch) {
+				ids := []uuid.UUID{random.UUID()}
+				mr.user.EXPECT().GetUsers(anyCtx{}, &repository.GetUsersArgs{IDs: ids}).Return(nil, errInternal)
+
+				return &schema.BatchRequest{Ids: ids}, nil
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupUserMock(t)
+
+			reqBody, hres := tt.setup(mr)
+
+			var resBody *schema.UsersBatch
+			statusCode, _ := doRequest(t, api, http.MethodPost, "/api/v1/users/batch", reqBody, &resBody)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, hres, resBody)
+		})
+	}
+}
+
 func TestUserHandler_SyncUsers(t *testing.T) {
 	t.Parallel()
 
@@ -227,6 +371,7 @@ func TestUserHandler_GetUser(t *testing.T) {
 						ID:          random.UUID(),
 						DisplayName: random.AlphaNumeric(),
 						Type:        rand.N(domain.AccountLimit),
+						Handle:      random.AlphaNumeric(),
 						URL:         random.AlphaNumeric(),
 					}
 
@@ -234,6 +379,7 @@ func TestUserHandler_GetUser(t *testing.T) {
 						Id:          raccount.ID,
 						DisplayName: raccount.DisplayName,
 						Type:        schema.AccountType(raccount.Type),
+						Handle:      raccount.Handle,
 						Url:         raccount.URL,
 					}
 
@@ -241,16 +387,47 @@ func TestUserHandler_GetUser(t *testing.T) {
 					hAccounts = append(hAccounts, haccount)
 				}
 
+				rFeatured := []*domain.UserFeaturedItem{
+					{
+						Type: domain.FeaturedItemProject,
+						ID:   random.UUID(),
+						Name: random.AlphaNumeric(),
+					},
+					{
+						Type:      domain.FeaturedItemContestTeam,
+						ID:        random.UUID(),
+						Name:      random.AlphaNumeric(),
+						ContestID: random.UUID(),
+						TeamName:  random.AlphaNumeric(),
+					},
+				}
+				hFeatured := []schema.UserFeaturedItem{
+					{
+						Type: schema.FeaturedItemType(rFeatured[0].Type),
+						Id:   rFeatured[0].ID,
+						Name: rFeatured[0].Name,
+					},
+					{
+						Type:      schema.FeaturedItemType(rFeatured[1].Type),
+						Id:        rFeatured[1].ID,
+						Name:      rFeatured[1].Name,
+						ContestId: &rFeatured[1].ContestID,
+						TeamName:  &rFeatured[1].TeamName,
+					},
+				}
+
 				repoUser := domain.UserDetail{
 					User:     *domain.NewUser(random.UUID(), random.AlphaNumeric(), random.AlphaNumeric(), random.Bool()),
 					State:    rand.N(domain.TraqStateLimit),
 					Bio:      random.AlphaNumericN(rand.IntN(256) + 1),
 					Accounts: rAccounts,
+					Featured: rFeatured,
 				}
 
 				hresUser := schema.UserDetail{
 					Accounts: hAccounts,
 					Bio:      repoUser.Bio,
+					Featured: hFeatured,
 					Id:       repoUser.User.ID,
 					Name:     repoUser.User.Name,
 					RealName: repoUser.User.RealName(),
@@ -308,6 +485,11 @@ func TestUserHandler_GetUser(t *testing.T) {
 			// Assertion
 			assert.Equal(t, tt.statusCode, statusCode)
 			assert.Equal(t, hresUsers, resBody)
+			if resBody != nil {
+				for _, a := range resBody.Accounts {
+					assert.NotEmpty(t, a.Handle)
+				}
+			}
 		})
 	}
 }
@@ -452,6 +634,168 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 	}
 }
 
+func TestUserHandler_EditUserFeaturedItems(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (reqBody *schema.EditUserFeaturedItemsRequest, path string)
+		statusCode int
+	}{
+		{
+			name: "Success",
+			setup: func(mr MockRepository) (*schema.EditUserFeaturedItemsRequest, string) {
+				userID := random.UUID()
+				projectID := random.UUID()
+				teamID := random.UUID()
+
+				reqBody := &schema.EditUserFeaturedItemsRequest{
+					Items: []schema.FeaturedItemID{
+						{Type: schema.FeaturedItemType(domain.FeaturedItemContestTeam), Id: teamID},
+						{Type: schema.FeaturedItemType(domain.FeaturedItemProject), Id: projectID},
+					},
+				}
+
+				args := []*repository.EditUserFeaturedItemArgs{
+					{Type: domain.FeaturedItemContestTeam, ID: teamID},
+					{Type: domain.FeaturedItemProject, ID: projectID},
+				}
+
+				path := fmt.Sprintf("/api/v1/users/%s/featured", userID)
+				mr.user.EXPECT().EditUserFeaturedItems(anyCtx{}, userID, args).Return(nil)
+				return reqBody, path
+			},
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name: "Success: empty",
+			setup: func(mr MockRepository) (*schema.EditUserFeaturedItemsRequest, string) {
+				userID := random.UUID()
+
+				reqBody := &schema.EditUserFeaturedItemsRequest{
+					Items: []schema.FeaturedItemID{},
+				}
+
+				path := fmt.Sprintf("/api/v1/users/%s/featured", userID)
+				mr.user.EXPECT().EditUserFeaturedItems(anyCtx{}, userID, []*repository.EditUserFeaturedItemArgs{}).Return(nil)
+				return reqBody, path
+			},
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name: "Not Found",
+			setup: func(mr MockRepository) (*schema.EditUserFeaturedItemsRequest, string) {
+				userID := random.UUID()
+
+				reqBody := &schema.EditUserFeaturedItemsRequest{
+					Items: []schema.FeaturedItemID{},
+				}
+
+				path := fmt.Sprintf("/api/v1/users/%s/featured", userID)
+				mr.user.EXPECT().EditUserFeaturedItems(anyCtx{}, userID, []*repository.EditUserFeaturedItemArgs{}).Return(repository.ErrNotFound)
+				return reqBody, path
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: not belonging",
+			setup: func(mr MockRepository) (*schema.EditUserFeaturedItemsRequest, string) {
+				userID := random.UUID()
+				projectID := random.UUID()
+
+				reqBody := &schema.EditUserFeaturedItemsRequest{
+					Items: []schema.FeaturedItemID{
+						{Type: schema.FeaturedItemType(domain.FeaturedItemProject), Id: projectID},
+					},
+				}
+
+				args := []*repository.EditUserFeaturedItemArgs{
+					{Type: domain.FeaturedItemProject, ID: projectID},
+				}
+
+				path := fmt.Sprintf("/api/v1/users/%s/featured", userID)
+				mr.user.EXPECT().EditUserFeaturedItems(anyCtx{}, userID, args).Return(repository.ErrInvalidArg)
+				return reqBody, path
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: duplicated items",
+			setup: func(_ MockRepository) (*schema.EditUserFeaturedItemsRequest, string) {
+				userID := random.UUID()
+				projectID := random.UUID()
+
+				reqBody := &schema.EditUserFeaturedItemsRequest{
+					Items: []schema.FeaturedItemID{
+						{Type: schema.FeaturedItemType(domain.FeaturedItemProject), Id: projectID},
+						{Type: schema.FeaturedItemType(domain.FeaturedItemProject), Id: projectID},
+					},
+				}
+
+				path := fmt.Sprintf("/api/v1/users/%s/featured", userID)
+				return reqBody, path
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: too many items",
+			setup: func(_ MockRepository) (*schema.EditUserFeaturedItemsRequest, string) {
+				userID := random.UUID()
+
+				items := make([]schema.FeaturedItemID, domain.UserFeaturedItemsLimit+1)
+				for i := range items {
+					items[i] = schema.FeaturedItemID{Type: schema.FeaturedItemType(domain.FeaturedItemProject), Id: random.UUID()}
+				}
+				reqBody := &schema.EditUserFeaturedItemsRequest{
+					Items: items,
+				}
+
+				path := fmt.Sprintf("/api/v1/users/%s/featured", userID)
+				return reqBody, path
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: invalid type",
+			setup: func(_ MockRepository) (*schema.EditUserFeaturedItemsRequest, string) {
+				userID := random.UUID()
+
+				reqBody := &schema.EditUserFeaturedItemsRequest{
+					Items: []schema.FeaturedItemID{
+						{Type: schema.FeaturedItemType(domain.FeaturedItemLimit), Id: random.UUID()},
+					},
+				}
+
+				path := fmt.Sprintf("/api/v1/users/%s/featured", userID)
+				return reqBody, path
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: invalid userID",
+			setup: func(_ MockRepository) (*schema.EditUserFeaturedItemsRequest, string) {
+				path := fmt.Sprintf("/api/v1/users/%s/featured", "invalid")
+				return nil, path
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupUserMock(t)
+
+			reqBody, path := tt.setup(mr)
+
+			statusCode, _ := doRequest(t, api, http.MethodPut, path, reqBody, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+		})
+	}
+}
+
 func TestUserHandler_GetUserAccounts(t *testing.T) {
 	t.Parallel()
 
@@ -567,12 +911,14 @@ func TestUserHandler_GetUserAccount(t *testing.T) {
 					DisplayName: random.AlphaNumeric(),
 					Type:        rand.N(domain.AccountLimit),
 					URL:         random.AlphaNumeric(),
+					Handle:      random.AlphaNumeric(),
 				}
 				hAccount := schema.Account{
 					Id:          rAccount.ID,
 					DisplayName: rAccount.DisplayName,
 					Type:        schema.AccountType(rAccount.Type),
 					Url:         rAccount.URL,
+					Handle:      rAccount.Handle,
 				}
 
 				mr.user.EXPECT().GetAccount(anyCtx{}, userID, rAccount.ID).Return(&rAccount, nil)
@@ -634,6 +980,84 @@ func TestUserHandler_GetUserAccount(t *testing.T) {
 	}
 }
 
+func TestUserHandler_GetUserAccountStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (hres *schema.AccountStats, path string)
+		statusCode int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (hres *schema.AccountStats, path string) {
+				userID := random.UUID()
+				accountID := random.UUID()
+
+				rStats := domain.AccountStats{
+					AccountID:     accountID,
+					Rating:        1500,
+					MaxRating:     2100,
+					Color:         "blue",
+					RatedContests: 3,
+					FetchedAt:     random.Time(),
+				}
+				hStats := schema.AccountStats{
+					Rating:        rStats.Rating,
+					MaxRating:     rStats.MaxRating,
+					Color:         rStats.Color,
+					RatedContests: rStats.RatedContests,
+					FetchedAt:     rStats.FetchedAt,
+				}
+
+				mr.accountStats.EXPECT().GetAccountStats(anyCtx{}, userID, accountID).Return(&rStats, nil)
+				path = fmt.Sprintf("/api/v1/users/%s/accounts/%s/stats", userID, accountID)
+				return &hStats, path
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "not found",
+			setup: func(mr MockRepository) (hres *schema.AccountStats, path string) {
+				userID := random.UUID()
+				accountID := random.UUID()
+
+				mr.accountStats.EXPECT().GetAccountStats(anyCtx{}, userID, accountID).Return(nil, repository.ErrNotFound)
+				path = fmt.Sprintf("/api/v1/users/%s/accounts/%s/stats", userID, accountID)
+				return nil, path
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: validate error nonUUID",
+			setup: func(_ MockRepository) (hres *schema.AccountStats, path string) {
+				userID := random.UUID()
+				accountID := random.AlphaNumericN(36)
+
+				path = fmt.Sprintf("/api/v1/users/%s/accounts/%s/stats", userID, accountID)
+				return nil, path
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupUserMock(t)
+
+			hres, path := tt.setup(mr)
+
+			var resBody *schema.AccountStats
+			statusCode, _ := doRequest(t, api, http.MethodGet, path, nil, &resBody)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, hres, resBody)
+		})
+	}
+}
+
 func TestUserHandler_AddUserAccount(t *testing.T) {
 	t.Parallel()
 
@@ -681,19 +1105,19 @@ func TestUserHandler_AddUserAccount(t *testing.T) {
 			statusCode: http.StatusCreated,
 		},
 		{
-			name: "Success: Account Type is 0",
+			name: "Success: handle and mixed-case url",
 			setup: func(mr MockRepository) (*schema.AddAccountRequest, schema.Account, string) {
 				userID := random.UUID()
 
 				reqBody := schema.AddAccountRequest{
 					DisplayName: random.AlphaNumeric(),
-					Type:        0,
-					Url:         random.AccountURLString(0),
+					Type:        schema.AccountType(domain.GITHUB),
+					Url:         "HTTPS://GitHub.com/traPtitech/",
 				}
 
 				args := repository.CreateAccountArgs{
 					DisplayName: reqBody.DisplayName,
-					Type:        domain.AccountType(uint8(reqBody.Type)),
+					Type:        domain.GITHUB,
 					URL:         reqBody.Url,
 				}
 
@@ -701,14 +1125,16 @@ func TestUserHandler_AddUserAccount(t *testing.T) {
 					ID:          userID,
 					DisplayName: args.DisplayName,
 					Type:        args.Type,
-					URL:         args.URL,
+					Handle:      "traPtitech",
+					URL:         "https://github.com/traPtitech",
 				}
 
 				expectedResBody := schema.Account{
 					Id:          userID,
 					DisplayName: reqBody.DisplayName,
 					Type:        reqBody.Type,
-					Url:         reqBody.Url,
+					Handle:      want.Handle,
+					Url:         want.URL,
 				}
 
 				path := fmt.Sprintf("/api/v1/users/%s/accounts", userID)
@@ -718,7 +1144,44 @@ func TestUserHandler_AddUserAccount(t *testing.T) {
 			statusCode: http.StatusCreated,
 		},
 		{
-			name: "Bad Request: DisplayName is empty",
+			name: "Success: Account Type is 0",
+			setup: func(mr MockRepository) (*schema.AddAccountRequest, schema.Account, string) {
+				userID := random.UUID()
+
+				reqBody := schema.AddAccountRequest{
+					DisplayName: random.AlphaNumeric(),
+					Type:        0,
+					Url:         random.AccountURLString(0),
+				}
+
+				args := repository.CreateAccountArgs{
+					DisplayName: reqBody.DisplayName,
+					Type:        domain.AccountType(uint8(reqBody.Type)),
+					URL:         reqBody.Url,
+				}
+
+				want := domain.Account{
+					ID:          userID,
+					DisplayName: args.DisplayName,
+					Type:        args.Type,
+					URL:         args.URL,
+				}
+
+				expectedResBody := schema.Account{
+					Id:          userID,
+					DisplayName: reqBody.DisplayName,
+					Type:        reqBody.Type,
+					Url:         reqBody.Url,
+				}
+
+				path := fmt.Sprintf("/api/v1/users/%s/accounts", userID)
+				mr.user.EXPECT().CreateAccount(anyCtx{}, userID, &args).Return(&want, nil)
+				return &reqBody, expectedResBody, path
+			},
+			statusCode: http.StatusCreated,
+		},
+		{
+			name: "Bad Request: DisplayName is empty",
 			setup: func(_ MockRepository) (*schema.AddAccountRequest, schema.Account, string) {
 				userID := random.UUID()
 				accountType := rand.N(domain.AccountLimit)
@@ -921,10 +1384,12 @@ func TestUserHandler_EditUserAccount(t *testing.T) {
 				userID := random.UUID()
 				accountID := random.UUID()
 
-				argsURL := random.AlphaNumeric()
+				accountType := schema.AccountType(domain.GITHUB)
+				argsURL := "https://example.com/" + random.AlphaNumeric()
 
 				reqBody := schema.EditUserAccountRequest{
-					Url: &argsURL,
+					Type: &accountType,
+					Url:  &argsURL,
 				}
 
 				path := fmt.Sprintf("/api/v1/users/%s/accounts/%s", userID, accountID)
@@ -1055,6 +1520,28 @@ func TestUserHandler_DeleteUserAccount(t *testing.T) {
 	}
 }
 
+func TestUserHandler_GetAccountTypes(t *testing.T) {
+	t.Parallel()
+
+	_, api := setupUserMock(t)
+
+	var hres []schema.AccountTypeDefinition
+	statusCode, _ := doRequest(t, api, http.MethodGet, "/api/v1/account-types", nil, &hres)
+
+	assert.Equal(t, http.StatusOK, statusCode)
+	if assert.Len(t, hres, int(domain.AccountLimit)) {
+		for i, v := range hres {
+			assert.Equal(t, schema.AccountType(i), v.Id)
+		}
+
+		github := hres[domain.GITHUB]
+		assert.Equal(t, "GitHub", github.Label)
+		assert.Equal(t, "github", github.Icon)
+		assert.NotNil(t, github.HandlePattern)
+		assert.Nil(t, hres[domain.HOMEPAGE].HandlePattern)
+	}
+}
+
 func TestUserHandler_GetUserProjects(t *testing.T) {
 	makeProjects := func(t *testing.T, mr MockRepository, projectsLen int) (hres []*schema.UserProject, path string) {
 		t.Helper()
@@ -1264,6 +1751,580 @@ func TestUserHandler_GetUserContests(t *testing.T) {
 	}
 }
 
+func TestUserHandler_GetUserResume(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (hres *schema.Resume, path string)
+		statusCode int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (hres *schema.Resume, path string) {
+				userID := random.UUID()
+				user := domain.UserDetail{
+					User: *domain.NewUser(userID, "user1", "User One", true),
+					Bio:  random.AlphaNumeric(),
+					Accounts: []*domain.Account{
+						{ID: random.UUID(), DisplayName: "user1_twitter", Type: domain.TWITTER, Handle: "user1", URL: "https://twitter.com/user1"},
+						{ID: random.UUID(), DisplayName: "user1's blog", Type: domain.BLOG, URL: "https://blog.example.com"},
+					},
+				}
+				projects := []*domain.UserProject{
+					{
+						ID:           random.UUID(),
+						Name:         "project1",
+						Duration:     domain.NewYearWithSemesterDuration(2021, 0, 2023, 1),
+						UserDuration: domain.NewYearWithSemesterDuration(2021, 1, 2022, 1),
+					},
+				}
+				contests := []*domain.UserContest{
+					{
+						ID:        random.UUID(),
+						Name:      "contest1",
+						TimeStart: time.Date(2022, 8, 1, 9, 0, 0, 0, time.UTC),
+						TimeEnd:   time.Date(2022, 8, 2, 9, 0, 0, 0, time.UTC),
+						Teams: []*domain.ContestTeamWithoutMembers{
+							{ID: random.UUID(), Name: "team1", Result: "優勝"},
+						},
+					},
+				}
+				groups := []*domain.UserGroup{
+					{
+						ID:       random.UUID(),
+						Name:     "group1",
+						Duration: domain.NewYearWithSemesterDuration(2022, 0, 0, 0),
+					},
+				}
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&user, nil)
+				mr.user.EXPECT().GetProjects(guestCtx{}, userID).Return(projects, nil)
+				mr.user.EXPECT().GetContests(guestCtx{}, userID).Return(contests, nil)
+				mr.user.EXPECT().GetGroupsByUserID(guestCtx{}, userID).Return(groups, nil)
+
+				projectEndDate := "2023-03"
+				hres = &schema.Resume{
+					Basics: schema.ResumeBasics{
+						Name:    "User One",
+						Summary: user.Bio,
+						Profiles: []schema.ResumeProfile{
+							{Network: "Twitter", Username: "user1", Url: "https://twitter.com/user1"},
+							{Network: "ブログ", Username: "user1's blog", Url: "https://blog.example.com"},
+						},
+					},
+					Projects: []schema.ResumeProject{
+						{Name: "project1", StartDate: "2021-10", EndDate: &projectEndDate},
+					},
+					Awards: []schema.ResumeAward{
+						{Title: "contest1 (team1)", Date: "2022-08-01", Awarder: "contest1", Summary: "優勝"},
+					},
+					Volunteer: []schema.ResumeVolunteer{
+						{Organization: "group1", StartDate: "2022-04"},
+					},
+				}
+				return hres, fmt.Sprintf("/api/v1/users/%s/resume.json", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "success: real name is not permitted",
+			setup: func(mr MockRepository) (hres *schema.Resume, path string) {
+				userID := random.UUID()
+				user := domain.UserDetail{
+					User:     *domain.NewUser(userID, "user1", "User One", false),
+					Accounts: []*domain.Account{},
+				}
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&user, nil)
+				mr.user.EXPECT().GetProjects(guestCtx{}, userID).Return([]*domain.UserProject{}, nil)
+				mr.user.EXPECT().GetContests(guestCtx{}, userID).Return([]*domain.UserContest{}, nil)
+				mr.user.EXPECT().GetGroupsByUserID(guestCtx{}, userID).Return([]*domain.UserGroup{}, nil)
+
+				hres = &schema.Resume{
+					Basics: schema.ResumeBasics{
+						Name:     "user1",
+						Profiles: []schema.ResumeProfile{},
+					},
+					Projects:  []schema.ResumeProject{},
+					Awards:    []schema.ResumeAward{},
+					Volunteer: []schema.ResumeVolunteer{},
+				}
+				return hres, fmt.Sprintf("/api/v1/users/%s/resume.json", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "not found",
+			setup: func(mr MockRepository) (hres *schema.Resume, path string) {
+				userID := random.UUID()
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(nil, repository.ErrNotFound)
+				return nil, fmt.Sprintf("/api/v1/users/%s/resume.json", userID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: invalid userID",
+			setup: func(_ MockRepository) (hres *schema.Resume, path string) {
+				return nil, fmt.Sprintf("/api/v1/users/%s/resume.json", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupUserMock(t)
+
+			hres, path := tt.setup(mr)
+
+			var resBody *schema.Resume
+			statusCode, _ := doRequest(t, api, http.MethodGet, path, nil, &resBody)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			assert.Equal(t, hres, resBody)
+		})
+	}
+}
+
+func TestUserHandler_GetUserCv(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (path string)
+		statusCode int
+		pages      int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (path string) {
+				userID := random.UUID()
+				user := domain.UserDetail{
+					User: *domain.NewUser(userID, "user1", "User One", true),
+					Bio:  random.AlphaNumeric(),
+					Accounts: []*domain.Account{
+						{ID: random.UUID(), DisplayName: "user1_twitter", Type: domain.TWITTER, Handle: "user1", URL: "https://twitter.com/user1"},
+					},
+				}
+				projects := []*domain.UserProject{
+					{
+						ID:           random.UUID(),
+						Name:         "project1",
+						Duration:     domain.NewYearWithSemesterDuration(2021, 0, 2023, 1),
+						UserDuration: domain.NewYearWithSemesterDuration(2021, 1, 2022, 1),
+					},
+				}
+				contests := []*domain.UserContest{
+					{
+						ID:        random.UUID(),
+						Name:      "contest1",
+						TimeStart: time.Date(2022, 8, 1, 9, 0, 0, 0, time.UTC),
+						TimeEnd:   time.Date(2022, 8, 2, 9, 0, 0, 0, time.UTC),
+						Teams: []*domain.ContestTeamWithoutMembers{
+							{ID: random.UUID(), Name: "team1", Result: "優勝"},
+						},
+					},
+				}
+				groups := []*domain.UserGroup{
+					{
+						ID:       random.UUID(),
+						Name:     "group1",
+						Duration: domain.NewYearWithSemesterDuration(2022, 0, 0, 0),
+					},
+				}
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&user, nil)
+				mr.user.EXPECT().GetProjects(guestCtx{}, userID).Return(projects, nil)
+				mr.user.EXPECT().GetContests(guestCtx{}, userID).Return(contests, nil)
+				mr.user.EXPECT().GetGroupsByUserID(guestCtx{}, userID).Return(groups, nil)
+				return fmt.Sprintf("/api/v1/users/%s/cv.pdf", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "success: selected sections",
+			setup: func(mr MockRepository) (path string) {
+				userID := random.UUID()
+				user := domain.UserDetail{
+					User:     *domain.NewUser(userID, "user1", "User One", false),
+					Accounts: []*domain.Account{},
+				}
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&user, nil)
+				mr.user.EXPECT().GetContests(guestCtx{}, userID).Return([]*domain.UserContest{}, nil)
+				return fmt.Sprintf("/api/v1/users/%s/cv.pdf?sections=profile&sections=contests", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "success: limited to two pages",
+			setup: func(mr MockRepository) (path string) {
+				userID := random.UUID()
+				user := domain.UserDetail{
+					User:     *domain.NewUser(userID, "user1", "User One", true),
+					Accounts: []*domain.Account{},
+				}
+				projects := make([]*domain.UserProject, 0, 200)
+				for range 200 {
+					projects = append(projects, &domain.UserProject{
+						ID:           random.UUID(),
+						Name:         random.AlphaNumeric(),
+						UserDuration: domain.NewYearWithSemesterDuration(2021, 1, 2022, 1),
+					})
+				}
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&user, nil)
+				mr.user.EXPECT().GetProjects(guestCtx{}, userID).Return(projects, nil)
+				return fmt.Sprintf("/api/v1/users/%s/cv.pdf?sections=projects", userID)
+			},
+			statusCode: http.StatusOK,
+			pages:      2,
+		},
+		{
+			name: "not found",
+			setup: func(mr MockRepository) (path string) {
+				userID := random.UUID()
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(nil, repository.ErrNotFound)
+				return fmt.Sprintf("/api/v1/users/%s/cv.pdf", userID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: invalid section",
+			setup: func(_ MockRepository) (path string) {
+				return fmt.Sprintf("/api/v1/users/%s/cv.pdf?sections=hobbies", random.UUID())
+			},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Bad Request: invalid userID",
+			setup: func(_ MockRepository) (path string) {
+				return fmt.Sprintf("/api/v1/users/%s/cv.pdf", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupUserMock(t)
+
+			path := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, path, nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "application/pdf", rec.Header().Get(echo.HeaderContentType))
+				assert.True(t, strings.HasPrefix(rec.Body.String(), "%PDF-"))
+				if tt.pages > 0 {
+					assert.Contains(t, rec.Body.String(), fmt.Sprintf("/Count %d\n", tt.pages))
+				}
+			}
+		})
+	}
+}
+
+func TestUserHandler_GetUserMarkdown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (want string, path string)
+		statusCode int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (want string, path string) {
+				userID := random.UUID()
+				user := domain.UserDetail{
+					User: *domain.NewUser(userID, "user_1", "User One", true),
+					Bio:  "# Hello, [traP](https://trap.jp)! <b>|</b>",
+					Accounts: []*domain.Account{
+						{ID: random.UUID(), DisplayName: "user1_twitter", Type: domain.TWITTER, Handle: "user1", URL: "https://twitter.com/user1"},
+					},
+				}
+				projects := []*domain.UserProject{
+					{
+						ID:           random.UUID(),
+						Name:         "project|1",
+						Duration:     domain.NewYearWithSemesterDuration(2021, 0, 2023, 1),
+						UserDuration: domain.NewYearWithSemesterDuration(2021, 1, 0, 0),
+					},
+				}
+				contests := []*domain.UserContest{
+					{
+						ID:        random.UUID(),
+						Name:      "contest1",
+						TimeStart: time.Date(2022, 8, 1, 9, 0, 0, 0, time.UTC),
+						TimeEnd:   time.Date(2022, 8, 2, 9, 0, 0, 0, time.UTC),
+						Teams: []*domain.ContestTeamWithoutMembers{
+							{ID: random.UUID(), Name: "team1", Result: "優勝"},
+							{ID: random.UUID(), Name: "team2"},
+						},
+					},
+				}
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&user, nil)
+				mr.user.EXPECT().GetProjects(guestCtx{}, userID).Return(projects, nil)
+				mr.user.EXPECT().GetContests(guestCtx{}, userID).Return(contests, nil)
+
+				want = `# User One (@user\_1)
+
+\# Hello, \[traP\](https://trap.jp)! \<b\>\|\</b\>
+
+## アカウント
+
+- Twitter: [user1](https://twitter.com/user1)
+
+## プロジェクト
+
+| プロジェクト | 参加期間 |
+| --- | --- |
+| project\|1 | 2021年度後期 〜 現在 |
+
+## コンテスト
+
+| コンテスト | 日付 | チーム | 結果 |
+| --- | --- | --- | --- |
+| contest1 | 2022/08/01 | team1 | 優勝 |
+| contest1 | 2022/08/01 | team2 | - |
+`
+				return want, fmt.Sprintf("/api/v1/users/%s/export.md", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "success: english and real name is not permitted",
+			setup: func(mr MockRepository) (want string, path string) {
+				userID := random.UUID()
+				user := domain.UserDetail{
+					User:     *domain.NewUser(userID, "user1", "User One", false),
+					Accounts: []*domain.Account{},
+				}
+				projects := []*domain.UserProject{
+					{
+						ID:           random.UUID(),
+						Name:         "project1",
+						Duration:     domain.NewYearWithSemesterDuration(2021, 0, 2023, 1),
+						UserDuration: domain.NewYearWithSemesterDuration(2021, 0, 2022, 1),
+					},
+				}
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&user, nil)
+				mr.user.EXPECT().GetProjects(guestCtx{}, userID).Return(projects, nil)
+				mr.user.EXPECT().GetContests(guestCtx{}, userID).Return([]*domain.UserContest{}, nil)
+
+				want = `# @user1
+
+## Projects
+
+| Project | Membership |
+| --- | --- |
+| project1 | Spring 2021 - Fall 2022 |
+`
+				return want, fmt.Sprintf("/api/v1/users/%s/export.md?lang=en", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "not found",
+			setup: func(mr MockRepository) (want string, path string) {
+				userID := random.UUID()
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(nil, repository.ErrNotFound)
+				return "", fmt.Sprintf("/api/v1/users/%s/export.md", userID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: invalid userID",
+			setup: func(_ MockRepository) (want string, path string) {
+				return "", fmt.Sprintf("/api/v1/users/%s/export.md", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupUserMock(t)
+
+			want, path := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, path, nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "text/markdown; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestUserHandler_GetUserBadge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (title string, path string)
+		statusCode int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (string, string) {
+				userID := random.UUID()
+				projects := []*domain.UserProject{{ID: random.UUID(), Name: "project1"}, {ID: random.UUID(), Name: "project2"}}
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&domain.UserDetail{User: *domain.NewUser(userID, "user1", "", false)}, nil)
+				mr.user.EXPECT().GetProjects(guestCtx{}, userID).Return(projects, nil)
+				return "<title>traP: 2 プロジェクト</title>", fmt.Sprintf("/api/v1/users/%s/badge.svg", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "success: english and a project",
+			setup: func(mr MockRepository) (string, string) {
+				userID := random.UUID()
+				projects := []*domain.UserProject{{ID: random.UUID(), Name: "project1"}}
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&domain.UserDetail{User: *domain.NewUser(userID, "user1", "", false)}, nil)
+				mr.user.EXPECT().GetProjects(guestCtx{}, userID).Return(projects, nil)
+				return "<title>traP: 1 project</title>", fmt.Sprintf("/api/v1/users/%s/badge.svg?lang=en", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "not found",
+			setup: func(mr MockRepository) (string, string) {
+				userID := random.UUID()
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(nil, repository.ErrNotFound)
+				return "", fmt.Sprintf("/api/v1/users/%s/badge.svg", userID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "invalid userID",
+			setup: func(_ MockRepository) (string, string) {
+				return "", fmt.Sprintf("/api/v1/users/%s/badge.svg", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupUserMock(t)
+
+			title, path := tt.setup(mr)
+
+			statusCode, rec := doRequestWithHeader(t, api, http.MethodGet, path, nil, nil, map[string]string{"X-Forwarded-User": "user1"})
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "image/svg+xml; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Equal(t, "public, max-age=600", rec.Header().Get(echo.HeaderCacheControl))
+				assert.Contains(t, rec.Body.String(), title)
+			}
+		})
+	}
+}
+
+func TestUserHandler_GetUserContestsBadge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (title string, path string)
+		statusCode int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (string, string) {
+				userID := random.UUID()
+				contests := []*domain.UserContest{
+					{
+						ID:        random.UUID(),
+						Name:      "ICPC 2021",
+						TimeStart: time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC),
+						Teams:     []*domain.ContestTeamWithoutMembers{{ID: random.UUID(), Name: "team1", Result: "Asia Finalist"}},
+					},
+					{
+						ID:        random.UUID(),
+						Name:      "ISUCON <12>",
+						TimeStart: time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+						Teams: []*domain.ContestTeamWithoutMembers{
+							{ID: random.UUID(), Name: "team2", Result: "優勝"},
+							{ID: random.UUID(), Name: "team3"},
+						},
+					},
+				}
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&domain.UserDetail{User: *domain.NewUser(userID, "user1", "", false)}, nil)
+				mr.user.EXPECT().GetContests(guestCtx{}, userID).Return(contests, nil)
+				return "<title>ISUCON &lt;12&gt;: 優勝, ICPC 2021: Asia Finalist</title>", fmt.Sprintf("/api/v1/users/%s/badges/contests.svg", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "success: no results",
+			setup: func(mr MockRepository) (string, string) {
+				userID := random.UUID()
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&domain.UserDetail{User: *domain.NewUser(userID, "user1", "", false)}, nil)
+				mr.user.EXPECT().GetContests(guestCtx{}, userID).Return([]*domain.UserContest{}, nil)
+				return "<title>contests: none</title>", fmt.Sprintf("/api/v1/users/%s/badges/contests.svg?lang=en", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "not found",
+			setup: func(mr MockRepository) (string, string) {
+				userID := random.UUID()
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(nil, repository.ErrNotFound)
+				return "", fmt.Sprintf("/api/v1/users/%s/badges/contests.svg", userID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "internal error",
+			setup: func(mr MockRepository) (string, string) {
+				userID := random.UUID()
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&domain.UserDetail{User: *domain.NewUser(userID, "user1", "", false)}, nil)
+				mr.user.EXPECT().GetContests(guestCtx{}, userID).Return(nil, errInternal)
+				return "", fmt.Sprintf("/api/v1/users/%s/badges/contests.svg", userID)
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupUserMock(t)
+
+			title, path := tt.setup(mr)
+
+			statusCode, rec := doRequestWithHeader(t, api, http.MethodGet, path, nil, nil, map[string]string{"X-Forwarded-User": "user1"})
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "image/svg+xml; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Contains(t, rec.Body.String(), title)
+			}
+		})
+	}
+}
+
 func TestUserHandler_GetUserGroups(t *testing.T) {
 	makeGroups := func(mr MockRepository, groupsLen int) (hres []*schema.UserGroup, path string) {
 		userID := random.UUID()
@@ -1367,6 +2428,98 @@ func TestUserHandler_GetUserGroups(t *testing.T) {
 	}
 }
 
+func TestUserHandler_GetUserEventsCalendar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setup      func(mr MockRepository) (contains []string, notContains []string, path string)
+		statusCode int
+	}{
+		{
+			name: "success",
+			setup: func(mr MockRepository) (contains []string, notContains []string, path string) {
+				userID := random.UUID()
+				user := domain.UserDetail{
+					User: *domain.NewUser(userID, "user1", "User One", true),
+				}
+				public := &domain.Event{
+					ID:        random.UUID(),
+					Name:      "public event",
+					Level:     domain.EventLevelPublic,
+					TimeStart: time.Date(2022, 8, 1, 1, 0, 0, 0, time.UTC),
+					TimeEnd:   time.Date(2022, 8, 1, 9, 0, 0, 0, time.UTC),
+				}
+				anonymous := &domain.Event{
+					ID:    random.UUID(),
+					Name:  "anonymous event",
+					Level: domain.EventLevelAnonymous,
+				}
+				private := &domain.Event{
+					ID:    random.UUID(),
+					Name:  "private event",
+					Level: domain.EventLevelPrivate,
+				}
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(&user, nil)
+				mr.event.EXPECT().GetUserEvents(guestCtx{}, userID).Return([]*domain.Event{public, anonymous, private}, nil)
+
+				contains = []string{
+					"X-WR-CALNAME:@user1 が開催したイベント\r\n",
+					fmt.Sprintf("UID:event-%s@traportfolio\r\n", public.ID),
+					"SUMMARY:public event\r\nDESCRIPTION:開催者: @user1\r\n",
+					"DTSTART:20220801T010000Z\r\n",
+					fmt.Sprintf("UID:event-%s@traportfolio\r\n", anonymous.ID),
+					"SUMMARY:anonymous event\r\nEND:VEVENT\r\n",
+				}
+				notContains = []string{private.ID.String(), "private event"}
+				return contains, notContains, fmt.Sprintf("/api/v1/users/%s/events.ics", userID)
+			},
+			statusCode: http.StatusOK,
+		},
+		{
+			name: "not found",
+			setup: func(mr MockRepository) (contains []string, notContains []string, path string) {
+				userID := random.UUID()
+
+				mr.user.EXPECT().GetUser(guestCtx{}, userID).Return(nil, repository.ErrNotFound)
+				return nil, nil, fmt.Sprintf("/api/v1/users/%s/events.ics", userID)
+			},
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name: "Bad Request: invalid userID",
+			setup: func(_ MockRepository) (contains []string, notContains []string, path string) {
+				return nil, nil, fmt.Sprintf("/api/v1/users/%s/events.ics", invalidID)
+			},
+			statusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Setup mock
+			mr, api := setupUserMock(t)
+
+			contains, notContains, path := tt.setup(mr)
+
+			statusCode, rec := doRequest(t, api, http.MethodGet, path, nil, nil)
+
+			// Assertion
+			assert.Equal(t, tt.statusCode, statusCode)
+			if statusCode == http.StatusOK {
+				assert.Equal(t, "text/calendar; charset=UTF-8", rec.Header().Get(echo.HeaderContentType))
+				for _, v := range contains {
+					assert.Contains(t, rec.Body.String(), v)
+				}
+				for _, v := range notContains {
+					assert.NotContains(t, rec.Body.String(), v)
+				}
+			}
+		})
+	}
+}
+
 func TestUserHandler_GetUserEvents(t *testing.T) {
 	makeEvents := func(mr MockRepository, eventsLen int) (hres []*schema.Event, path string) {
 		userID := random.UUID()
@@ -1532,6 +2685,7 @@ func TestUserHandler_GetMe(t *testing.T) {
 					RealName: ruser.RealName(),
 					Accounts: haccounts,
 					Bio:      ruserDetail.Bio,
+					Featured: []schema.UserFeaturedItem{},
 					State:    schema.UserAccountState(ruserDetail.State),
 				}
 
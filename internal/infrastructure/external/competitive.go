@@ -0,0 +1,145 @@
+//go:generate go run go.uber.org/mock/mockgen@latest -typed -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/config"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+type CompetitiveStatsResponse struct {
+	Rating        int
+	MaxRating     int
+	Color         string
+	RatedContests int
+}
+
+// CompetitiveStatsAPI 競技プログラミングなどのサービスから公開されている成績を取得する
+type CompetitiveStatsAPI interface {
+	// SupportedAccountTypes 成績を取得できるアカウントの種類
+	SupportedAccountTypes() []domain.AccountType
+	// GetStats 対応していない種類の場合やハンドルが存在しない場合はrepository.ErrNotFoundを返す
+	GetStats(accountType domain.AccountType, handle string) (*CompetitiveStatsResponse, error)
+}
+
+// competitiveStatsProvider サービスごとの成績の取得方法
+type competitiveStatsProvider interface {
+	getStats(handle string) (*CompetitiveStatsResponse, error)
+}
+
+type competitiveStatsAPI struct {
+	providers map[domain.AccountType]competitiveStatsProvider
+}
+
+func NewCompetitiveStatsAPI(conf config.CompetitiveConfig) (CompetitiveStatsAPI, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	return &competitiveStatsAPI{
+		providers: map[domain.AccountType]competitiveStatsProvider{
+			domain.ATCODER: &atcoderStatsProvider{client: client, endpoint: conf.AtCoderEndpoint},
+		},
+	}, nil
+}
+
+func (a *competitiveStatsAPI) SupportedAccountTypes() []domain.AccountType {
+	types := make([]domain.AccountType, 0, len(a.providers))
+	for t := range domain.AccountLimit {
+		if _, ok := a.providers[t]; ok {
+			types = append(types, t)
+		}
+	}
+
+	return types
+}
+
+func (a *competitiveStatsAPI) GetStats(accountType domain.AccountType, handle string) (*CompetitiveStatsResponse, error) {
+	p, ok := a.providers[accountType]
+	if !ok {
+		return nil, fmt.Errorf("%w: stats of account type %d are not supported", repository.ErrNotFound, accountType)
+	}
+
+	return p.getStats(handle)
+}
+
+type atcoderStatsProvider struct {
+	client   *http.Client
+	endpoint string
+}
+
+type atcoderHistoryResponse struct {
+	IsRated   bool `json:"IsRated"`
+	NewRating int  `json:"NewRating"`
+}
+
+// atcoderRatingColors レーティングの下限と色の組を下限の降順に並べたもの
+var atcoderRatingColors = []struct {
+	min   int
+	color string
+}{
+	{2800, "red"},
+	{2400, "orange"},
+	{2000, "yellow"},
+	{1600, "blue"},
+	{1200, "cyan"},
+	{800, "green"},
+	{400, "brown"},
+	{1, "gray"},
+}
+
+func atcoderRatingColor(rating int) string {
+	for _, c := range atcoderRatingColors {
+		if rating >= c.min {
+			return c.color
+		}
+	}
+
+	return "black"
+}
+
+func (p *atcoderStatsProvider) getStats(handle string) (*CompetitiveStatsResponse, error) {
+	path := fmt.Sprintf("/users/%s/history/json", url.PathEscape(handle))
+	res, err := p.client.Get(p.endpoint + path)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, repository.ErrNotFound
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s failed: %d", path, res.StatusCode)
+	}
+
+	var history []*atcoderHistoryResponse
+	if err := json.NewDecoder(res.Body).Decode(&history); err != nil {
+		return nil, fmt.Errorf("decode failed: %w", err)
+	}
+
+	stats := &CompetitiveStatsResponse{}
+	for _, h := range history {
+		if !h.IsRated {
+			continue
+		}
+
+		stats.Rating = h.NewRating
+		stats.MaxRating = max(stats.MaxRating, h.NewRating)
+		stats.RatedContests++
+	}
+	stats.Color = atcoderRatingColor(stats.Rating)
+
+	return stats, nil
+}
+
+// Interface guards
+var (
+	_ CompetitiveStatsAPI = (*competitiveStatsAPI)(nil)
+)
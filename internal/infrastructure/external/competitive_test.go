@@ -0,0 +1,126 @@
+package external
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/config"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+func newAtCoderTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/tourist/history/json", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[
+			{"IsRated": true, "NewRating": 1500, "OldRating": 0},
+			{"IsRated": false, "NewRating": 0, "OldRating": 0},
+			{"IsRated": true, "NewRating": 2100, "OldRating": 1500},
+			{"IsRated": true, "NewRating": 1900, "OldRating": 2100}
+		]`)
+	})
+	mux.HandleFunc("GET /users/newcomer/history/json", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("GET /users/broken/history/json", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func TestCompetitiveStatsAPI_GetStats(t *testing.T) {
+	t.Parallel()
+
+	s := newAtCoderTestServer(t)
+	api, err := NewCompetitiveStatsAPI(config.CompetitiveConfig{AtCoderEndpoint: s.URL})
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		accountType domain.AccountType
+		handle      string
+		want        *CompetitiveStatsResponse
+		assertion   assert.ErrorAssertionFunc
+	}{
+		"atcoder": {
+			domain.ATCODER,
+			"tourist",
+			&CompetitiveStatsResponse{Rating: 1900, MaxRating: 2100, Color: "blue", RatedContests: 3},
+			assert.NoError,
+		},
+		"atcoder without rated contests": {
+			domain.ATCODER,
+			"newcomer",
+			&CompetitiveStatsResponse{Rating: 0, MaxRating: 0, Color: "black", RatedContests: 0},
+			assert.NoError,
+		},
+		"atcoder user not found": {
+			domain.ATCODER,
+			"nobody",
+			nil,
+			func(tt assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorIs(tt, err, repository.ErrNotFound)
+			},
+		},
+		"atcoder server error": {
+			domain.ATCODER,
+			"broken",
+			nil,
+			assert.Error,
+		},
+		"unsupported account type": {
+			domain.GITHUB,
+			"tourist",
+			nil,
+			func(tt assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorIs(tt, err, repository.ErrNotFound)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := api.GetStats(tt.accountType, tt.handle)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompetitiveStatsAPI_SupportedAccountTypes(t *testing.T) {
+	t.Parallel()
+
+	api, err := NewCompetitiveStatsAPI(config.CompetitiveConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.AccountType{domain.ATCODER}, api.SupportedAccountTypes())
+}
+
+func Test_atcoderRatingColor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[int]string{
+		0:    "black",
+		1:    "gray",
+		399:  "gray",
+		400:  "brown",
+		1199: "green",
+		1600: "blue",
+		2399: "yellow",
+		2400: "orange",
+		3500: "red",
+	}
+
+	for rating, want := range tests {
+		assert.Equal(t, want, atcoderRatingColor(rating), "rating %d", rating)
+	}
+}
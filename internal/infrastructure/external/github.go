@@ -0,0 +1,138 @@
+//go:generate go run go.uber.org/mock/mockgen@latest -typed -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/config"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+type GitHubRepositoryResponse struct {
+	Description string
+	Languages   []string // コード量の多い順
+	Stars       int
+	License     string // SPDXの識別子
+	PushedAt    time.Time
+}
+
+type GitHubAPI interface {
+	// GetRepository リポジトリが存在しない場合や非公開の場合はrepository.ErrNotFoundを返す
+	GetRepository(owner string, name string) (*GitHubRepositoryResponse, error)
+}
+
+type gitHubAPI struct {
+	client      *http.Client
+	endpoint    string
+	accessToken string
+}
+
+func NewGitHubAPI(conf config.GitHubConfig) (GitHubAPI, error) {
+	return &gitHubAPI{
+		client:      &http.Client{Timeout: 30 * time.Second},
+		endpoint:    conf.APIEndpoint,
+		accessToken: conf.AccessToken,
+	}, nil
+}
+
+type gitHubRepoResponse struct {
+	Description     *string   `json:"description"`
+	StargazersCount int       `json:"stargazers_count"`
+	PushedAt        time.Time `json:"pushed_at"`
+	License         *struct {
+		SpdxID string `json:"spdx_id"`
+	} `json:"license"`
+}
+
+func (a *gitHubAPI) GetRepository(owner string, name string) (*GitHubRepositoryResponse, error) {
+	path := fmt.Sprintf("/repos/%s/%s", url.PathEscape(owner), url.PathEscape(name))
+
+	var repo gitHubRepoResponse
+	if err := a.get(path, &repo); err != nil {
+		return nil, err
+	}
+
+	// 言語ごとのコード量(バイト数)
+	var languages map[string]int
+	if err := a.get(path+"/languages", &languages); err != nil {
+		return nil, err
+	}
+
+	res := &GitHubRepositoryResponse{
+		Stars:     repo.StargazersCount,
+		PushedAt:  repo.PushedAt,
+		Languages: sortLanguages(languages),
+	}
+	if repo.Description != nil {
+		res.Description = *repo.Description
+	}
+	// ライセンスを判別できない場合は"NOASSERTION"になる
+	if repo.License != nil && repo.License.SpdxID != "NOASSERTION" {
+		res.License = repo.License.SpdxID
+	}
+
+	return res, nil
+}
+
+func (a *gitHubAPI) get(path string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, a.endpoint+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if a.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+a.accessToken)
+	}
+
+	res, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return repository.ErrNotFound
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s failed: %d", path, res.StatusCode)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("decode failed: %w", err)
+	}
+
+	return nil
+}
+
+// sortLanguages コード量の多い順に主な言語を並べる
+func sortLanguages(languages map[string]int) []string {
+	res := make([]string, 0, len(languages))
+	for l := range languages {
+		res = append(res, l)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if languages[res[i]] != languages[res[j]] {
+			return languages[res[i]] > languages[res[j]]
+		}
+		return res[i] < res[j]
+	})
+
+	if len(res) > domain.GitHubRepositoryLanguagesLimit {
+		res = res[:domain.GitHubRepositoryLanguagesLimit]
+	}
+
+	return res
+}
+
+// Interface guards
+var (
+	_ GitHubAPI = (*gitHubAPI)(nil)
+)
@@ -0,0 +1,102 @@
+package external
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/config"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+func newGitHubTestServer(t *testing.T, accessToken string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/traPtitech/traPortfolio", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer "+accessToken, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{
+			"description": "traP portfolio",
+			"stargazers_count": 12,
+			"pushed_at": "2024-04-01T12:00:00Z",
+			"license": {"key": "mit", "spdx_id": "MIT"}
+		}`)
+	})
+	mux.HandleFunc("GET /repos/traPtitech/traPortfolio/languages", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"Go": 30000, "Shell": 100, "Dockerfile": 100, "Makefile": 50, "HTML": 10, "CSS": 5, "TypeScript": 20000}`)
+	})
+	mux.HandleFunc("GET /repos/traPtitech/unknown", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"description": null, "stargazers_count": 0, "pushed_at": "2024-04-01T12:00:00Z", "license": {"spdx_id": "NOASSERTION"}}`)
+	})
+	mux.HandleFunc("GET /repos/traPtitech/unknown/languages", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+	mux.HandleFunc("GET /repos/traPtitech/broken", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func TestGitHubAPI_GetRepository(t *testing.T) {
+	t.Parallel()
+
+	const accessToken = "token"
+	s := newGitHubTestServer(t, accessToken)
+	api, err := NewGitHubAPI(config.GitHubConfig{APIEndpoint: s.URL, AccessToken: accessToken})
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		name      string
+		want      *GitHubRepositoryResponse
+		assertion assert.ErrorAssertionFunc
+	}{
+		"success": {
+			"traPortfolio",
+			&GitHubRepositoryResponse{
+				Description: "traP portfolio",
+				Languages:   []string{"Go", "TypeScript", "Dockerfile", "Shell", "Makefile"},
+				Stars:       12,
+				License:     "MIT",
+				PushedAt:    time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC),
+			},
+			assert.NoError,
+		},
+		"no description and unknown license": {
+			"unknown",
+			&GitHubRepositoryResponse{
+				Languages: []string{},
+				PushedAt:  time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC),
+			},
+			assert.NoError,
+		},
+		"not found": {
+			"nothing",
+			nil,
+			func(tt assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorIs(tt, err, repository.ErrNotFound)
+			},
+		},
+		"rate limited": {
+			"broken",
+			nil,
+			assert.Error,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := api.GetRepository("traPtitech", tt.name)
+			tt.assertion(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package mock_external_e2e //nolint:revive
+
+import (
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/external"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/mockdata"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+type MockCompetitiveStatsAPI struct{}
+
+func NewMockCompetitiveStatsAPI() *MockCompetitiveStatsAPI {
+	return &MockCompetitiveStatsAPI{}
+}
+
+func (m *MockCompetitiveStatsAPI) SupportedAccountTypes() []domain.AccountType {
+	return []domain.AccountType{domain.ATCODER}
+}
+
+// GetStats AtCoderのアカウントであればハンドルによらず同じ成績を返す
+func (m *MockCompetitiveStatsAPI) GetStats(accountType domain.AccountType, _ string) (*external.CompetitiveStatsResponse, error) {
+	if accountType != domain.ATCODER {
+		return nil, repository.ErrNotFound
+	}
+
+	return mockdata.MockCompetitiveStats, nil
+}
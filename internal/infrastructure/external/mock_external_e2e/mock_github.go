@@ -0,0 +1,17 @@
+package mock_external_e2e //nolint:revive
+
+import (
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/external"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/mockdata"
+)
+
+type MockGitHubAPI struct{}
+
+func NewMockGitHubAPI() *MockGitHubAPI {
+	return &MockGitHubAPI{}
+}
+
+// GetRepository リポジトリによらず同じ情報を返す
+func (m *MockGitHubAPI) GetRepository(_ string, _ string) (*external.GitHubRepositoryResponse, error) {
+	return mockdata.MockGitHubRepository, nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/patrickmn/go-cache"
+	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/config"
 )
 
@@ -94,7 +95,13 @@ var (
 	_ PortalAPI = (*portalAPI)(nil)
 )
 
-func GetRealNameMap(p PortalAPI) (map[string]string, error) {
+// RealNameIn 言語に応じた本名を返す
+// 英語の場合はAlphabeticNameを返すが、設定されていなければ日本語の本名を返す
+func (u *PortalUserResponse) RealNameIn(lang domain.Lang) string {
+	return lang.Pick(u.RealName, u.AlphabeticName)
+}
+
+func GetRealNameMap(p PortalAPI, lang domain.Lang) (map[string]string, error) {
 	users, err := p.GetUsers()
 	if err != nil {
 		return nil, err
@@ -102,7 +109,7 @@ func GetRealNameMap(p PortalAPI) (map[string]string, error) {
 
 	realNameMap := make(map[string]string, len(users))
 	for _, u := range users {
-		realNameMap[u.TraQID] = u.RealName
+		realNameMap[u.TraQID] = u.RealNameIn(lang)
 	}
 
 	return realNameMap, nil
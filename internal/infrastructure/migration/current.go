@@ -9,8 +9,19 @@ import (
 func Migrations() []*gormigrate.Migration {
 	return []*gormigrate.Migration{
 		v1(),
-		v2(), // プロジェクト名とコンテスト名の重複禁止と文字数制限増加(32->128)
-		v3(), // ユーザーアカウントのprPermitted属性廃止
+		v2(),  // プロジェクト名とコンテスト名の重複禁止と文字数制限増加(32->128)
+		v3(),  // ユーザーアカウントのprPermitted属性廃止
+		v4(),  // プロジェクト、コンテスト、コンテストチームの公開範囲追加
+		v5(),  // プロジェクト、コンテスト、コンテストチームのMarkdownの本文追加
+		v6(),  // 名前、説明、自己紹介の英語版追加
+		v7(),  // プロフィールに固定表示するプロジェクトやコンテストの追加
+		v8(),  // プロジェクト、コンテストの下書きと公開予約追加
+		v9(),  // プロジェクト、コンテスト、コンテストチームの編集履歴追加
+		v10(), // アカウントのハンドル追加とURLの正規化、同じ種類のアカウントの重複禁止
+		v11(), // 外部サービスから取得したアカウントの成績追加
+		v12(), // プロジェクトのリンク先のGitHubリポジトリの情報追加
+		v13(), // ユーザー、プロジェクト、コンテスト、コンテストチームの版番号追加
+		v14(), // コンテストチームの結果の更新日時追加
 	}
 }
 
@@ -18,8 +29,11 @@ func AllTables() []interface{} {
 	return []interface{}{
 		model.User{},
 		model.Account{},
+		model.AccountStats{},
+		model.UserFeaturedItem{},
 		model.Project{},
 		model.ProjectMember{},
+		model.ProjectGitHubRepository{},
 		model.EventLevelRelation{},
 		model.Contest{},
 		model.ContestTeam{},
@@ -27,5 +41,6 @@ func AllTables() []interface{} {
 		model.Group{},
 		model.GroupUserBelonging{},
 		model.GroupUserAdmin{},
+		model.Revision{},
 	}
 }
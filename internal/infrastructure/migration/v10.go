@@ -0,0 +1,199 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// v10 アカウントのハンドル追加とURLの正規化、同じ種類のアカウントの重複禁止
+func v10() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "10",
+		Migrate: func(db *gorm.DB) error {
+			// 同じユーザーが同じ種類のアカウントを複数持っている場合は、どれを残すか運用者が手動で解消する必要がある
+			{
+				accounts := make([]*v10Account, 0)
+				if err := db.
+					Order("user_id, type, id").
+					Find(&accounts).
+					Error; err != nil {
+					return err
+				}
+
+				conflicts := make([]string, 0)
+				for i, a := range accounts {
+					dupPrev := i > 0 && accounts[i-1].UserID == a.UserID && accounts[i-1].Type == a.Type
+					dupNext := i+1 < len(accounts) && accounts[i+1].UserID == a.UserID && accounts[i+1].Type == a.Type
+					if dupPrev || dupNext {
+						conflicts = append(conflicts, fmt.Sprintf("%s (user %s, type %d, %s)", a.ID, a.UserID, a.Type, a.URL))
+					}
+				}
+
+				if len(conflicts) > 0 {
+					return fmt.Errorf("duplicate accounts must be removed before migration 10: %s", strings.Join(conflicts, ", "))
+				}
+			}
+
+			if err := db.AutoMigrate(&v10Account{}); err != nil {
+				return err
+			}
+
+			// 既存のアカウントのURLを正規化する
+			// 正規化できなかったアカウントはそのまま残し、ログに出力する
+			{
+				accounts := make([]*v10Account, 0)
+				if err := db.Find(&accounts).Error; err != nil {
+					return err
+				}
+
+				failed := make([]string, 0)
+				for _, a := range accounts {
+					handle, url, ok := v10NormalizeAccountURL(a.Type, a.URL)
+					if !ok {
+						failed = append(failed, fmt.Sprintf("%s (%s)", a.ID, a.URL))
+						continue
+					}
+					if handle == a.Handle && url == a.URL {
+						continue
+					}
+
+					if err := db.
+						Model(&v10Account{}).
+						Where(&v10Account{ID: a.ID}).
+						Updates(map[string]interface{}{
+							"handle": handle,
+							"url":    url,
+						}).
+						Error; err != nil {
+						return err
+					}
+				}
+
+				if len(failed) > 0 {
+					log.Printf("migration 10: failed to normalize %d account urls: %s", len(failed), strings.Join(failed, ", "))
+				}
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v10Account struct {
+	ID        uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
+	Type      uint8     `gorm:"type:tinyint(1);not null;uniqueIndex:idx_accounts_user_id_type,priority:2"` // 変更
+	Name      string    `gorm:"type:varchar(256)"`
+	Handle    string    `gorm:"type:varchar(256);not null;default:''"` // 追加
+	URL       string    `gorm:"type:text"`
+	UserID    uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_accounts_user_id_type,priority:1"` // 変更
+	CreatedAt time.Time `gorm:"precision:6"`
+	UpdatedAt time.Time `gorm:"precision:6"`
+}
+
+func (*v10Account) TableName() string {
+	return "accounts"
+}
+
+// v10AccountType 移行時点の組み込みの外部アカウントの種類の定義
+// 正規化の規則や設定で追加する種類が変わっても移行の結果が変わらないよう、domainの定義を使わずに固定する
+type v10AccountType struct {
+	urlPattern    *regexp.Regexp
+	handlePattern *regexp.Regexp // nilの場合はハンドルを持たない
+	urlTemplate   string
+}
+
+var v10AccountTypes = map[uint8]v10AccountType{
+	0:  {regexp.MustCompile(`^https?://.+$`), nil, ""},
+	1:  {regexp.MustCompile(`^https?://.+$`), nil, ""},
+	2:  {regexp.MustCompile(`^https://(twitter|x)\.com/[a-zA-Z0-9_]+$`), regexp.MustCompile(`^https?://(?:www\.)?(?:twitter|x)\.com/@?([a-zA-Z0-9_]+)$`), "https://twitter.com/{handle}"},
+	3:  {regexp.MustCompile(`^https://www\.facebook\.com/[a-zA-Z0-9.]+$`), regexp.MustCompile(`^https?://(?:www\.)?facebook\.com/([a-zA-Z0-9.]+)$`), "https://www.facebook.com/{handle}"},
+	4:  {regexp.MustCompile(`^https://www\.pixiv\.net/users/[0-9]+`), regexp.MustCompile(`^https?://(?:www\.)?pixiv\.net/(?:[a-z]{2}/)?users/([0-9]+)$`), "https://www.pixiv.net/users/{handle}"},
+	5:  {regexp.MustCompile(`^https://github\.com/[a-zA-Z0-9-]+$`), regexp.MustCompile(`^https?://(?:www\.)?github\.com/@?([a-zA-Z0-9-]+)$`), "https://github.com/{handle}"},
+	6:  {regexp.MustCompile(`^https://qiita\.com/[a-zA-Z0-9-_]+$`), regexp.MustCompile(`^https?://(?:www\.)?qiita\.com/@?([a-zA-Z0-9-_]+)$`), "https://qiita.com/{handle}"},
+	7:  {regexp.MustCompile(`^https://zenn\.dev/[a-z0-9_]+$`), regexp.MustCompile(`^https?://(?:www\.)?zenn\.dev/@?([a-z0-9_]+)$`), "https://zenn.dev/{handle}"},
+	8:  {regexp.MustCompile(`^https://atcoder\.jp/users/[a-zA-Z0-9_]+$`), regexp.MustCompile(`^https?://(?:www\.)?atcoder\.jp/users/@?([a-zA-Z0-9_]+)$`), "https://atcoder.jp/users/{handle}"},
+	9:  {regexp.MustCompile(`^https://soundcloud\.com/[a-z0-9-_]+$`), regexp.MustCompile(`^https?://(?:www\.|m\.)?soundcloud\.com/@?([a-z0-9-_]+)$`), "https://soundcloud.com/{handle}"},
+	10: {regexp.MustCompile(`^https://app\.hackthebox\.com/users/[a-zA-Z0-9]+$`), regexp.MustCompile(`^https?://app\.hackthebox\.com/users/([a-zA-Z0-9]+)$`), "https://app.hackthebox.com/users/{handle}"},
+	11: {regexp.MustCompile(`^https://ctftime\.org/user/[0-9]+$`), regexp.MustCompile(`^https?://(?:www\.)?ctftime\.org/user/([0-9]+)$`), "https://ctftime.org/user/{handle}"},
+	12: {regexp.MustCompile(`^https://bsky\.app/profile/[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9](\.[a-zA-Z0-9]+)+$`), regexp.MustCompile(`^https?://bsky\.app/profile/@?([a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9](?:\.[a-zA-Z0-9]+)+)$`), "https://bsky.app/profile/{handle}"},
+	13: {regexp.MustCompile(`^https://mixi\.social/@[a-zA-Z][a-zA-Z0-9_]{3,15}$`), regexp.MustCompile(`^https?://mixi\.social/@?([a-zA-Z][a-zA-Z0-9_]{3,15})$`), "https://mixi.social/@{handle}"},
+}
+
+func (t v10AccountType) handle(u string) (string, bool) {
+	m := t.handlePattern.FindStringSubmatch(u)
+	if len(m) < 2 || m[1] == "" {
+		return "", false
+	}
+
+	return m[1], true
+}
+
+// v10NormalizeAccountURL 移行時点のdomain.NormalizeAccountURLと同じ規則でURLを正規化する
+// 組み込みでない種類のアカウントは正規化できない
+func v10NormalizeAccountURL(accountType uint8, input string) (handle string, canonicalURL string, ok bool) {
+	t, ok := v10AccountTypes[accountType]
+	if !ok {
+		return "", "", false
+	}
+
+	input = strings.TrimSpace(input)
+	if t.handlePattern == nil {
+		u, ok := v10NormalizeURL(input)
+		if !ok || !t.urlPattern.MatchString(u.String()) {
+			return "", "", false
+		}
+
+		return "", u.String(), true
+	}
+
+	if !strings.Contains(input, "/") {
+		handle = strings.TrimPrefix(input, "@")
+	} else {
+		u, ok := v10NormalizeURL(input)
+		if !ok {
+			return "", "", false
+		}
+		u.RawQuery = ""
+		u.Fragment = ""
+
+		if handle, ok = t.handle(u.String()); !ok {
+			return "", "", false
+		}
+	}
+
+	canonicalURL = strings.ReplaceAll(t.urlTemplate, "{handle}", handle)
+	if h, ok := t.handle(canonicalURL); !ok || h != handle || !t.urlPattern.MatchString(canonicalURL) {
+		return "", "", false
+	}
+
+	return handle, canonicalURL, true
+}
+
+func v10NormalizeURL(input string) (*url.URL, bool) {
+	if !strings.Contains(input, "://") {
+		input = "https://" + input
+	}
+
+	u, err := url.Parse(input)
+	if err != nil || u.Host == "" {
+		return nil, false
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+
+	return u, true
+}
@@ -0,0 +1,41 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// v11 外部サービスから取得したアカウントの成績追加
+func v11() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "11",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v11AccountStats{}); err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v11AccountStats struct {
+	AccountID     uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
+	FetchedAt     time.Time `gorm:"precision:6;not null;primaryKey"`
+	Rating        int       `gorm:"type:int;not null"`
+	MaxRating     int       `gorm:"type:int;not null"`
+	Color         string    `gorm:"type:varchar(32);not null"`
+	RatedContests int       `gorm:"type:int unsigned;not null"`
+
+	Account v10Account `gorm:"foreignKey:AccountID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (*v11AccountStats) TableName() string {
+	return "account_stats"
+}
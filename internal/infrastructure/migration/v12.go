@@ -0,0 +1,44 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// v12 プロジェクトのリンク先のGitHubリポジトリの情報追加
+func v12() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "12",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v12ProjectGitHubRepository{}); err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v12ProjectGitHubRepository struct {
+	ProjectID   uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
+	Owner       string    `gorm:"type:varchar(64);not null"`
+	Name        string    `gorm:"type:varchar(128);not null"`
+	Description string    `gorm:"type:text;not null"`
+	Languages   []string  `gorm:"type:text;not null;serializer:json"`
+	Stars       int       `gorm:"type:int unsigned;not null"`
+	License     string    `gorm:"type:varchar(64);not null"`
+	PushedAt    time.Time `gorm:"precision:6"`
+	FetchedAt   time.Time `gorm:"precision:6"`
+
+	Project v8Project `gorm:"foreignKey:ProjectID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (*v12ProjectGitHubRepository) TableName() string {
+	return "project_github_repositories"
+}
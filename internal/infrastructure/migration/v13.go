@@ -0,0 +1,110 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"gorm.io/gorm"
+)
+
+// v13 ユーザー、プロジェクト、コンテスト、コンテストチームの版番号追加
+func v13() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "13",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v13User{}, &v13Project{}, &v13Contest{}, &v13ContestTeam{}); err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v13User struct {
+	ID            uuid.UUID        `gorm:"type:char(36);not null;primaryKey"`
+	Description   string           `gorm:"type:text;not null"`
+	DescriptionEn string           `gorm:"type:text;not null;default:''"`
+	Check         bool             `gorm:"type:boolean;not null;default:false"`
+	Name          string           `gorm:"type:varchar(32);not null;unique"`
+	State         domain.TraQState `gorm:"type:tinyint(1);not null"`
+	Version       int              `gorm:"type:int unsigned;not null;default:0"` // 追加
+	CreatedAt     time.Time        `gorm:"precision:6"`
+	UpdatedAt     time.Time        `gorm:"precision:6"`
+}
+
+func (*v13User) TableName() string {
+	return "users"
+}
+
+type v13Project struct {
+	ID            uuid.UUID              `gorm:"type:char(36);not null;primaryKey"`
+	Name          string                 `gorm:"type:varchar(128)"`
+	NameEn        string                 `gorm:"type:varchar(128);not null;default:''"`
+	Description   string                 `gorm:"type:text"`
+	DescriptionEn string                 `gorm:"type:text;not null;default:''"`
+	Body          string                 `gorm:"type:text;not null;default:''"`
+	Link          string                 `gorm:"type:text"`
+	SinceYear     int                    `gorm:"type:smallint(4);not null"`
+	SinceSemester int                    `gorm:"type:tinyint(1);not null"`
+	UntilYear     int                    `gorm:"type:smallint(4);not null"`
+	UntilSemester int                    `gorm:"type:tinyint(1);not null"`
+	Visibility    domain.Visibility      `gorm:"type:tinyint unsigned;not null;default:0"`
+	Draft         bool                   `gorm:"type:boolean;not null;default:false"`
+	PublishAt     optional.Of[time.Time] `gorm:"type:datetime(6)"`
+	Version       int                    `gorm:"type:int unsigned;not null;default:0"` // 追加
+	CreatedAt     time.Time              `gorm:"precision:6"`
+	UpdatedAt     time.Time              `gorm:"precision:6"`
+}
+
+func (*v13Project) TableName() string {
+	return "projects"
+}
+
+type v13Contest struct {
+	ID            uuid.UUID              `gorm:"type:char(36);not null;primaryKey"`
+	Name          string                 `gorm:"type:varchar(128)"`
+	NameEn        string                 `gorm:"type:varchar(128);not null;default:''"`
+	Description   string                 `gorm:"type:text"`
+	DescriptionEn string                 `gorm:"type:text;not null;default:''"`
+	Body          string                 `gorm:"type:text;not null;default:''"`
+	Link          string                 `gorm:"type:text"`
+	Since         time.Time              `gorm:"precision:6"`
+	Until         time.Time              `gorm:"precision:6"`
+	Visibility    domain.Visibility      `gorm:"type:tinyint unsigned;not null;default:0"`
+	Draft         bool                   `gorm:"type:boolean;not null;default:false"`
+	PublishAt     optional.Of[time.Time] `gorm:"type:datetime(6)"`
+	Version       int                    `gorm:"type:int unsigned;not null;default:0"` // 追加
+	CreatedAt     time.Time              `gorm:"precision:6"`
+	UpdatedAt     time.Time              `gorm:"precision:6"`
+}
+
+func (*v13Contest) TableName() string {
+	return "contests"
+}
+
+type v13ContestTeam struct {
+	ID            uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	ContestID     uuid.UUID         `gorm:"type:char(36);not null"`
+	Name          string            `gorm:"type:varchar(128)"`
+	NameEn        string            `gorm:"type:varchar(128);not null;default:''"`
+	Description   string            `gorm:"type:text"`
+	DescriptionEn string            `gorm:"type:text;not null;default:''"`
+	Body          string            `gorm:"type:text;not null;default:''"`
+	Result        string            `gorm:"type:text"`
+	Link          string            `gorm:"type:text"`
+	Visibility    domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"`
+	Version       int               `gorm:"type:int unsigned;not null;default:0"` // 追加
+	CreatedAt     time.Time         `gorm:"precision:6"`
+	UpdatedAt     time.Time         `gorm:"precision:6"`
+}
+
+func (*v13ContestTeam) TableName() string {
+	return "contest_teams"
+}
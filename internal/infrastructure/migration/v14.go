@@ -0,0 +1,58 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"gorm.io/gorm"
+)
+
+// v14 コンテストチームの結果の更新日時追加
+func v14() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "14",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v14ContestTeam{}); err != nil {
+				return err
+			}
+
+			// 既に結果が登録されているチームは、最後に編集された日時を結果の更新日時とする
+			if err := db.
+				Model(&v14ContestTeam{}).
+				Where("`result` <> ''").
+				UpdateColumn("result_updated_at", gorm.Expr("`updated_at`")).
+				Error; err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v14ContestTeam struct {
+	ID              uuid.UUID              `gorm:"type:char(36);not null;primaryKey"`
+	ContestID       uuid.UUID              `gorm:"type:char(36);not null"`
+	Name            string                 `gorm:"type:varchar(128)"`
+	NameEn          string                 `gorm:"type:varchar(128);not null;default:''"`
+	Description     string                 `gorm:"type:text"`
+	DescriptionEn   string                 `gorm:"type:text;not null;default:''"`
+	Body            string                 `gorm:"type:text;not null;default:''"`
+	Result          string                 `gorm:"type:text"`
+	ResultUpdatedAt optional.Of[time.Time] `gorm:"type:datetime(6)"` // 追加
+	Link            string                 `gorm:"type:text"`
+	Visibility      domain.Visibility      `gorm:"type:tinyint unsigned;not null;default:0"`
+	Version         int                    `gorm:"type:int unsigned;not null;default:0"`
+	CreatedAt       time.Time              `gorm:"precision:6"`
+	UpdatedAt       time.Time              `gorm:"precision:6"`
+}
+
+func (*v14ContestTeam) TableName() string {
+	return "contest_teams"
+}
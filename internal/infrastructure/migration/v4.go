@@ -0,0 +1,77 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"gorm.io/gorm"
+)
+
+// v4 プロジェクト、コンテスト、コンテストチームの公開範囲追加
+func v4() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "4",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v4Project{}, &v4Contest{}, &v4ContestTeam{}); err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v4Project struct {
+	ID            uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	Name          string            `gorm:"type:varchar(128)"`
+	Description   string            `gorm:"type:text"`
+	Link          string            `gorm:"type:text"`
+	SinceYear     int               `gorm:"type:smallint(4);not null"`
+	SinceSemester int               `gorm:"type:tinyint(1);not null"`
+	UntilYear     int               `gorm:"type:smallint(4);not null"`
+	UntilSemester int               `gorm:"type:tinyint(1);not null"`
+	Visibility    domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"` // 追加
+	CreatedAt     time.Time         `gorm:"precision:6"`
+	UpdatedAt     time.Time         `gorm:"precision:6"`
+}
+
+func (*v4Project) TableName() string {
+	return "projects"
+}
+
+type v4Contest struct {
+	ID          uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	Name        string            `gorm:"type:varchar(128)"`
+	Description string            `gorm:"type:text"`
+	Link        string            `gorm:"type:text"`
+	Since       time.Time         `gorm:"precision:6"`
+	Until       time.Time         `gorm:"precision:6"`
+	Visibility  domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"` // 追加
+	CreatedAt   time.Time         `gorm:"precision:6"`
+	UpdatedAt   time.Time         `gorm:"precision:6"`
+}
+
+func (*v4Contest) TableName() string {
+	return "contests"
+}
+
+type v4ContestTeam struct {
+	ID          uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	ContestID   uuid.UUID         `gorm:"type:char(36);not null"`
+	Name        string            `gorm:"type:varchar(128)"`
+	Description string            `gorm:"type:text"`
+	Result      string            `gorm:"type:text"`
+	Link        string            `gorm:"type:text"`
+	Visibility  domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"` // 追加
+	CreatedAt   time.Time         `gorm:"precision:6"`
+	UpdatedAt   time.Time         `gorm:"precision:6"`
+}
+
+func (*v4ContestTeam) TableName() string {
+	return "contest_teams"
+}
@@ -0,0 +1,80 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"gorm.io/gorm"
+)
+
+// v5 プロジェクト、コンテスト、コンテストチームのMarkdownの本文追加
+func v5() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "5",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v5Project{}, &v5Contest{}, &v5ContestTeam{}); err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v5Project struct {
+	ID            uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	Name          string            `gorm:"type:varchar(128)"`
+	Description   string            `gorm:"type:text"`
+	Body          string            `gorm:"type:text;not null;default:''"` // 追加
+	Link          string            `gorm:"type:text"`
+	SinceYear     int               `gorm:"type:smallint(4);not null"`
+	SinceSemester int               `gorm:"type:tinyint(1);not null"`
+	UntilYear     int               `gorm:"type:smallint(4);not null"`
+	UntilSemester int               `gorm:"type:tinyint(1);not null"`
+	Visibility    domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"`
+	CreatedAt     time.Time         `gorm:"precision:6"`
+	UpdatedAt     time.Time         `gorm:"precision:6"`
+}
+
+func (*v5Project) TableName() string {
+	return "projects"
+}
+
+type v5Contest struct {
+	ID          uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	Name        string            `gorm:"type:varchar(128)"`
+	Description string            `gorm:"type:text"`
+	Body        string            `gorm:"type:text;not null;default:''"` // 追加
+	Link        string            `gorm:"type:text"`
+	Since       time.Time         `gorm:"precision:6"`
+	Until       time.Time         `gorm:"precision:6"`
+	Visibility  domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"`
+	CreatedAt   time.Time         `gorm:"precision:6"`
+	UpdatedAt   time.Time         `gorm:"precision:6"`
+}
+
+func (*v5Contest) TableName() string {
+	return "contests"
+}
+
+type v5ContestTeam struct {
+	ID          uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	ContestID   uuid.UUID         `gorm:"type:char(36);not null"`
+	Name        string            `gorm:"type:varchar(128)"`
+	Description string            `gorm:"type:text"`
+	Body        string            `gorm:"type:text;not null;default:''"` // 追加
+	Result      string            `gorm:"type:text"`
+	Link        string            `gorm:"type:text"`
+	Visibility  domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"`
+	CreatedAt   time.Time         `gorm:"precision:6"`
+	UpdatedAt   time.Time         `gorm:"precision:6"`
+}
+
+func (*v5ContestTeam) TableName() string {
+	return "contest_teams"
+}
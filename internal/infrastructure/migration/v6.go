@@ -0,0 +1,116 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"gorm.io/gorm"
+)
+
+// v6 プロジェクト、コンテスト、コンテストチーム、班の名前と説明、ユーザーの自己紹介の英語版追加
+func v6() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "6",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v6Project{}, &v6Contest{}, &v6ContestTeam{}, &v6Group{}, &v6User{}); err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v6Project struct {
+	ID            uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	Name          string            `gorm:"type:varchar(128)"`
+	NameEn        string            `gorm:"type:varchar(128);not null;default:''"` // 追加
+	Description   string            `gorm:"type:text"`
+	DescriptionEn string            `gorm:"type:text;not null;default:''"` // 追加
+	Body          string            `gorm:"type:text;not null;default:''"`
+	Link          string            `gorm:"type:text"`
+	SinceYear     int               `gorm:"type:smallint(4);not null"`
+	SinceSemester int               `gorm:"type:tinyint(1);not null"`
+	UntilYear     int               `gorm:"type:smallint(4);not null"`
+	UntilSemester int               `gorm:"type:tinyint(1);not null"`
+	Visibility    domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"`
+	CreatedAt     time.Time         `gorm:"precision:6"`
+	UpdatedAt     time.Time         `gorm:"precision:6"`
+}
+
+func (*v6Project) TableName() string {
+	return "projects"
+}
+
+type v6Contest struct {
+	ID            uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	Name          string            `gorm:"type:varchar(128)"`
+	NameEn        string            `gorm:"type:varchar(128);not null;default:''"` // 追加
+	Description   string            `gorm:"type:text"`
+	DescriptionEn string            `gorm:"type:text;not null;default:''"` // 追加
+	Body          string            `gorm:"type:text;not null;default:''"`
+	Link          string            `gorm:"type:text"`
+	Since         time.Time         `gorm:"precision:6"`
+	Until         time.Time         `gorm:"precision:6"`
+	Visibility    domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"`
+	CreatedAt     time.Time         `gorm:"precision:6"`
+	UpdatedAt     time.Time         `gorm:"precision:6"`
+}
+
+func (*v6Contest) TableName() string {
+	return "contests"
+}
+
+type v6ContestTeam struct {
+	ID            uuid.UUID         `gorm:"type:char(36);not null;primaryKey"`
+	ContestID     uuid.UUID         `gorm:"type:char(36);not null"`
+	Name          string            `gorm:"type:varchar(128)"`
+	NameEn        string            `gorm:"type:varchar(128);not null;default:''"` // 追加
+	Description   string            `gorm:"type:text"`
+	DescriptionEn string            `gorm:"type:text;not null;default:''"` // 追加
+	Body          string            `gorm:"type:text;not null;default:''"`
+	Result        string            `gorm:"type:text"`
+	Link          string            `gorm:"type:text"`
+	Visibility    domain.Visibility `gorm:"type:tinyint unsigned;not null;default:0"`
+	CreatedAt     time.Time         `gorm:"precision:6"`
+	UpdatedAt     time.Time         `gorm:"precision:6"`
+}
+
+func (*v6ContestTeam) TableName() string {
+	return "contest_teams"
+}
+
+type v6Group struct {
+	GroupID       uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
+	Name          string    `gorm:"type:varchar(32)"`
+	NameEn        string    `gorm:"type:varchar(32);not null;default:''"` // 追加
+	Link          string    `gorm:"type:text"`
+	Description   string    `gorm:"type:text"`
+	DescriptionEn string    `gorm:"type:text;not null;default:''"` // 追加
+	CreatedAt     time.Time `gorm:"precision:6"`
+	UpdatedAt     time.Time `gorm:"precision:6"`
+}
+
+func (*v6Group) TableName() string {
+	return "groups"
+}
+
+type v6User struct {
+	ID            uuid.UUID        `gorm:"type:char(36);not null;primaryKey"`
+	Description   string           `gorm:"type:text;not null"`
+	DescriptionEn string           `gorm:"type:text;not null;default:''"` // 追加
+	Check         bool             `gorm:"type:boolean;not null;default:false"`
+	Name          string           `gorm:"type:varchar(32);not null;unique"`
+	State         domain.TraQState `gorm:"type:tinyint(1);not null"`
+	CreatedAt     time.Time        `gorm:"precision:6"`
+	UpdatedAt     time.Time        `gorm:"precision:6"`
+}
+
+func (*v6User) TableName() string {
+	return "users"
+}
@@ -0,0 +1,42 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"gorm.io/gorm"
+)
+
+// v7 ユーザーのプロフィールに固定表示するプロジェクトやコンテストのテーブル追加
+func v7() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "7",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v7UserFeaturedItem{}); err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v7UserFeaturedItem struct {
+	UserID    uuid.UUID               `gorm:"type:char(36);not null;primaryKey"`
+	Type      domain.FeaturedItemType `gorm:"type:tinyint unsigned;not null;primaryKey"`
+	ItemID    uuid.UUID               `gorm:"type:char(36);not null;primaryKey"`
+	Position  int                     `gorm:"type:tinyint unsigned;not null"`
+	CreatedAt time.Time               `gorm:"precision:6"`
+	UpdatedAt time.Time               `gorm:"precision:6"`
+
+	User v6User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (*v7UserFeaturedItem) TableName() string {
+	return "user_featured_items"
+}
@@ -0,0 +1,72 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"gorm.io/gorm"
+)
+
+// v8 プロジェクト、コンテストの下書きと公開予約追加
+func v8() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "8",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v8Project{}, &v8Contest{}); err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v8Project struct {
+	ID            uuid.UUID              `gorm:"type:char(36);not null;primaryKey"`
+	Name          string                 `gorm:"type:varchar(128)"`
+	NameEn        string                 `gorm:"type:varchar(128);not null;default:''"`
+	Description   string                 `gorm:"type:text"`
+	DescriptionEn string                 `gorm:"type:text;not null;default:''"`
+	Body          string                 `gorm:"type:text;not null;default:''"`
+	Link          string                 `gorm:"type:text"`
+	SinceYear     int                    `gorm:"type:smallint(4);not null"`
+	SinceSemester int                    `gorm:"type:tinyint(1);not null"`
+	UntilYear     int                    `gorm:"type:smallint(4);not null"`
+	UntilSemester int                    `gorm:"type:tinyint(1);not null"`
+	Visibility    domain.Visibility      `gorm:"type:tinyint unsigned;not null;default:0"`
+	Draft         bool                   `gorm:"type:boolean;not null;default:false"` // 追加
+	PublishAt     optional.Of[time.Time] `gorm:"type:datetime(6)"`                    // 追加
+	CreatedAt     time.Time              `gorm:"precision:6"`
+	UpdatedAt     time.Time              `gorm:"precision:6"`
+}
+
+func (*v8Project) TableName() string {
+	return "projects"
+}
+
+type v8Contest struct {
+	ID            uuid.UUID              `gorm:"type:char(36);not null;primaryKey"`
+	Name          string                 `gorm:"type:varchar(128)"`
+	NameEn        string                 `gorm:"type:varchar(128);not null;default:''"`
+	Description   string                 `gorm:"type:text"`
+	DescriptionEn string                 `gorm:"type:text;not null;default:''"`
+	Body          string                 `gorm:"type:text;not null;default:''"`
+	Link          string                 `gorm:"type:text"`
+	Since         time.Time              `gorm:"precision:6"`
+	Until         time.Time              `gorm:"precision:6"`
+	Visibility    domain.Visibility      `gorm:"type:tinyint unsigned;not null;default:0"`
+	Draft         bool                   `gorm:"type:boolean;not null;default:false"` // 追加
+	PublishAt     optional.Of[time.Time] `gorm:"type:datetime(6)"`                    // 追加
+	CreatedAt     time.Time              `gorm:"precision:6"`
+	UpdatedAt     time.Time              `gorm:"precision:6"`
+}
+
+func (*v8Contest) TableName() string {
+	return "contests"
+}
@@ -0,0 +1,39 @@
+// Package migration migrate current struct
+package migration
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"gorm.io/gorm"
+)
+
+// v9 プロジェクト、コンテスト、コンテストチームの編集履歴追加
+func v9() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "9",
+		Migrate: func(db *gorm.DB) error {
+			if err := db.AutoMigrate(&v9Revision{}); err != nil {
+				return err
+			}
+
+			return db.
+				Table("portfolio").
+				Error
+		},
+	}
+}
+
+type v9Revision struct {
+	TargetType domain.RevisionTarget `gorm:"type:tinyint unsigned;not null;primaryKey"`
+	TargetID   uuid.UUID             `gorm:"type:char(36);not null;primaryKey"`
+	Number     int                   `gorm:"type:int unsigned;not null;primaryKey"`
+	Snapshot   string                `gorm:"type:mediumtext;not null"`
+	CreatedAt  time.Time             `gorm:"precision:6"`
+}
+
+func (*v9Revision) TableName() string {
+	return "revisions"
+}
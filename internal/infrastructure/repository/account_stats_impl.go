@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/external"
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/repository/model"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+	"gorm.io/gorm"
+)
+
+type AccountStatsRepository struct {
+	h           *gorm.DB
+	competitive external.CompetitiveStatsAPI
+}
+
+func NewAccountStatsRepository(sql *gorm.DB, competitive external.CompetitiveStatsAPI) *AccountStatsRepository {
+	return &AccountStatsRepository{
+		h:           sql,
+		competitive: competitive,
+	}
+}
+
+func (r *AccountStatsRepository) GetAccountStats(ctx context.Context, userID uuid.UUID, accountID uuid.UUID) (*domain.AccountStats, error) {
+	if err := r.h.
+		WithContext(ctx).
+		Where(&model.Account{ID: accountID, UserID: userID}).
+		First(&model.Account{}).
+		Error; err != nil {
+		return nil, err
+	}
+
+	stats := new(model.AccountStats)
+	if err := r.h.
+		WithContext(ctx).
+		Where(&model.AccountStats{AccountID: accountID}).
+		Order("`account_stats`.`fetched_at` DESC").
+		First(stats).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &domain.AccountStats{
+		AccountID:     stats.AccountID,
+		Rating:        stats.Rating,
+		MaxRating:     stats.MaxRating,
+		Color:         stats.Color,
+		RatedContests: stats.RatedContests,
+		FetchedAt:     stats.FetchedAt,
+	}, nil
+}
+
+func (r *AccountStatsRepository) SyncAccountStats(ctx context.Context) error {
+	accountTypes := r.competitive.SupportedAccountTypes()
+	if len(accountTypes) == 0 {
+		return nil
+	}
+
+	accounts := make([]*model.Account, 0)
+	if err := r.h.
+		WithContext(ctx).
+		Where("`accounts`.`type` IN (?) AND `accounts`.`handle` <> ''", accountTypes).
+		Find(&accounts).
+		Error; err != nil {
+		return err
+	}
+
+	return syncEach(ctx, accounts, func(a *model.Account) (error, error) {
+		res, err := r.competitive.GetStats(domain.AccountType(a.Type), a.Handle)
+		if err != nil {
+			return fmt.Errorf("account %s: %w", a.ID, err), nil
+		}
+
+		return nil, r.h.
+			WithContext(ctx).
+			Create(&model.AccountStats{
+				AccountID:     a.ID,
+				FetchedAt:     time.Now().Truncate(time.Microsecond),
+				Rating:        res.Rating,
+				MaxRating:     res.MaxRating,
+				Color:         res.Color,
+				RatedContests: res.RatedContests,
+			}).
+			Error
+	})
+}
+
+// Interface guards
+var (
+	_ repository.AccountStatsRepository = (*AccountStatsRepository)(nil)
+)
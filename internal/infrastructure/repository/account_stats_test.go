@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/external/mock_external_e2e"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/mockdata"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
+	urepository "github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+func TestAccountStatsRepository_SyncAccountStats(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	err := mockdata.InsertSampleDataToDB(db)
+	assert.NoError(t, err)
+	userRepo := NewUserRepository(db, mock_external_e2e.NewMockPortalAPI(), mock_external_e2e.NewMockTraQAPI())
+	repo := NewAccountStatsRepository(db, mock_external_e2e.NewMockCompetitiveStatsAPI())
+
+	user := mockdata.MockUsers[1]
+	atcoder := mustMakeAccount(t, userRepo, user.ID, &urepository.CreateAccountArgs{
+		DisplayName: random.AlphaNumeric(),
+		Type:        domain.ATCODER,
+		URL:         random.AccountURLString(domain.ATCODER),
+	})
+	github := mustMakeAccount(t, userRepo, user.ID, &urepository.CreateAccountArgs{
+		DisplayName: random.AlphaNumeric(),
+		Type:        domain.GITHUB,
+		URL:         random.AccountURLString(domain.GITHUB),
+	})
+
+	// 取得前は成績がない
+	_, err = repo.GetAccountStats(context.Background(), user.ID, atcoder.ID)
+	assert.ErrorIs(t, err, urepository.ErrNotFound)
+
+	err = repo.SyncAccountStats(context.Background())
+	assert.NoError(t, err)
+
+	got, err := repo.GetAccountStats(context.Background(), user.ID, atcoder.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, atcoder.ID, got.AccountID)
+	assert.Equal(t, mockdata.MockCompetitiveStats.Rating, got.Rating)
+	assert.Equal(t, mockdata.MockCompetitiveStats.MaxRating, got.MaxRating)
+	assert.Equal(t, mockdata.MockCompetitiveStats.Color, got.Color)
+	assert.Equal(t, mockdata.MockCompetitiveStats.RatedContests, got.RatedContests)
+
+	// 成績を取得できない種類のアカウント
+	_, err = repo.GetAccountStats(context.Background(), user.ID, github.ID)
+	assert.ErrorIs(t, err, urepository.ErrNotFound)
+
+	// 他のユーザーのアカウント
+	_, err = repo.GetAccountStats(context.Background(), mockdata.MockUsers[0].ID, atcoder.ID)
+	assert.ErrorIs(t, err, urepository.ErrNotFound)
+
+	// URLを変更すると別のアカウントの成績になるため削除される
+	err = userRepo.UpdateAccount(context.Background(), user.ID, atcoder.ID, &urepository.UpdateAccountArgs{
+		URL: optional.From("https://atcoder.jp/users/another_user"),
+	})
+	assert.NoError(t, err)
+	_, err = repo.GetAccountStats(context.Background(), user.ID, atcoder.ID)
+	assert.ErrorIs(t, err, urepository.ErrNotFound)
+}
@@ -3,11 +3,15 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
 	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/external"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/repository/model"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
 	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
 	"gorm.io/gorm"
 )
@@ -23,20 +27,31 @@ func NewContestRepository(sql *gorm.DB, portal external.PortalAPI) repository.Co
 
 func (r *ContestRepository) GetContests(ctx context.Context, args *repository.GetContestsArgs) ([]*domain.Contest, error) {
 	limit := args.Limit.ValueOr(-1)
+	tx := r.h.WithContext(ctx).Limit(limit)
+	if !repository.IsMember(ctx) {
+		tx = wherePublic(tx, "contests")
+	}
+	if len(args.IDs) > 0 {
+		tx = tx.Where("`contests`.`id` IN ?", args.IDs)
+	}
+
 	contests := make([]*model.Contest, 10)
-	err := r.h.WithContext(ctx).Limit(limit).Find(&contests).Error
+	err := tx.Find(&contests).Error
 	if err != nil {
 		return nil, err
 	}
 
+	lang := repository.LangFrom(ctx)
 	result := make([]*domain.Contest, 0, len(contests))
 
 	for _, v := range contests {
 		result = append(result, &domain.Contest{
-			ID:        v.ID,
-			Name:      v.Name,
-			TimeStart: v.Since,
-			TimeEnd:   v.Until,
+			ID:         v.ID,
+			Name:       lang.Pick(v.Name, v.NameEn),
+			TimeStart:  v.Since,
+			TimeEnd:    v.Until,
+			Visibility: v.Visibility,
+			Publish:    contestPublishState(v),
 		})
 	}
 	return result, nil
@@ -46,8 +61,71 @@ func (r *ContestRepository) GetContest(ctx context.Context, contestID uuid.UUID)
 	return r.getContest(ctx, contestID)
 }
 
+func (r *ContestRepository) GetContestDetails(ctx context.Context, contestIDs []uuid.UUID) ([]*domain.ContestDetail, error) {
+	contests, err := r.getVisibleContests(ctx, contestIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := r.getContestTeams(ctx, contests)
+	if err != nil {
+		return nil, err
+	}
+
+	lang := repository.LangFrom(ctx)
+	res := make([]*domain.ContestDetail, len(contests))
+	for i, v := range contests {
+		res[i] = &domain.ContestDetail{
+			Contest: domain.Contest{
+				ID:         v.ID,
+				Name:       lang.Pick(v.Name, v.NameEn),
+				TimeStart:  v.Since,
+				TimeEnd:    v.Until,
+				Visibility: v.Visibility,
+				Publish:    contestPublishState(v),
+			},
+			Link:         v.Link,
+			Description:  lang.Pick(v.Description, v.DescriptionEn),
+			Body:         v.Body,
+			ContestTeams: teams[v.ID],
+			Version:      v.Version,
+		}
+	}
+
+	return res, nil
+}
+
 // Teamsは別途GetContestTeamsで取得するためここではnilのまま返す
 func (r *ContestRepository) getContest(ctx context.Context, contestID uuid.UUID) (*domain.ContestDetail, error) {
+	contest, err := r.getVisibleContest(ctx, contestID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newContestDetail(repository.LangFrom(ctx), contest), nil
+}
+
+// newContestDetail チーム以外のコンテストの詳細情報を作る
+func newContestDetail(lang domain.Lang, contest *model.Contest) *domain.ContestDetail {
+	return &domain.ContestDetail{
+		Contest: domain.Contest{
+			ID:         contest.ID,
+			Name:       lang.Pick(contest.Name, contest.NameEn),
+			TimeStart:  contest.Since,
+			TimeEnd:    contest.Until,
+			Visibility: contest.Visibility,
+			Publish:    contestPublishState(contest),
+		},
+		Link:        contest.Link,
+		Description: lang.Pick(contest.Description, contest.DescriptionEn),
+		Body:        contest.Body,
+		// Teams:
+		Version: contest.Version,
+	}
+}
+
+// getVisibleContest 閲覧者が閲覧できないコンテストの場合はErrNotFoundを返す
+func (r *ContestRepository) getVisibleContest(ctx context.Context, contestID uuid.UUID) (*model.Contest, error) {
 	contest := new(model.Contest)
 	if err := r.h.
 		WithContext(ctx).
@@ -57,29 +135,48 @@ func (r *ContestRepository) getContest(ctx context.Context, contestID uuid.UUID)
 		return nil, err
 	}
 
-	res := &domain.ContestDetail{
-		Contest: domain.Contest{
-			ID:        contest.ID,
-			Name:      contest.Name,
-			TimeStart: contest.Since,
-			TimeEnd:   contest.Until,
-		},
-		Link:        contest.Link,
-		Description: contest.Description,
-		// Teams:
+	if !contestVisibility(contest).IsVisible(repository.IsMember(ctx)) {
+		return nil, repository.ErrNotFound
 	}
 
-	return res, nil
+	return contest, nil
+}
+
+// getVisibleContests 閲覧者が閲覧できないコンテストは結果から除外する
+func (r *ContestRepository) getVisibleContests(ctx context.Context, contestIDs []uuid.UUID) ([]*model.Contest, error) {
+	if len(contestIDs) == 0 {
+		return []*model.Contest{}, nil
+	}
+
+	contests := make([]*model.Contest, 0, len(contestIDs))
+	if err := r.h.
+		WithContext(ctx).
+		Where("`contests`.`id` IN (?)", contestIDs).
+		Find(&contests).
+		Error; err != nil {
+		return nil, err
+	}
+
+	isMember := repository.IsMember(ctx)
+	return lo.Filter(contests, func(v *model.Contest, _ int) bool {
+		return contestVisibility(v).IsVisible(isMember)
+	}), nil
 }
 
 func (r *ContestRepository) CreateContest(ctx context.Context, args *repository.CreateContestArgs) (*domain.ContestDetail, error) {
 	contest := &model.Contest{
-		ID:          uuid.Must(uuid.NewV4()),
-		Name:        args.Name,
-		Description: args.Description,
-		Link:        args.Link.ValueOrZero(),
-		Since:       args.Since,
-		Until:       args.Until.ValueOrZero(),
+		ID:            uuid.Must(uuid.NewV4()),
+		Name:          args.Name,
+		NameEn:        args.NameEn.ValueOrZero(),
+		Description:   args.Description,
+		DescriptionEn: args.DescriptionEn.ValueOrZero(),
+		Body:          args.Body.ValueOrZero(),
+		Link:          args.Link.ValueOrZero(),
+		Since:         args.Since,
+		Until:         args.Until.ValueOrZero(),
+		Visibility:    args.Visibility.ValueOrZero(),
+		Draft:         args.Draft.ValueOrZero(),
+		PublishAt:     args.PublishAt,
 	}
 
 	// 既に同名のコンテストが存在するか
@@ -94,17 +191,19 @@ func (r *ContestRepository) CreateContest(ctx context.Context, args *repository.
 		return nil, err
 	}
 
-	err = r.h.WithContext(ctx).Create(contest).Error
-	if err != nil {
-		return nil, err
-	}
+	err = r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(contest).Error; err != nil {
+			return err
+		}
 
-	result, err := r.getContest(ctx, contest.ID)
+		return recordContestRevision(tx, contest.ID)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return result, nil
+	// 作成者がメンバーでなくても非公開や下書きのコンテストを返せるよう、閲覧者による絞り込みをせずに作る
+	return newContestDetail(repository.LangFrom(ctx), contest), nil
 }
 
 func (r *ContestRepository) UpdateContest(ctx context.Context, contestID uuid.UUID, args *repository.UpdateContestArgs) error {
@@ -112,9 +211,18 @@ func (r *ContestRepository) UpdateContest(ctx context.Context, contestID uuid.UU
 	if v, ok := args.Name.V(); ok {
 		changes["name"] = v
 	}
+	if v, ok := args.NameEn.V(); ok {
+		changes["name_en"] = v
+	}
 	if v, ok := args.Description.V(); ok {
 		changes["description"] = v
 	}
+	if v, ok := args.DescriptionEn.V(); ok {
+		changes["description_en"] = v
+	}
+	if v, ok := args.Body.V(); ok {
+		changes["body"] = v
+	}
 	if v, ok := args.Link.V(); ok {
 		changes["link"] = v
 	}
@@ -124,6 +232,9 @@ func (r *ContestRepository) UpdateContest(ctx context.Context, contestID uuid.UU
 	if v, ok := args.Until.V(); ok {
 		changes["until"] = v
 	}
+	if v, ok := args.Visibility.V(); ok {
+		changes["visibility"] = v
+	}
 
 	if len(changes) == 0 {
 		return nil
@@ -131,11 +242,12 @@ func (r *ContestRepository) UpdateContest(ctx context.Context, contestID uuid.UU
 
 	var c model.Contest
 	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := tx.
-			WithContext(ctx).
-			Where(&model.Contest{ID: contestID}).
-			First(&model.Contest{}).
-			Error; err != nil {
+		if err := lockVersion(ctx, tx, "contests", contestID); err != nil {
+			return err
+		}
+
+		// 編集履歴導入前のコンテストの場合は編集前の状態も記録する
+		if err := recordContestRevision(tx, contestID); err != nil {
 			return err
 		}
 
@@ -147,6 +259,10 @@ func (r *ContestRepository) UpdateContest(ctx context.Context, contestID uuid.UU
 			return err
 		}
 
+		if err := recordContestRevision(tx, contestID); err != nil {
+			return err
+		}
+
 		if err := tx.
 			WithContext(ctx).
 			Where(&model.Contest{ID: contestID}).
@@ -174,6 +290,16 @@ func (r *ContestRepository) DeleteContest(ctx context.Context, contestID uuid.UU
 			return err
 		}
 
+		teamIDs := make([]uuid.UUID, 0)
+		if err := tx.
+			WithContext(ctx).
+			Model(&model.ContestTeam{}).
+			Where(&model.ContestTeam{ContestID: contestID}).
+			Pluck("id", &teamIDs).
+			Error; err != nil {
+			return err
+		}
+
 		if err := tx.
 			WithContext(ctx).
 			Where(&model.Contest{ID: contestID}).
@@ -182,7 +308,45 @@ func (r *ContestRepository) DeleteContest(ctx context.Context, contestID uuid.UU
 			return err
 		}
 
-		return nil
+		if err := deleteRevisions(tx, domain.RevisionTargetContestTeam, teamIDs...); err != nil {
+			return err
+		}
+
+		return deleteRevisions(tx, domain.RevisionTargetContest, contestID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ContestRepository) PublishContest(ctx context.Context, contestID uuid.UUID, publishAt optional.Of[time.Time]) error {
+	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		contest := new(model.Contest)
+		err := tx.
+			WithContext(ctx).
+			Where(&model.Contest{ID: contestID}).
+			First(contest).
+			Error
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if contestPublishState(contest).IsPublished(now) {
+			return fmt.Errorf("%w: contest has already been published", repository.ErrAlreadyExists)
+		}
+
+		if err := tx.
+			WithContext(ctx).
+			Model(contest).
+			Updates(publishChanges(publishAt, now)).
+			Error; err != nil {
+			return err
+		}
+
+		return bumpVersion(tx, "contests", contestID)
 	})
 	if err != nil {
 		return err
@@ -193,25 +357,58 @@ func (r *ContestRepository) DeleteContest(ctx context.Context, contestID uuid.UU
 
 func (r *ContestRepository) GetContestTeams(ctx context.Context, contestID uuid.UUID) ([]*domain.ContestTeam, error) {
 	//IDがcontestIDであるようなcontestが存在するかチェック
-	if err := r.h.
-		WithContext(ctx).
-		Where(&model.Contest{ID: contestID}).
-		First(&model.Contest{}).
-		Error; err != nil {
+	contest, err := r.getVisibleContest(ctx, contestID)
+	if err != nil {
 		return nil, err
 	}
 
-	//ContestIDがcontestIDであるようなcontestTeamを10件まで列挙する
-	teams := make([]*model.ContestTeam, 10)
-	err := r.h.
+	teams, err := r.getContestTeams(ctx, []*model.Contest{contest})
+	if err != nil {
+		return nil, err
+	}
+
+	return teams[contestID], nil
+}
+
+func (r *ContestRepository) GetContestTeamsByContestIDs(ctx context.Context, contestIDs []uuid.UUID) (map[uuid.UUID][]*domain.ContestTeam, error) {
+	contests, err := r.getVisibleContests(ctx, contestIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.getContestTeams(ctx, contests)
+}
+
+// getContestTeams 閲覧者が閲覧できるコンテストについて、コンテストIDごとのチームとメンバーをまとめて取得する
+func (r *ContestRepository) getContestTeams(ctx context.Context, contests []*model.Contest) (map[uuid.UUID][]*domain.ContestTeam, error) {
+	isMember := repository.IsMember(ctx)
+	result := make(map[uuid.UUID][]*domain.ContestTeam, len(contests))
+	if len(contests) == 0 {
+		return result, nil
+	}
+
+	contestMap := make(map[uuid.UUID]*model.Contest, len(contests))
+	contestIDs := make([]uuid.UUID, len(contests))
+	for i, v := range contests {
+		contestMap[v.ID] = v
+		contestIDs[i] = v.ID
+		result[v.ID] = make([]*domain.ContestTeam, 0)
+	}
+
+	//ContestIDがcontestIDsに含まれるようなcontestTeamを列挙する
+	teams := make([]*model.ContestTeam, 0)
+	tx := r.h.
 		WithContext(ctx).
-		Where(&model.ContestTeam{ContestID: contestID}).
+		Where("`contest_teams`.`contest_id` IN (?)", contestIDs)
+	if !isMember {
+		tx = tx.Where("`contest_teams`.`visibility` <> ?", domain.VisibilityPrivate)
+	}
+	err := tx.
 		Find(&teams).
 		Error
 	if err != nil {
 		return nil, err
 	}
-
 	//teamsの要素vについてTeamIDがv.IDである(TeamIDがteamsIDListに入っているID)ようなContestTeamUserBelongingを列挙する
 	var teamsIDList = make([]uuid.UUID, len(teams))
 	for i, v := range teams {
@@ -234,60 +431,106 @@ func (r *ContestRepository) GetContestTeams(ctx context.Context, contestID uuid.
 		belongingMap[v.TeamID] = append(belongingMap[v.TeamID], v)
 	}
 
-	realNameMap, err := external.GetRealNameMap(r.portal)
+	realNameMap, err := external.GetRealNameMap(r.portal, repository.LangFrom(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	result := make([]*domain.ContestTeam, 0, len(teams))
+	lang := repository.LangFrom(ctx)
 	for _, v := range teams {
-		members := make([]*domain.User, len(belongingMap[v.ID]))
-		for i, w := range belongingMap[v.ID] {
-			u := w.User
-			members[i] = domain.NewUser(u.ID, u.Name, realNameMap[u.Name], u.Check)
+		members := make([]*domain.User, 0, len(belongingMap[v.ID]))
+		if contestVisibility(contestMap[v.ContestID]).Restrict(v.Visibility).ShowsMembers(isMember) {
+			for _, w := range belongingMap[v.ID] {
+				u := w.User
+				members = append(members, domain.NewUser(u.ID, u.Name, realNameMap[u.Name], u.Check))
+			}
 		}
 
-		result = append(result, &domain.ContestTeam{
+		result[v.ContestID] = append(result[v.ContestID], &domain.ContestTeam{
 			ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{
-				ID:        v.ID,
-				ContestID: v.ContestID,
-				Name:      v.Name,
-				Result:    v.Result,
+				ID:         v.ID,
+				ContestID:  v.ContestID,
+				Name:       lang.Pick(v.Name, v.NameEn),
+				Result:     v.Result,
+				Visibility: v.Visibility,
 			},
 			Members: members,
 		})
 	}
 
-	// if len(result) == 0 {
-	// 	result = []*domain.ContestTeam{}
-	// }
-
 	return result, nil
 }
 
 // Membersは別途GetContestTeamMembersで取得するためここではnilのまま返す
 func (r *ContestRepository) GetContestTeam(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID) (*domain.ContestTeamDetail, error) {
-	var team model.ContestTeam
+	team, err := r.getVisibleContestTeam(ctx, contestID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := r.getContestTeamMembers(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+
+	lang := repository.LangFrom(ctx)
+	res := &domain.ContestTeamDetail{
+		ContestTeam: domain.ContestTeam{
+			ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{
+				ID:         team.ID,
+				ContestID:  team.ContestID,
+				Name:       lang.Pick(team.Name, team.NameEn),
+				Result:     team.Result,
+				Visibility: team.Visibility,
+			},
+			Members: members,
+		},
+		Link:        team.Link,
+		Description: lang.Pick(team.Description, team.DescriptionEn),
+		Body:        team.Body,
+		Version:     team.Version,
+	}
+	return res, nil
+}
+
+// getVisibleContestTeam 閲覧者が閲覧できないコンテストチームの場合はErrNotFoundを返す
+// コンテストチームの公開範囲はコンテストの公開範囲で制限される
+func (r *ContestRepository) getVisibleContestTeam(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID) (*model.ContestTeam, error) {
+	team := new(model.ContestTeam)
 	if err := r.h.
 		WithContext(ctx).
+		Preload("Contest").
 		Where(&model.ContestTeam{ID: teamID, ContestID: contestID}).
-		First(&team).
+		First(team).
 		Error; err != nil {
 		return nil, err
 	}
 
+	if !contestVisibility(&team.Contest).Restrict(team.Visibility).IsVisible(repository.IsMember(ctx)) {
+		return nil, repository.ErrNotFound
+	}
+
+	return team, nil
+}
+
+// getContestTeamMembers 閲覧者がメンバーを閲覧できないコンテストチームの場合は空のスライスを返す
+func (r *ContestRepository) getContestTeamMembers(ctx context.Context, team *model.ContestTeam) ([]*domain.User, error) {
+	if !contestVisibility(&team.Contest).Restrict(team.Visibility).ShowsMembers(repository.IsMember(ctx)) {
+		return []*domain.User{}, nil
+	}
+
 	var belongings []*model.ContestTeamUserBelonging
 	err := r.h.
 		WithContext(ctx).
 		Preload("User").
-		Where(&model.ContestTeamUserBelonging{TeamID: teamID}).
+		Where(&model.ContestTeamUserBelonging{TeamID: team.ID}).
 		Find(&belongings).
 		Error
 	if err != nil {
 		return nil, err
 	}
 
-	realNameMap, err := external.GetRealNameMap(r.portal)
+	realNameMap, err := external.GetRealNameMap(r.portal, repository.LangFrom(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -298,20 +541,7 @@ func (r *ContestRepository) GetContestTeam(ctx context.Context, contestID uuid.U
 		members[i] = domain.NewUser(u.ID, u.Name, realNameMap[u.Name], u.Check)
 	}
 
-	res := &domain.ContestTeamDetail{
-		ContestTeam: domain.ContestTeam{
-			ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{
-				ID:        team.ID,
-				ContestID: team.ContestID,
-				Name:      team.Name,
-				Result:    team.Result,
-			},
-			Members: members,
-		},
-		Link:        team.Link,
-		Description: team.Description,
-	}
-	return res, nil
+	return members, nil
 }
 
 func (r *ContestRepository) CreateContestTeam(ctx context.Context, contestID uuid.UUID, _contestTeam *repository.CreateContestTeamArgs) (*domain.ContestTeamDetail, error) {
@@ -324,31 +554,51 @@ func (r *ContestRepository) CreateContestTeam(ctx context.Context, contestID uui
 	}
 
 	contestTeam := &model.ContestTeam{
-		ID:          uuid.Must(uuid.NewV4()),
-		ContestID:   contestID,
-		Name:        _contestTeam.Name,
-		Description: _contestTeam.Description,
-		Result:      _contestTeam.Result.ValueOrZero(),
-		Link:        _contestTeam.Link.ValueOrZero(),
+		ID:            uuid.Must(uuid.NewV4()),
+		ContestID:     contestID,
+		Name:          _contestTeam.Name,
+		NameEn:        _contestTeam.NameEn.ValueOrZero(),
+		Description:   _contestTeam.Description,
+		DescriptionEn: _contestTeam.DescriptionEn.ValueOrZero(),
+		Body:          _contestTeam.Body.ValueOrZero(),
+		Result:        _contestTeam.Result.ValueOrZero(),
+		Link:          _contestTeam.Link.ValueOrZero(),
+		Visibility:    _contestTeam.Visibility.ValueOrZero(),
 	}
+	if contestTeam.Result != "" {
+		contestTeam.ResultUpdatedAt = optional.From(time.Now())
+	}
+
+	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(contestTeam).Error; err != nil {
+			return err
+		}
+
+		if err := bumpVersion(tx, "contests", contestID); err != nil {
+			return err
+		}
 
-	err := r.h.WithContext(ctx).Create(contestTeam).Error
+		return recordContestTeamRevision(tx, contestTeam.ID)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	lang := repository.LangFrom(ctx)
 	result := &domain.ContestTeamDetail{
 		ContestTeam: domain.ContestTeam{
 			ContestTeamWithoutMembers: domain.ContestTeamWithoutMembers{
-				ID:        contestTeam.ID,
-				ContestID: contestTeam.ContestID,
-				Name:      contestTeam.Name,
-				Result:    contestTeam.Result,
+				ID:         contestTeam.ID,
+				ContestID:  contestTeam.ContestID,
+				Name:       lang.Pick(contestTeam.Name, contestTeam.NameEn),
+				Result:     contestTeam.Result,
+				Visibility: contestTeam.Visibility,
 			},
 			Members: make([]*domain.User, 0),
 		},
 		Link:        contestTeam.Link,
-		Description: contestTeam.Description,
+		Description: lang.Pick(contestTeam.Description, contestTeam.DescriptionEn),
+		Body:        contestTeam.Body,
 	}
 	return result, nil
 }
@@ -358,15 +608,27 @@ func (r *ContestRepository) UpdateContestTeam(ctx context.Context, teamID uuid.U
 	if v, ok := args.Name.V(); ok {
 		changes["name"] = v
 	}
+	if v, ok := args.NameEn.V(); ok {
+		changes["name_en"] = v
+	}
 	if v, ok := args.Description.V(); ok {
 		changes["description"] = v
 	}
+	if v, ok := args.DescriptionEn.V(); ok {
+		changes["description_en"] = v
+	}
+	if v, ok := args.Body.V(); ok {
+		changes["body"] = v
+	}
 	if v, ok := args.Link.V(); ok {
 		changes["link"] = v
 	}
 	if v, ok := args.Result.V(); ok {
 		changes["result"] = v
 	}
+	if v, ok := args.Visibility.V(); ok {
+		changes["visibility"] = v
+	}
 
 	if len(changes) == 0 {
 		return nil
@@ -374,11 +636,19 @@ func (r *ContestRepository) UpdateContestTeam(ctx context.Context, teamID uuid.U
 
 	var ct model.ContestTeam
 	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := tx.
-			WithContext(ctx).
-			Where(&model.ContestTeam{ID: teamID}).
-			First(&model.ContestTeam{}).
-			Error; err != nil {
+		if err := lockVersion(ctx, tx, "contest_teams", teamID); err != nil {
+			return err
+		}
+		if err := bumpContestVersionOfTeam(tx, teamID); err != nil {
+			return err
+		}
+
+		// 編集履歴導入前のコンテストチームの場合は編集前の状態も記録する
+		if err := recordContestTeamRevision(tx, teamID); err != nil {
+			return err
+		}
+
+		if err := setResultUpdatedAt(tx, teamID, changes); err != nil {
 			return err
 		}
 
@@ -390,6 +660,10 @@ func (r *ContestRepository) UpdateContestTeam(ctx context.Context, teamID uuid.U
 			return err
 		}
 
+		if err := recordContestTeamRevision(tx, teamID); err != nil {
+			return err
+		}
+
 		if err := tx.
 			WithContext(ctx).
 			Where(&model.ContestTeam{ID: teamID}).
@@ -425,7 +699,11 @@ func (r *ContestRepository) DeleteContestTeam(ctx context.Context, contestID uui
 			return err
 		}
 
-		return nil
+		if err := bumpVersion(tx, "contests", contestID); err != nil {
+			return err
+		}
+
+		return deleteRevisions(tx, domain.RevisionTargetContestTeam, teamID)
 	}); err != nil {
 		return err
 	}
@@ -435,85 +713,55 @@ func (r *ContestRepository) DeleteContestTeam(ctx context.Context, contestID uui
 
 func (r *ContestRepository) GetContestTeamMembers(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID) ([]*domain.User, error) {
 	// 存在チェック
-	err := r.h.
-		WithContext(ctx).
-		Where(&model.Contest{ID: contestID}).
-		First(&model.Contest{}).
-		Error
-	if err != nil {
-		return nil, err
-	}
-	err = r.h.
-		WithContext(ctx).
-		Where(&model.ContestTeam{ID: teamID}).
-		First(&model.ContestTeam{}).
-		Error
-	if err != nil {
-		return nil, err
-	}
-
-	var belongings []*model.ContestTeamUserBelonging
-	err = r.h.
-		WithContext(ctx).
-		Preload("User").
-		Where(&model.ContestTeamUserBelonging{TeamID: teamID}).
-		Find(&belongings).
-		Error
-	if err != nil {
-		return nil, err
-	}
-
-	realNameMap, err := external.GetRealNameMap(r.portal)
+	team, err := r.getVisibleContestTeam(ctx, contestID, teamID)
 	if err != nil {
 		return nil, err
 	}
 
-	result := make([]*domain.User, len(belongings))
-	for i, v := range belongings {
-		u := v.User
-		result[i] = domain.NewUser(u.ID, u.Name, realNameMap[u.Name], u.Check)
-	}
-	return result, nil
+	return r.getContestTeamMembers(ctx, team)
 }
 
 func (r *ContestRepository) EditContestTeamMembers(ctx context.Context, teamID uuid.UUID, members []uuid.UUID) error {
-	// 存在チェック
-	err := r.h.
-		WithContext(ctx).
-		Where(&model.ContestTeam{ID: teamID}).
-		First(&model.ContestTeam{}).
-		Error
-	if err != nil {
-		return err
-	}
-
-	belongings := make(map[uuid.UUID]struct{}, len(members))
-	_belongings := make([]*model.ContestTeamUserBelonging, 0, len(members))
-	err = r.h.
-		WithContext(ctx).
-		Where(&model.ContestTeamUserBelonging{TeamID: teamID}).
-		Find(&_belongings).
-		Error
-	if err != nil {
-		return err
-	}
-	for _, v := range _belongings {
-		belongings[v.UserID] = struct{}{}
-	}
-
 	membersMap := make(map[uuid.UUID]struct{}, len(members))
 	for _, v := range members {
 		membersMap[v] = struct{}{}
 	}
 
-	err = r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		//チームに所属していなくて渡された配列に入っているメンバーをチームに追加
-		membersToBeAdded := make([]*model.ContestTeamUserBelonging, 0, len(members))
-		for _, memberID := range members {
-			if _, ok := belongings[memberID]; !ok {
-				membersToBeAdded = append(membersToBeAdded, &model.ContestTeamUserBelonging{TeamID: teamID, UserID: memberID})
-			}
-		}
+	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 存在チェック
+		if err := lockVersion(ctx, tx, "contest_teams", teamID); err != nil {
+			return err
+		}
+		if err := bumpContestVersionOfTeam(tx, teamID); err != nil {
+			return err
+		}
+
+		if err := recordContestTeamRevision(tx, teamID); err != nil {
+			return err
+		}
+
+		// 同時に編集されても上書きしないよう、チームをロックしてから現在のメンバーを取得する
+		belongings := make(map[uuid.UUID]struct{}, len(members))
+		_belongings := make([]*model.ContestTeamUserBelonging, 0, len(members))
+		err := tx.
+			WithContext(ctx).
+			Where(&model.ContestTeamUserBelonging{TeamID: teamID}).
+			Find(&_belongings).
+			Error
+		if err != nil {
+			return err
+		}
+		for _, v := range _belongings {
+			belongings[v.UserID] = struct{}{}
+		}
+
+		//チームに所属していなくて渡された配列に入っているメンバーをチームに追加
+		membersToBeAdded := make([]*model.ContestTeamUserBelonging, 0, len(members))
+		for _, memberID := range members {
+			if _, ok := belongings[memberID]; !ok {
+				membersToBeAdded = append(membersToBeAdded, &model.ContestTeamUserBelonging{TeamID: teamID, UserID: memberID})
+			}
+		}
 		if len(membersToBeAdded) > 0 {
 			err = tx.WithContext(ctx).Create(&membersToBeAdded).Error
 			if err != nil {
@@ -538,14 +786,488 @@ func (r *ContestRepository) EditContestTeamMembers(ctx context.Context, teamID u
 				return err
 			}
 		}
-		return nil
+
+		return recordContestTeamRevision(tx, teamID)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *ContestRepository) GetContestRevisions(ctx context.Context, contestID uuid.UUID) ([]*domain.Revision, error) {
+	if err := checkRevisionAccess(repository.IsMember(ctx)); err != nil {
+		return nil, err
+	}
+
+	tx := r.h.WithContext(ctx)
+	if err := tx.
+		Where(&model.Contest{ID: contestID}).
+		First(&model.Contest{}).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return getRevisions(tx, domain.RevisionTargetContest, contestID)
+}
+
+func (r *ContestRepository) GetContestRevisionDiff(ctx context.Context, contestID uuid.UUID, from int, to int) ([]*domain.RevisionFieldDiff, error) {
+	if err := checkRevisionAccess(repository.IsMember(ctx)); err != nil {
+		return nil, err
+	}
+
+	return getRevisionDiff(r.h.WithContext(ctx), domain.RevisionTargetContest, contestID, from, to)
+}
+
+func (r *ContestRepository) RestoreContestRevision(ctx context.Context, contestID uuid.UUID, revision int) error {
+	if err := checkRevisionAccess(repository.IsMember(ctx)); err != nil {
+		return err
+	}
+
+	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var s contestSnapshot
+		if err := getRevisionSnapshot(tx, domain.RevisionTargetContest, contestID, revision, &s); err != nil {
+			return err
+		}
+
+		// 復元後の名前が他のコンテストと重複しないか
+		err := tx.
+			Where(&model.Contest{Name: s.Name}).
+			Where("`contests`.`id` <> ?", contestID).
+			First(&model.Contest{}).
+			Error
+		if err == nil {
+			return repository.ErrAlreadyExists
+		} else if !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+
+		if err := recordContestRevision(tx, contestID); err != nil {
+			return err
+		}
+
+		if err := bumpVersion(tx, "contests", contestID); err != nil {
+			return err
+		}
+
+		if err := tx.
+			Model(&model.Contest{ID: contestID}).
+			Updates(map[string]interface{}{
+				"name":           s.Name,
+				"name_en":        s.NameEn,
+				"description":    s.Description,
+				"description_en": s.DescriptionEn,
+				"body":           s.Body,
+				"link":           s.Link,
+				"since":          s.Since,
+				"until":          s.Until,
+				"visibility":     s.Visibility,
+			}).
+			Error; err != nil {
+			return err
+		}
+
+		return recordContestRevision(tx, contestID)
 	})
 	if err != nil {
 		return err
 	}
+
 	return nil
 }
 
+func (r *ContestRepository) GetContestTeamRevisions(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID) ([]*domain.Revision, error) {
+	if err := checkRevisionAccess(repository.IsMember(ctx)); err != nil {
+		return nil, err
+	}
+
+	tx := r.h.WithContext(ctx)
+	if err := tx.
+		Where(&model.ContestTeam{ID: teamID, ContestID: contestID}).
+		First(&model.ContestTeam{}).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return getRevisions(tx, domain.RevisionTargetContestTeam, teamID)
+}
+
+func (r *ContestRepository) GetContestTeamRevisionDiff(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID, from int, to int) ([]*domain.RevisionFieldDiff, error) {
+	if err := checkRevisionAccess(repository.IsMember(ctx)); err != nil {
+		return nil, err
+	}
+
+	tx := r.h.WithContext(ctx)
+	if err := tx.
+		Where(&model.ContestTeam{ID: teamID, ContestID: contestID}).
+		First(&model.ContestTeam{}).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return getRevisionDiff(tx, domain.RevisionTargetContestTeam, teamID, from, to)
+}
+
+func (r *ContestRepository) RestoreContestTeamRevision(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID, revision int) error {
+	if err := checkRevisionAccess(repository.IsMember(ctx)); err != nil {
+		return err
+	}
+
+	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where(&model.ContestTeam{ID: teamID, ContestID: contestID}).
+			First(&model.ContestTeam{}).
+			Error; err != nil {
+			return err
+		}
+
+		var s contestTeamSnapshot
+		if err := getRevisionSnapshot(tx, domain.RevisionTargetContestTeam, teamID, revision, &s); err != nil {
+			return err
+		}
+
+		if err := recordContestTeamRevision(tx, teamID); err != nil {
+			return err
+		}
+
+		if err := bumpVersion(tx, "contest_teams", teamID); err != nil {
+			return err
+		}
+		if err := bumpVersion(tx, "contests", contestID); err != nil {
+			return err
+		}
+
+		changes := map[string]interface{}{
+			"name":           s.Name,
+			"name_en":        s.NameEn,
+			"description":    s.Description,
+			"description_en": s.DescriptionEn,
+			"body":           s.Body,
+			"result":         s.Result,
+			"link":           s.Link,
+			"visibility":     s.Visibility,
+		}
+		if err := setResultUpdatedAt(tx, teamID, changes); err != nil {
+			return err
+		}
+
+		if err := tx.
+			Model(&model.ContestTeam{ID: teamID}).
+			Updates(changes).
+			Error; err != nil {
+			return err
+		}
+
+		if err := tx.
+			Where(&model.ContestTeamUserBelonging{TeamID: teamID}).
+			Delete(&model.ContestTeamUserBelonging{}).
+			Error; err != nil {
+			return err
+		}
+		if len(s.Members) > 0 {
+			belongings := make([]*model.ContestTeamUserBelonging, len(s.Members))
+			for i, userID := range s.Members {
+				belongings[i] = &model.ContestTeamUserBelonging{TeamID: teamID, UserID: userID}
+			}
+			if err := tx.Create(&belongings).Error; err != nil {
+				return err
+			}
+		}
+
+		return recordContestTeamRevision(tx, teamID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ContestRepository) ImportContestTeams(ctx context.Context, contestID uuid.UUID, rows []*repository.ImportContestTeamArgs, dryRun bool) ([]*domain.ContestTeamImportResult, error) {
+	var results []*domain.ContestTeamImportResult
+	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.
+			Where(&model.Contest{ID: contestID}).
+			First(&model.Contest{}).
+			Error; err != nil {
+			return err
+		}
+
+		existingTeams := make([]*model.ContestTeam, 0)
+		if err := tx.
+			Where(&model.ContestTeam{ContestID: contestID}).
+			Order("`contest_teams`.`created_at`").
+			Find(&existingTeams).
+			Error; err != nil {
+			return err
+		}
+		teamIDByName := make(map[string]uuid.UUID, len(existingTeams))
+		for _, t := range existingTeams {
+			if _, ok := teamIDByName[t.Name]; !ok {
+				teamIDByName[t.Name] = t.ID
+			}
+		}
+
+		userIDByName, err := getUserIDsByName(tx, rows)
+		if err != nil {
+			return err
+		}
+
+		results = make([]*domain.ContestTeamImportResult, len(rows))
+		rowByName := make(map[string]int, len(rows))
+		for i, row := range rows {
+			res := &domain.ContestTeamImportResult{Action: domain.ContestTeamImportCreate, Errors: []string{}}
+			if id, ok := teamIDByName[row.Name]; ok {
+				res.Action = domain.ContestTeamImportUpdate
+				res.TeamID = id
+			}
+
+			if j, ok := rowByName[row.Name]; ok {
+				res.Errors = append(res.Errors, fmt.Sprintf("team name %q is also used in row %d", row.Name, j+1))
+			} else {
+				rowByName[row.Name] = i
+			}
+			for _, name := range row.Members.ValueOrZero() {
+				if _, ok := userIDByName[name]; !ok {
+					res.Errors = append(res.Errors, fmt.Sprintf("user %q is not found", name))
+				}
+			}
+
+			results[i] = res
+		}
+
+		if dryRun || domain.HasContestTeamImportErrors(results) {
+			return nil
+		}
+
+		for i, row := range rows {
+			var err error
+			switch results[i].Action {
+			case domain.ContestTeamImportCreate:
+				results[i].TeamID, err = importNewContestTeam(tx, contestID, row)
+			case domain.ContestTeamImportUpdate:
+				err = importExistingContestTeam(tx, results[i].TeamID, row)
+				if err == nil {
+					err = bumpVersion(tx, "contest_teams", results[i].TeamID)
+				}
+			}
+			if err != nil {
+				return err
+			}
+
+			if members, ok := row.Members.V(); ok {
+				memberIDs := make([]uuid.UUID, len(members))
+				for j, name := range members {
+					memberIDs[j] = userIDByName[name]
+				}
+				if err := replaceContestTeamMembers(tx, results[i].TeamID, memberIDs); err != nil {
+					return err
+				}
+			}
+
+			if err := recordContestTeamRevision(tx, results[i].TeamID); err != nil {
+				return err
+			}
+		}
+
+		return bumpVersion(tx, "contests", contestID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// getUserIDsByName 一括登録の全ての行で指定されたtraQ IDをユーザーIDに変換する
+func getUserIDsByName(tx *gorm.DB, rows []*repository.ImportContestTeamArgs) (map[string]uuid.UUID, error) {
+	names := make([]string, 0)
+	for _, row := range rows {
+		names = append(names, row.Members.ValueOrZero()...)
+	}
+	if len(names) == 0 {
+		return map[string]uuid.UUID{}, nil
+	}
+
+	users := make([]*model.User, 0, len(names))
+	if err := tx.
+		Where("`users`.`name` IN (?)", lo.Uniq(names)).
+		Find(&users).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return lo.Associate(users, func(u *model.User) (string, uuid.UUID) {
+		return u.Name, u.ID
+	}), nil
+}
+
+func importNewContestTeam(tx *gorm.DB, contestID uuid.UUID, row *repository.ImportContestTeamArgs) (uuid.UUID, error) {
+	team := &model.ContestTeam{
+		ID:          uuid.Must(uuid.NewV4()),
+		ContestID:   contestID,
+		Name:        row.Name,
+		Description: row.Description.ValueOrZero(),
+		Result:      row.Result.ValueOrZero(),
+		Link:        row.Link.ValueOrZero(),
+	}
+	if team.Result != "" {
+		team.ResultUpdatedAt = optional.From(time.Now())
+	}
+	if err := tx.Create(team).Error; err != nil {
+		return uuid.Nil, err
+	}
+
+	return team.ID, nil
+}
+
+func importExistingContestTeam(tx *gorm.DB, teamID uuid.UUID, row *repository.ImportContestTeamArgs) error {
+	// 編集履歴導入前のコンテストチームの場合は編集前の状態も記録する
+	if err := recordContestTeamRevision(tx, teamID); err != nil {
+		return err
+	}
+
+	changes := map[string]interface{}{}
+	if v, ok := row.Result.V(); ok {
+		changes["result"] = v
+	}
+	if v, ok := row.Link.V(); ok {
+		changes["link"] = v
+	}
+	if v, ok := row.Description.V(); ok {
+		changes["description"] = v
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if err := setResultUpdatedAt(tx, teamID, changes); err != nil {
+		return err
+	}
+
+	return tx.
+		Model(&model.ContestTeam{ID: teamID}).
+		Updates(changes).
+		Error
+}
+
+// setResultUpdatedAt changesでチームの結果が変わる場合だけ、フィードに使う結果の更新日時も変える
+// 名前やメンバーなど結果以外の編集では変えない
+func setResultUpdatedAt(tx *gorm.DB, teamID uuid.UUID, changes map[string]interface{}) error {
+	result, ok := changes["result"]
+	if !ok {
+		return nil
+	}
+
+	team := new(model.ContestTeam)
+	if err := tx.
+		Select("result").
+		Where(&model.ContestTeam{ID: teamID}).
+		First(team).
+		Error; err != nil {
+		return err
+	}
+	if team.Result != result {
+		changes["result_updated_at"] = time.Now()
+	}
+
+	return nil
+}
+
+// bumpContestVersionOfTeam チームの編集でコンテストの詳細情報も変わるため、チームが属するコンテストの版番号も増やす
+func bumpContestVersionOfTeam(tx *gorm.DB, teamID uuid.UUID) error {
+	team := new(model.ContestTeam)
+	if err := tx.
+		Select("contest_id").
+		Where(&model.ContestTeam{ID: teamID}).
+		First(team).
+		Error; err != nil {
+		return err
+	}
+
+	return bumpVersion(tx, "contests", team.ContestID)
+}
+
+// replaceContestTeamMembers チームに所属するメンバーをmemberIDsに置き換える
+func replaceContestTeamMembers(tx *gorm.DB, teamID uuid.UUID, memberIDs []uuid.UUID) error {
+	if err := tx.
+		Where(&model.ContestTeamUserBelonging{TeamID: teamID}).
+		Delete(&model.ContestTeamUserBelonging{}).
+		Error; err != nil {
+		return err
+	}
+
+	memberIDs = lo.Uniq(memberIDs)
+	if len(memberIDs) == 0 {
+		return nil
+	}
+
+	belongings := make([]*model.ContestTeamUserBelonging, len(memberIDs))
+	for i, id := range memberIDs {
+		belongings[i] = &model.ContestTeamUserBelonging{TeamID: teamID, UserID: id}
+	}
+
+	return tx.Create(&belongings).Error
+}
+
+// GetFeedContests 公開されているコンテストを追加日時の新しい順に返す
+func (r *ContestRepository) GetFeedContests(ctx context.Context, limit int) ([]*domain.FeedContest, error) {
+	contests := make([]*model.Contest, 0)
+	err := wherePublic(r.h.WithContext(ctx), "contests").
+		Order("`contests`.`created_at` DESC").
+		Limit(limit).
+		Find(&contests).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	lang := repository.LangFrom(ctx)
+	res := make([]*domain.FeedContest, len(contests))
+	for i, v := range contests {
+		res[i] = &domain.FeedContest{
+			ID:          v.ID,
+			Name:        lang.Pick(v.Name, v.NameEn),
+			Description: lang.Pick(v.Description, v.DescriptionEn),
+			TimeStart:   v.Since,
+			CreatedAt:   v.CreatedAt,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	}
+
+	return res, nil
+}
+
+// GetFeedContestResults コンテストチームの公開範囲はコンテストの公開範囲でも制限される
+func (r *ContestRepository) GetFeedContestResults(ctx context.Context, limit int) ([]*domain.FeedContestResult, error) {
+	teams := make([]*model.ContestTeam, 0)
+	err := wherePublic(r.h.WithContext(ctx).Joins("Contest"), "Contest").
+		Where("`contest_teams`.`visibility` <> ?", domain.VisibilityPrivate).
+		Where("`contest_teams`.`result` <> ''").
+		Order("`contest_teams`.`result_updated_at` DESC").
+		Limit(limit).
+		Find(&teams).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	lang := repository.LangFrom(ctx)
+	res := make([]*domain.FeedContestResult, len(teams))
+	for i, v := range teams {
+		res[i] = &domain.FeedContestResult{
+			ContestID:   v.ContestID,
+			ContestName: lang.Pick(v.Contest.Name, v.Contest.NameEn),
+			TeamID:      v.ID,
+			TeamName:    lang.Pick(v.Name, v.NameEn),
+			Result:      v.Result,
+			UpdatedAt:   v.ResultUpdatedAt.ValueOrZero(),
+		}
+	}
+
+	return res, nil
+}
+
 // Interface guards
 var (
 	_ repository.ContestRepository = (*ContestRepository)(nil)
@@ -12,6 +12,7 @@ import (
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/external/mock_external"
 	"go.uber.org/mock/gomock"
 
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
 	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
 )
@@ -37,6 +38,128 @@ func Test_GetContests(t *testing.T) {
 	})
 }
 
+func Test_GetFeedContests(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	portalAPI := mock_external.NewMockPortalAPI(gomock.NewController(t))
+	repo := NewContestRepository(db, portalAPI)
+	ctx := context.Background()
+
+	older, err := repo.CreateContest(ctx, random.CreateContestArgs())
+	assert.NoError(t, err)
+
+	privateArgs := random.CreateContestArgs()
+	privateArgs.Visibility = optional.From(domain.VisibilityPrivate)
+	private, err := repo.CreateContest(ctx, privateArgs)
+	assert.NoError(t, err)
+
+	draftArgs := random.CreateContestArgs()
+	draftArgs.Draft = optional.From(true)
+	draft, err := repo.CreateContest(ctx, draftArgs)
+	assert.NoError(t, err)
+
+	newer, err := repo.CreateContest(ctx, random.CreateContestArgs())
+	assert.NoError(t, err)
+
+	// メンバーからのアクセスでも非公開や下書きのものは含めない
+	memberCtx := repository.WithMember(ctx)
+
+	t.Run("contests", func(t *testing.T) {
+		got, err := repo.GetFeedContests(memberCtx, 10)
+		assert.NoError(t, err)
+		ids := lo.Map(got, func(c *domain.FeedContest, _ int) uuid.UUID { return c.ID })
+		assert.Equal(t, []uuid.UUID{newer.ID, older.ID}, ids)
+		assert.Equal(t, newer.Name, got[0].Name)
+
+		got, err = repo.GetFeedContests(memberCtx, 1)
+		assert.NoError(t, err)
+		assert.Len(t, got, 1)
+	})
+
+	t.Run("results", func(t *testing.T) {
+		withResult := mustMakeContestTeam(t, repo, older.ID, &repository.CreateContestTeamArgs{
+			Name:   random.AlphaNumeric(),
+			Result: optional.From("優勝"),
+		})
+		mustMakeContestTeam(t, repo, older.ID, &repository.CreateContestTeamArgs{
+			Name: random.AlphaNumeric(),
+		})
+		mustMakeContestTeam(t, repo, older.ID, &repository.CreateContestTeamArgs{
+			Name:       random.AlphaNumeric(),
+			Result:     optional.From(random.AlphaNumeric()),
+			Visibility: optional.From(domain.VisibilityPrivate),
+		})
+		mustMakeContestTeam(t, repo, private.ID, &repository.CreateContestTeamArgs{
+			Name:   random.AlphaNumeric(),
+			Result: optional.From(random.AlphaNumeric()),
+		})
+		mustMakeContestTeam(t, repo, draft.ID, &repository.CreateContestTeamArgs{
+			Name:   random.AlphaNumeric(),
+			Result: optional.From(random.AlphaNumeric()),
+		})
+
+		got, err := repo.GetFeedContestResults(memberCtx, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, got, 1) {
+			assert.Equal(t, older.ID, got[0].ContestID)
+			assert.Equal(t, older.Name, got[0].ContestName)
+			assert.Equal(t, withResult.ID, got[0].TeamID)
+			assert.Equal(t, withResult.Name, got[0].TeamName)
+			assert.Equal(t, "優勝", got[0].Result)
+		}
+	})
+}
+
+func Test_GetFeedContestResults_ResultUpdatedAt(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	portalAPI := mock_external.NewMockPortalAPI(gomock.NewController(t))
+	repo := NewContestRepository(db, portalAPI)
+	ctx := context.Background()
+
+	contest := mustMakeContest(t, repo, nil)
+	team1 := mustMakeContestTeam(t, repo, contest.ID, &repository.CreateContestTeamArgs{
+		Name:   random.AlphaNumeric(),
+		Result: optional.From("優勝"),
+	})
+	team2 := mustMakeContestTeam(t, repo, contest.ID, &repository.CreateContestTeamArgs{
+		Name:   random.AlphaNumeric(),
+		Result: optional.From("準優勝"),
+	})
+
+	got, err := repo.GetFeedContestResults(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{team2.ID, team1.ID}, lo.Map(got, func(r *domain.FeedContestResult, _ int) uuid.UUID { return r.TeamID }))
+	resultUpdatedAt := got[1].UpdatedAt
+
+	// 結果以外の編集では順番も更新日時も変わらない
+	err = repo.UpdateContestTeam(ctx, team1.ID, &repository.UpdateContestTeamArgs{
+		Name:   optional.From(random.AlphaNumeric()),
+		Result: optional.From("優勝"),
+	})
+	assert.NoError(t, err)
+	err = repo.EditContestTeamMembers(ctx, team1.ID, []uuid.UUID{})
+	assert.NoError(t, err)
+
+	got, err = repo.GetFeedContestResults(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{team2.ID, team1.ID}, lo.Map(got, func(r *domain.FeedContestResult, _ int) uuid.UUID { return r.TeamID }))
+	assert.Equal(t, resultUpdatedAt, got[1].UpdatedAt)
+
+	// 結果が変わると先頭に来る
+	err = repo.UpdateContestTeam(ctx, team1.ID, &repository.UpdateContestTeamArgs{
+		Result: optional.From("3位"),
+	})
+	assert.NoError(t, err)
+
+	got, err = repo.GetFeedContestResults(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{team1.ID, team2.ID}, lo.Map(got, func(r *domain.FeedContestResult, _ int) uuid.UUID { return r.TeamID }))
+	assert.True(t, got[0].UpdatedAt.After(resultUpdatedAt))
+}
+
 func Test_GetContest(t *testing.T) {
 	t.Parallel()
 
@@ -62,6 +185,32 @@ func Test_GetContest(t *testing.T) {
 	})
 }
 
+func Test_GetContestDetails(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	portalAPI := mock_external.NewMockPortalAPI(gomock.NewController(t))
+	repo := NewContestRepository(db, portalAPI)
+
+	// contest1 has a team (team1)
+	contest1, err := repo.CreateContest(context.Background(), random.CreateContestArgs())
+	assert.NoError(t, err)
+	team1, err := repo.CreateContestTeam(context.Background(), contest1.ID, random.CreateContestTeamArgs())
+	assert.NoError(t, err)
+	// contest2 has no teams
+	contest2, err := repo.CreateContest(context.Background(), random.CreateContestArgs())
+	assert.NoError(t, err)
+
+	portalAPI.EXPECT().GetUsers().Return([]*external.PortalUserResponse{}, nil)
+	got, err := repo.GetContestDetails(context.Background(), []uuid.UUID{contest1.ID, contest2.ID, random.UUID()})
+	assert.NoError(t, err)
+
+	contest1.ContestTeams = []*domain.ContestTeam{&team1.ContestTeam}
+	contest1.Version++ // チームの作成で増える
+	contest2.ContestTeams = []*domain.ContestTeam{}
+	assert.ElementsMatch(t, []*domain.ContestDetail{contest1, contest2}, got)
+}
+
 func Test_CreateContest(t *testing.T) {
 	t.Parallel()
 
@@ -80,6 +229,23 @@ func Test_CreateContest(t *testing.T) {
 		assert.Equal(t, contest, gotContest)
 	})
 
+	t.Run("create a draft contest as a guest", func(t *testing.T) {
+		ctx := repository.WithGuest(context.Background())
+		args := random.CreateContestArgs()
+		args.Draft = optional.From(true)
+		contest, err := repo.CreateContest(ctx, args)
+		assert.NoError(t, err)
+		assert.Equal(t, args.Name, contest.Name)
+		assert.True(t, contest.Publish.Draft)
+
+		// 作成したコンテストは閲覧者に見えなくても作成されている
+		_, err = repo.GetContest(ctx, contest.ID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		gotContest, err := repo.GetContest(repository.WithMember(context.Background()), contest.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, contest, gotContest)
+	})
+
 	t.Run("create contests which name duplicated", func(t *testing.T) {
 		ctx := context.Background()
 		arg1 := random.CreateContestArgs()
@@ -117,6 +283,7 @@ func Test_UpdateContest(t *testing.T) {
 		contest.Link = args.Link.ValueOr(contest.Link)
 		contest.TimeStart = args.Since.ValueOr(contest.TimeStart)
 		contest.TimeEnd = args.Until.ValueOr(contest.TimeEnd)
+		contest.Version++
 		assert.Equal(t, contest, gotContest)
 	})
 
@@ -199,6 +366,40 @@ func Test_GetContestTeams(t *testing.T) {
 	})
 }
 
+func Test_GetContestTeamsByContestIDs(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	portalAPI := mock_external.NewMockPortalAPI(gomock.NewController(t))
+	repo := NewContestRepository(db, portalAPI)
+
+	// contest1 has two teams (team1, team2)
+	contest1, err := repo.CreateContest(context.Background(), random.CreateContestArgs())
+	assert.NoError(t, err)
+	team1, err := repo.CreateContestTeam(context.Background(), contest1.ID, random.CreateContestTeamArgs())
+	assert.NoError(t, err)
+	team2, err := repo.CreateContestTeam(context.Background(), contest1.ID, random.CreateContestTeamArgs())
+	assert.NoError(t, err)
+	// contest2 has no teams
+	contest2, err := repo.CreateContest(context.Background(), random.CreateContestArgs())
+	assert.NoError(t, err)
+
+	t.Run("get teams of contest1 and contest2", func(t *testing.T) {
+		portalAPI.EXPECT().GetUsers().Return([]*external.PortalUserResponse{}, nil)
+		got, err := repo.GetContestTeamsByContestIDs(context.Background(), []uuid.UUID{contest1.ID, contest2.ID, random.UUID()})
+		assert.NoError(t, err)
+		assert.Len(t, got, 2)
+		assert.ElementsMatch(t, []*domain.ContestTeam{&team1.ContestTeam, &team2.ContestTeam}, got[contest1.ID])
+		assert.Empty(t, got[contest2.ID])
+	})
+
+	t.Run("no contest ids", func(t *testing.T) {
+		got, err := repo.GetContestTeamsByContestIDs(context.Background(), []uuid.UUID{})
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
 func Test_GetContestTeam(t *testing.T) {
 	t.Parallel()
 
@@ -256,6 +457,7 @@ func Test_UpdateContestTeam(t *testing.T) {
 		team.Result = args.Result.ValueOr(team.Result)
 		team.Link = args.Link.ValueOr(team.Link)
 		team.Description = args.Description.ValueOr(team.Description)
+		team.Version++
 		assert.Equal(t, team, gotTeam)
 	})
 
@@ -454,3 +656,135 @@ func Test_EditContestTeamMembers(t *testing.T) {
 		assert.ElementsMatch(t, expectedMembers, gotMembers)
 	})
 }
+
+func Test_ImportContestTeams(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	portalAPI := mock_external.NewMockPortalAPI(gomock.NewController(t))
+	repo := NewContestRepository(db, portalAPI)
+	traqAPI := mock_external.NewMockTraQAPI(gomock.NewController(t))
+	userRepo := NewUserRepository(db, portalAPI, traqAPI)
+	ctx := repository.WithMember(context.Background())
+
+	contest := mustMakeContest(t, repo, nil)
+	existing := mustMakeContestTeam(t, repo, contest.ID, nil)
+
+	traqUsers := []*external.TraQUserResponse{
+		{ID: random.UUID(), Name: "user1", State: domain.TraqStateActive},
+		{ID: random.UUID(), Name: "user2", State: domain.TraqStateActive},
+	}
+	traqAPI.EXPECT().GetUsers(&external.TraQGetAllArgs{IncludeSuspended: true}).Return(traqUsers, nil)
+	assert.NoError(t, userRepo.SyncUsers(context.Background()))
+	portalAPI.EXPECT().GetUsers().Return([]*external.PortalUserResponse{}, nil).AnyTimes()
+
+	rows := []*repository.ImportContestTeamArgs{
+		{Name: existing.Name, Result: optional.From("1st"), Members: optional.From([]string{"user1"})},
+		{Name: "new team", Description: optional.From("description"), Members: optional.From([]string{"user1", "user2"})},
+	}
+
+	t.Run("dry run does not apply changes", func(t *testing.T) {
+		results, err := repo.ImportContestTeams(ctx, contest.ID, rows, true)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.ContestTeamImportResult{
+			{Action: domain.ContestTeamImportUpdate, TeamID: existing.ID, Errors: []string{}},
+			{Action: domain.ContestTeamImportCreate, Errors: []string{}},
+		}, results)
+
+		teams, err := repo.GetContestTeams(ctx, contest.ID)
+		assert.NoError(t, err)
+		assert.Len(t, teams, 1)
+	})
+
+	t.Run("rows with errors are not applied", func(t *testing.T) {
+		invalidRows := []*repository.ImportContestTeamArgs{
+			{Name: "team", Members: optional.From([]string{"unknown"})},
+			{Name: "team"},
+		}
+		results, err := repo.ImportContestTeams(ctx, contest.ID, invalidRows, false)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.ContestTeamImportResult{
+			{Action: domain.ContestTeamImportCreate, Errors: []string{`user "unknown" is not found`}},
+			{Action: domain.ContestTeamImportCreate, Errors: []string{`team name "team" is also used in row 1`}},
+		}, results)
+
+		teams, err := repo.GetContestTeams(ctx, contest.ID)
+		assert.NoError(t, err)
+		assert.Len(t, teams, 1)
+	})
+
+	t.Run("apply changes", func(t *testing.T) {
+		results, err := repo.ImportContestTeams(ctx, contest.ID, rows, false)
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, existing.ID, results[0].TeamID)
+		assert.False(t, results[1].TeamID.IsNil())
+
+		updated, err := repo.GetContestTeam(ctx, contest.ID, existing.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "1st", updated.Result)
+		assert.Equal(t, existing.Description, updated.Description)
+		assert.ElementsMatch(t, []uuid.UUID{traqUsers[0].ID}, lo.Map(updated.Members, func(u *domain.User, _ int) uuid.UUID { return u.ID }))
+
+		created, err := repo.GetContestTeam(ctx, contest.ID, results[1].TeamID)
+		assert.NoError(t, err)
+		assert.Equal(t, "new team", created.Name)
+		assert.Equal(t, "description", created.Description)
+		assert.ElementsMatch(t, []uuid.UUID{traqUsers[0].ID, traqUsers[1].ID}, lo.Map(created.Members, func(u *domain.User, _ int) uuid.UUID { return u.ID }))
+	})
+
+	t.Run("contest not found", func(t *testing.T) {
+		_, err := repo.ImportContestTeams(ctx, random.UUID(), rows, true)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
+
+func Test_ContestVersion(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	portalAPI := mock_external.NewMockPortalAPI(gomock.NewController(t))
+	repo := NewContestRepository(db, portalAPI)
+	portalAPI.EXPECT().GetUsers().Return([]*external.PortalUserResponse{}, nil).AnyTimes()
+
+	ctx := context.Background()
+	contest := mustMakeContest(t, repo, nil)
+	team := mustMakeContestTeam(t, repo, contest.ID, nil)
+
+	// チームの作成でコンテストの版番号が増える
+	gotContest, err := repo.GetContest(ctx, contest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, gotContest.Version)
+
+	// 編集前の版番号を指定するとチームを編集でき、チームとコンテストの版番号が増える
+	err = repo.UpdateContestTeam(repository.WithVersions(ctx, 0), team.ID, &repository.UpdateContestTeamArgs{
+		Name: optional.From(random.AlphaNumeric()),
+	})
+	assert.NoError(t, err)
+
+	gotTeam, err := repo.GetContestTeam(ctx, contest.ID, team.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, gotTeam.Version)
+	gotContest, err = repo.GetContest(ctx, contest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, gotContest.Version)
+
+	// 古い版番号を指定すると編集されない
+	err = repo.EditContestTeamMembers(repository.WithVersions(ctx, 0), team.ID, []uuid.UUID{})
+	assert.ErrorIs(t, err, repository.ErrPreconditionFailed)
+
+	gotContest, err = repo.GetContest(ctx, contest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, gotContest.Version)
+
+	// メンバーの編集でもチームとコンテストの版番号が増える
+	err = repo.EditContestTeamMembers(repository.WithVersions(ctx, 1), team.ID, []uuid.UUID{})
+	assert.NoError(t, err)
+
+	gotTeam, err = repo.GetContestTeam(ctx, contest.ID, team.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, gotTeam.Version)
+	gotContest, err = repo.GetContest(ctx, contest.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, gotContest.Version)
+}
@@ -35,7 +35,7 @@ func (r *EventRepository) GetEvents(ctx context.Context) ([]*domain.Event, error
 		return nil, err
 	}
 	events := r.convertEvents(knoqEvents, levelByID)
-	result := filterAccessibleEvents(events)
+	result := filterAccessibleEvents(ctx, events)
 	return result, nil
 }
 
@@ -75,6 +75,11 @@ func (r *EventRepository) GetEvent(ctx context.Context, eventID uuid.UUID) (*dom
 		return nil, err
 	}
 
+	// メンバーからのアクセスの場合は全て公開する
+	if repository.IsMember(ctx) {
+		return &ed, nil
+	}
+
 	res := domain.ApplyEventLevel(ed)
 	if v, ok := res.V(); ok {
 		return &v, nil
@@ -144,8 +149,7 @@ func (r *EventRepository) GetUserEvents(ctx context.Context, userID uuid.UUID) (
 		return nil, err
 	}
 	events := r.convertEvents(knoqEvents, levelByID)
-	// TODO: 自分のイベントで非公開のものが見られない
-	result := filterAccessibleEvents(events)
+	result := filterAccessibleEvents(ctx, events)
 	return result, nil
 }
 
@@ -183,7 +187,12 @@ func (r *EventRepository) convertEvents(events []*external.EventResponse, levelB
 	return result
 }
 
-func filterAccessibleEvents(events []*domain.Event) []*domain.Event {
+func filterAccessibleEvents(ctx context.Context, events []*domain.Event) []*domain.Event {
+	// メンバーからのアクセスの場合は全て公開する
+	if repository.IsMember(ctx) {
+		return events
+	}
+
 	// privateのものだけ除外する
 	return lo.Filter(events, func(e *domain.Event, _ int) bool {
 		return e.Level != domain.EventLevelPrivate
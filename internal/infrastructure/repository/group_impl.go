@@ -26,11 +26,12 @@ func (r *GroupRepository) GetGroups(ctx context.Context, args *repository.GetGro
 		return nil, err
 	}
 
+	lang := repository.LangFrom(ctx)
 	result := make([]*domain.Group, 0, len(groups))
 	for _, v := range groups {
 		result = append(result, &domain.Group{
 			ID:   v.GroupID,
-			Name: v.Name,
+			Name: lang.Pick(v.Name, v.NameEn),
 		})
 	}
 	return result, nil
@@ -88,13 +89,14 @@ func (r *GroupRepository) GetGroup(ctx context.Context, groupID uuid.UUID) (*dom
 	}
 
 	// Name,RealNameはPortalから取得する
+	lang := repository.LangFrom(ctx)
 	result := &domain.GroupDetail{
 		ID:          groupID,
-		Name:        group.Name,
+		Name:        lang.Pick(group.Name, group.NameEn),
 		Link:        group.Link,
 		Admin:       erAdmin,
 		Members:     erMembers,
-		Description: group.Description,
+		Description: lang.Pick(group.Description, group.DescriptionEn),
 	}
 	return result, nil
 }
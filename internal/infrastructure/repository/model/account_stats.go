@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type AccountStats struct {
+	AccountID     uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
+	FetchedAt     time.Time `gorm:"precision:6;not null;primaryKey"`
+	Rating        int       `gorm:"type:int;not null"`
+	MaxRating     int       `gorm:"type:int;not null"`
+	Color         string    `gorm:"type:varchar(32);not null"`
+	RatedContests int       `gorm:"type:int unsigned;not null"`
+
+	Account Account `gorm:"foreignKey:AccountID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (*AccountStats) TableName() string {
+	return "account_stats"
+}
@@ -4,17 +4,26 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
 )
 
 type Contest struct {
-	ID          uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
-	Name        string    `gorm:"type:varchar(128)"`
-	Description string    `gorm:"type:text"`
-	Link        string    `gorm:"type:text"`
-	Since       time.Time `gorm:"precision:6"`
-	Until       time.Time `gorm:"precision:6"`
-	CreatedAt   time.Time `gorm:"precision:6"`
-	UpdatedAt   time.Time `gorm:"precision:6"`
+	ID            uuid.UUID              `gorm:"type:char(36);not null;primaryKey"`
+	Name          string                 `gorm:"type:varchar(128)"`
+	NameEn        string                 `gorm:"type:varchar(128);not null;default:''"`
+	Description   string                 `gorm:"type:text"`
+	DescriptionEn string                 `gorm:"type:text;not null;default:''"`
+	Body          string                 `gorm:"type:text;not null;default:''"`
+	Link          string                 `gorm:"type:text"`
+	Since         time.Time              `gorm:"precision:6"`
+	Until         time.Time              `gorm:"precision:6"`
+	Visibility    domain.Visibility      `gorm:"type:tinyint unsigned;not null;default:0"`
+	Draft         bool                   `gorm:"type:boolean;not null;default:false"`
+	PublishAt     optional.Of[time.Time] `gorm:"type:datetime(6)"`
+	Version       int                    `gorm:"type:int unsigned;not null;default:0"`
+	CreatedAt     time.Time              `gorm:"precision:6"`
+	UpdatedAt     time.Time              `gorm:"precision:6"`
 }
 
 func (*Contest) TableName() string {
@@ -22,14 +31,20 @@ func (*Contest) TableName() string {
 }
 
 type ContestTeam struct {
-	ID          uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
-	ContestID   uuid.UUID `gorm:"type:char(36);not null"`
-	Name        string    `gorm:"type:varchar(128)"`
-	Description string    `gorm:"type:text"`
-	Result      string    `gorm:"type:text"`
-	Link        string    `gorm:"type:text"`
-	CreatedAt   time.Time `gorm:"precision:6"`
-	UpdatedAt   time.Time `gorm:"precision:6"`
+	ID              uuid.UUID              `gorm:"type:char(36);not null;primaryKey"`
+	ContestID       uuid.UUID              `gorm:"type:char(36);not null"`
+	Name            string                 `gorm:"type:varchar(128)"`
+	NameEn          string                 `gorm:"type:varchar(128);not null;default:''"`
+	Description     string                 `gorm:"type:text"`
+	DescriptionEn   string                 `gorm:"type:text;not null;default:''"`
+	Body            string                 `gorm:"type:text;not null;default:''"`
+	Result          string                 `gorm:"type:text"`
+	ResultUpdatedAt optional.Of[time.Time] `gorm:"type:datetime(6)"` // 結果が変わった日時。フィードに使う
+	Link            string                 `gorm:"type:text"`
+	Visibility      domain.Visibility      `gorm:"type:tinyint unsigned;not null;default:0"`
+	Version         int                    `gorm:"type:int unsigned;not null;default:0"`
+	CreatedAt       time.Time              `gorm:"precision:6"`
+	UpdatedAt       time.Time              `gorm:"precision:6"`
 
 	Contest Contest `gorm:"foreignKey:ContestID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type ProjectGitHubRepository struct {
+	ProjectID   uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
+	Owner       string    `gorm:"type:varchar(64);not null"`
+	Name        string    `gorm:"type:varchar(128);not null"`
+	Description string    `gorm:"type:text;not null"`
+	Languages   []string  `gorm:"type:text;not null;serializer:json"` // 主な言語の配列のJSON
+	Stars       int       `gorm:"type:int unsigned;not null"`
+	License     string    `gorm:"type:varchar(64);not null"`
+	PushedAt    time.Time `gorm:"precision:6"`
+	FetchedAt   time.Time `gorm:"precision:6"`
+
+	Project Project `gorm:"foreignKey:ProjectID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (*ProjectGitHubRepository) TableName() string {
+	return "project_github_repositories"
+}
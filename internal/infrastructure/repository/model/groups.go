@@ -7,12 +7,14 @@ import (
 )
 
 type Group struct {
-	GroupID     uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
-	Name        string    `gorm:"type:varchar(32)"`
-	Link        string    `gorm:"type:text"`
-	Description string    `gorm:"type:text"`
-	CreatedAt   time.Time `gorm:"precision:6"`
-	UpdatedAt   time.Time `gorm:"precision:6"`
+	GroupID       uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
+	Name          string    `gorm:"type:varchar(32)"`
+	NameEn        string    `gorm:"type:varchar(32);not null;default:''"`
+	Link          string    `gorm:"type:text"`
+	Description   string    `gorm:"type:text"`
+	DescriptionEn string    `gorm:"type:text;not null;default:''"`
+	CreatedAt     time.Time `gorm:"precision:6"`
+	UpdatedAt     time.Time `gorm:"precision:6"`
 }
 
 func (*Group) TableName() string {
@@ -4,19 +4,28 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
 )
 
 type Project struct {
-	ID            uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
-	Name          string    `gorm:"type:varchar(128)"`
-	Description   string    `gorm:"type:text"`
-	Link          string    `gorm:"type:text"`
-	SinceYear     int       `gorm:"type:smallint(4);not null"`
-	SinceSemester int       `gorm:"type:tinyint(1);not null"`
-	UntilYear     int       `gorm:"type:smallint(4);not null"`
-	UntilSemester int       `gorm:"type:tinyint(1);not null"`
-	CreatedAt     time.Time `gorm:"precision:6"`
-	UpdatedAt     time.Time `gorm:"precision:6"`
+	ID            uuid.UUID              `gorm:"type:char(36);not null;primaryKey"`
+	Name          string                 `gorm:"type:varchar(128)"`
+	NameEn        string                 `gorm:"type:varchar(128);not null;default:''"`
+	Description   string                 `gorm:"type:text"`
+	DescriptionEn string                 `gorm:"type:text;not null;default:''"`
+	Body          string                 `gorm:"type:text;not null;default:''"`
+	Link          string                 `gorm:"type:text"`
+	SinceYear     int                    `gorm:"type:smallint(4);not null"`
+	SinceSemester int                    `gorm:"type:tinyint(1);not null"`
+	UntilYear     int                    `gorm:"type:smallint(4);not null"`
+	UntilSemester int                    `gorm:"type:tinyint(1);not null"`
+	Visibility    domain.Visibility      `gorm:"type:tinyint unsigned;not null;default:0"`
+	Draft         bool                   `gorm:"type:boolean;not null;default:false"`
+	PublishAt     optional.Of[time.Time] `gorm:"type:datetime(6)"`
+	Version       int                    `gorm:"type:int unsigned;not null;default:0"`
+	CreatedAt     time.Time              `gorm:"precision:6"`
+	UpdatedAt     time.Time              `gorm:"precision:6"`
 }
 
 func (*Project) TableName() string {
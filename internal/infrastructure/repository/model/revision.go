@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+type Revision struct {
+	TargetType domain.RevisionTarget `gorm:"type:tinyint unsigned;not null;primaryKey"`
+	TargetID   uuid.UUID             `gorm:"type:char(36);not null;primaryKey"`
+	Number     int                   `gorm:"type:int unsigned;not null;primaryKey"`
+	Snapshot   string                `gorm:"type:mediumtext;not null"` // 編集後の状態のJSON
+	CreatedAt  time.Time             `gorm:"precision:6"`
+}
+
+func (*Revision) TableName() string {
+	return "revisions"
+}
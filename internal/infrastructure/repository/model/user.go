@@ -8,13 +8,15 @@ import (
 )
 
 type User struct {
-	ID          uuid.UUID        `gorm:"type:char(36);not null;primaryKey"`
-	Description string           `gorm:"type:text;not null"`
-	Check       bool             `gorm:"type:boolean;not null;default:false"`
-	Name        string           `gorm:"type:varchar(32);not null;unique"`
-	State       domain.TraQState `gorm:"type:tinyint(1);not null"`
-	CreatedAt   time.Time        `gorm:"precision:6"`
-	UpdatedAt   time.Time        `gorm:"precision:6"`
+	ID            uuid.UUID        `gorm:"type:char(36);not null;primaryKey"`
+	Description   string           `gorm:"type:text;not null"`
+	DescriptionEn string           `gorm:"type:text;not null;default:''"`
+	Check         bool             `gorm:"type:boolean;not null;default:false"`
+	Name          string           `gorm:"type:varchar(32);not null;unique"`
+	State         domain.TraQState `gorm:"type:tinyint(1);not null"`
+	Version       int              `gorm:"type:int unsigned;not null;default:0"`
+	CreatedAt     time.Time        `gorm:"precision:6"`
+	UpdatedAt     time.Time        `gorm:"precision:6"`
 
 	Accounts []*Account `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -25,10 +27,11 @@ func (*User) TableName() string {
 
 type Account struct {
 	ID        uuid.UUID `gorm:"type:char(36);not null;primaryKey"`
-	Type      uint8     `gorm:"type:tinyint(1);not null"`
+	Type      uint8     `gorm:"type:tinyint(1);not null;uniqueIndex:idx_accounts_user_id_type,priority:2"`
 	Name      string    `gorm:"type:varchar(256)"`
+	Handle    string    `gorm:"type:varchar(256);not null;default:''"`
 	URL       string    `gorm:"type:text"`
-	UserID    uuid.UUID `gorm:"type:char(36);not null"`
+	UserID    uuid.UUID `gorm:"type:char(36);not null;uniqueIndex:idx_accounts_user_id_type,priority:1"`
 	CreatedAt time.Time `gorm:"precision:6"`
 	UpdatedAt time.Time `gorm:"precision:6"`
 }
@@ -36,3 +39,18 @@ type Account struct {
 func (*Account) TableName() string {
 	return "accounts"
 }
+
+type UserFeaturedItem struct {
+	UserID    uuid.UUID               `gorm:"type:char(36);not null;primaryKey"`
+	Type      domain.FeaturedItemType `gorm:"type:tinyint unsigned;not null;primaryKey"`
+	ItemID    uuid.UUID               `gorm:"type:char(36);not null;primaryKey"`
+	Position  int                     `gorm:"type:tinyint unsigned;not null"`
+	CreatedAt time.Time               `gorm:"precision:6"`
+	UpdatedAt time.Time               `gorm:"precision:6"`
+
+	User User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (*UserFeaturedItem) TableName() string {
+	return "user_featured_items"
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/external"
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/repository/model"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProjectGitHubRepository struct {
+	h      *gorm.DB
+	github external.GitHubAPI
+}
+
+func NewProjectGitHubRepository(sql *gorm.DB, github external.GitHubAPI) *ProjectGitHubRepository {
+	return &ProjectGitHubRepository{
+		h:      sql,
+		github: github,
+	}
+}
+
+func (r *ProjectGitHubRepository) SyncProjectGitHubRepositories(ctx context.Context) error {
+	projects := make([]*model.Project, 0)
+	if err := r.h.
+		WithContext(ctx).
+		Select("id", "link").
+		Find(&projects).
+		Error; err != nil {
+		return err
+	}
+
+	// 同じリポジトリを指すプロジェクトがあっても取得は1回にする
+	fetched := make(map[string]*external.GitHubRepositoryResponse)
+
+	return syncEach(ctx, projects, func(p *model.Project) (error, error) {
+		owner, name, ok := domain.ParseGitHubRepositoryURL(p.Link)
+		if !ok {
+			return nil, r.deleteProjectGitHubRepository(ctx, p.ID)
+		}
+
+		key := strings.ToLower(owner + "/" + name)
+		res, ok := fetched[key]
+		if !ok {
+			var err error
+			res, err = r.github.GetRepository(owner, name)
+			if errors.Is(err, repository.ErrNotFound) {
+				// 削除されたか非公開になったリポジトリ
+				return nil, r.deleteProjectGitHubRepository(ctx, p.ID)
+			}
+			if err != nil {
+				return fmt.Errorf("project %s: %w", p.ID, err), nil
+			}
+			fetched[key] = res
+		}
+
+		return nil, r.h.
+			WithContext(ctx).
+			Clauses(clause.OnConflict{UpdateAll: true}).
+			Create(&model.ProjectGitHubRepository{
+				ProjectID:   p.ID,
+				Owner:       owner,
+				Name:        name,
+				Description: res.Description,
+				Languages:   res.Languages,
+				Stars:       res.Stars,
+				License:     res.License,
+				PushedAt:    res.PushedAt,
+				FetchedAt:   time.Now().Truncate(time.Microsecond),
+			}).
+			Error
+	})
+}
+
+func (r *ProjectGitHubRepository) deleteProjectGitHubRepository(ctx context.Context, projectID uuid.UUID) error {
+	return r.h.
+		WithContext(ctx).
+		Where(&model.ProjectGitHubRepository{ProjectID: projectID}).
+		Delete(&model.ProjectGitHubRepository{}).
+		Error
+}
+
+// getProjectGitHubRepository プロジェクトのリンク先のGitHubリポジトリの情報を返す
+// リンクがGitHubのリポジトリでない場合や、リンクの変更後にまだ取得していない場合はnilを返す
+func getProjectGitHubRepository(tx *gorm.DB, project *model.Project) (*domain.GitHubRepository, error) {
+	owner, name, ok := domain.ParseGitHubRepositoryURL(project.Link)
+	if !ok {
+		return nil, nil
+	}
+
+	repos := make([]*model.ProjectGitHubRepository, 0, 1)
+	if err := tx.
+		Where(&model.ProjectGitHubRepository{ProjectID: project.ID}).
+		Limit(1).
+		Find(&repos).
+		Error; err != nil {
+		return nil, err
+	}
+	if len(repos) == 0 {
+		return nil, nil
+	}
+
+	v := repos[0]
+	res := &domain.GitHubRepository{
+		Owner:       v.Owner,
+		Name:        v.Name,
+		Description: v.Description,
+		Languages:   v.Languages,
+		Stars:       v.Stars,
+		License:     v.License,
+		PushedAt:    v.PushedAt,
+		FetchedAt:   v.FetchedAt,
+	}
+	if !res.IsSame(owner, name) {
+		return nil, nil
+	}
+
+	return res, nil
+}
+
+// Interface guards
+var (
+	_ repository.ProjectGitHubRepository = (*ProjectGitHubRepository)(nil)
+)
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/external/mock_external_e2e"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/mockdata"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
+	urepository "github.com/traPtitech/traPortfolio/internal/usecases/repository"
+)
+
+func TestProjectGitHubRepository_SyncProjectGitHubRepositories(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	projectRepo := NewProjectRepository(db, mock_external_e2e.NewMockPortalAPI())
+	repo := NewProjectGitHubRepository(db, mock_external_e2e.NewMockGitHubAPI())
+
+	duration := random.Duration()
+	project := mustMakeProjectDetail(t, projectRepo, &urepository.CreateProjectArgs{
+		Name:          random.AlphaNumeric(),
+		Description:   random.AlphaNumeric(),
+		Link:          optional.From("https://github.com/traPtitech/traPortfolio"),
+		SinceYear:     duration.Since.Year,
+		SinceSemester: duration.Since.Semester,
+		UntilYear:     duration.Until.ValueOrZero().Year,
+		UntilSemester: duration.Until.ValueOrZero().Semester,
+	})
+	other := mustMakeProjectDetail(t, projectRepo, nil)
+
+	// 取得前は情報がない
+	got, err := projectRepo.GetProject(context.Background(), project.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, got.GitHubRepository)
+
+	err = repo.SyncProjectGitHubRepositories(context.Background())
+	assert.NoError(t, err)
+
+	got, err = projectRepo.GetProject(context.Background(), project.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, got.GitHubRepository) {
+		assert.Equal(t, "traPtitech", got.GitHubRepository.Owner)
+		assert.Equal(t, "traPortfolio", got.GitHubRepository.Name)
+		assert.Equal(t, mockdata.MockGitHubRepository.Description, got.GitHubRepository.Description)
+		assert.Equal(t, mockdata.MockGitHubRepository.Languages, got.GitHubRepository.Languages)
+		assert.Equal(t, mockdata.MockGitHubRepository.Stars, got.GitHubRepository.Stars)
+		assert.Equal(t, mockdata.MockGitHubRepository.License, got.GitHubRepository.License)
+	}
+
+	// リンクがGitHubのリポジトリでないプロジェクト
+	got, err = projectRepo.GetProject(context.Background(), other.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, got.GitHubRepository)
+
+	// リンクを変更すると次に取得するまでは表示しない
+	err = projectRepo.UpdateProject(context.Background(), project.ID, &urepository.UpdateProjectArgs{
+		Link: optional.From("https://github.com/traPtitech/traQ"),
+	})
+	assert.NoError(t, err)
+	got, err = projectRepo.GetProject(context.Background(), project.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, got.GitHubRepository)
+
+	err = repo.SyncProjectGitHubRepositories(context.Background())
+	assert.NoError(t, err)
+	got, err = projectRepo.GetProject(context.Background(), project.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, got.GitHubRepository) {
+		assert.Equal(t, "traQ", got.GitHubRepository.Name)
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/samber/lo"
 	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/external"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/repository/model"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
 	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
 	"gorm.io/gorm"
@@ -25,17 +28,28 @@ func NewProjectRepository(h *gorm.DB, portal external.PortalAPI) *ProjectReposit
 
 func (r *ProjectRepository) GetProjects(ctx context.Context, args *repository.GetProjectsArgs) ([]*domain.Project, error) {
 	limit := args.Limit.ValueOr(-1)
+	tx := r.h.WithContext(ctx).Limit(limit)
+	if !repository.IsMember(ctx) {
+		tx = wherePublic(tx, "projects")
+	}
+	if len(args.IDs) > 0 {
+		tx = tx.Where("`projects`.`id` IN ?", args.IDs)
+	}
+
 	projects := make([]*model.Project, 0)
-	err := r.h.WithContext(ctx).Limit(limit).Find(&projects).Error
+	err := tx.Find(&projects).Error
 	if err != nil {
 		return nil, err
 	}
+	lang := repository.LangFrom(ctx)
 	res := make([]*domain.Project, 0, len(projects))
 	for _, v := range projects {
 		p := &domain.Project{
-			ID:       v.ID,
-			Name:     v.Name,
-			Duration: domain.NewYearWithSemesterDuration(v.SinceYear, v.SinceSemester, v.UntilYear, v.UntilSemester),
+			ID:         v.ID,
+			Name:       lang.Pick(v.Name, v.NameEn),
+			Duration:   domain.NewYearWithSemesterDuration(v.SinceYear, v.SinceSemester, v.UntilYear, v.UntilSemester),
+			Visibility: v.Visibility,
+			Publish:    projectPublishState(v),
 		}
 		res = append(res, p)
 	}
@@ -43,56 +57,115 @@ func (r *ProjectRepository) GetProjects(ctx context.Context, args *repository.Ge
 }
 
 func (r *ProjectRepository) GetProject(ctx context.Context, projectID uuid.UUID) (*domain.ProjectDetail, error) {
-	project := new(model.Project)
-	if err := r.h.
-		WithContext(ctx).
-		Where(&model.Project{ID: projectID}).
-		First(project).
-		Error; err != nil {
+	project, err := r.getVisibleProject(ctx, projectID)
+	if err != nil {
 		return nil, err
 	}
 
-	members := make([]*model.ProjectMember, 0)
-	err := r.h.
-		WithContext(ctx).
-		Preload("User").
-		Where(model.ProjectMember{ProjectID: projectID}).
-		Find(&members).
-		Error
+	m, err := r.getProjectMembers(ctx, project)
 	if err != nil {
 		return nil, err
 	}
 
-	realNameMap, err := external.GetRealNameMap(r.portal)
+	gh, err := getProjectGitHubRepository(r.h.WithContext(ctx), project)
 	if err != nil {
 		return nil, err
 	}
 
-	m := make([]*domain.UserWithDuration, len(members))
-	for i, v := range members {
-		pm := domain.UserWithDuration{
-			User: *domain.NewUser(
-				v.User.ID,
-				v.User.Name,
-				realNameMap[v.User.Name],
-				v.User.Check,
-			),
-			Duration: domain.NewYearWithSemesterDuration(v.SinceYear, v.SinceSemester, v.UntilYear, v.UntilSemester),
-		}
-
-		m[i] = &pm
-	}
-
+	lang := repository.LangFrom(ctx)
 	res := &domain.ProjectDetail{
 		Project: domain.Project{
-			ID:       projectID,
-			Name:     project.Name,
-			Duration: domain.NewYearWithSemesterDuration(project.SinceYear, project.SinceSemester, project.UntilYear, project.UntilSemester),
+			ID:         projectID,
+			Name:       lang.Pick(project.Name, project.NameEn),
+			Duration:   domain.NewYearWithSemesterDuration(project.SinceYear, project.SinceSemester, project.UntilYear, project.UntilSemester),
+			Visibility: project.Visibility,
+			Publish:    projectPublishState(project),
 		},
-		Description: project.Description,
+		Description: lang.Pick(project.Description, project.DescriptionEn),
+		Body:        project.Body,
 		Link:        project.Link,
 		Members:     m,
+		Version:     project.Version,
+
+		GitHubRepository: gh,
+	}
+	return res, nil
+}
+
+func (r *ProjectRepository) GetProjectDetails(ctx context.Context, projectIDs []uuid.UUID) ([]*domain.ProjectDetail, error) {
+	if len(projectIDs) == 0 {
+		return []*domain.ProjectDetail{}, nil
+	}
+
+	projects := make([]*model.Project, 0, len(projectIDs))
+	err := r.h.
+		WithContext(ctx).
+		Where("`projects`.`id` IN (?)", projectIDs).
+		Find(&projects).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	isMember := repository.IsMember(ctx)
+	projects = lo.Filter(projects, func(v *model.Project, _ int) bool {
+		return projectVisibility(v).IsVisible(isMember)
+	})
+
+	// メンバーを閲覧できるプロジェクトのメンバーだけをまとめて取得する
+	showsMembers := lo.FilterMap(projects, func(v *model.Project, _ int) (uuid.UUID, bool) {
+		return v.ID, projectVisibility(v).ShowsMembers(isMember)
+	})
+	membersMap := make(map[uuid.UUID][]*domain.UserWithDuration, len(showsMembers))
+	if len(showsMembers) > 0 {
+		members := make([]*model.ProjectMember, 0)
+		err := r.h.
+			WithContext(ctx).
+			Preload("User").
+			Where("`project_members`.`project_id` IN (?)", showsMembers).
+			Find(&members).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		realNameMap, err := external.GetRealNameMap(r.portal, repository.LangFrom(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range members {
+			membersMap[v.ProjectID] = append(membersMap[v.ProjectID], &domain.UserWithDuration{
+				User:     *domain.NewUser(v.User.ID, v.User.Name, realNameMap[v.User.Name], v.User.Check),
+				Duration: domain.NewYearWithSemesterDuration(v.SinceYear, v.SinceSemester, v.UntilYear, v.UntilSemester),
+			})
+		}
+	}
+
+	lang := repository.LangFrom(ctx)
+	res := make([]*domain.ProjectDetail, len(projects))
+	for i, v := range projects {
+		m, ok := membersMap[v.ID]
+		if !ok {
+			m = []*domain.UserWithDuration{}
+		}
+
+		res[i] = &domain.ProjectDetail{
+			Project: domain.Project{
+				ID:         v.ID,
+				Name:       lang.Pick(v.Name, v.NameEn),
+				Duration:   domain.NewYearWithSemesterDuration(v.SinceYear, v.SinceSemester, v.UntilYear, v.UntilSemester),
+				Visibility: v.Visibility,
+				Publish:    projectPublishState(v),
+			},
+			Description: lang.Pick(v.Description, v.DescriptionEn),
+			Body:        v.Body,
+			Link:        v.Link,
+			Members:     m,
+			Version:     v.Version,
+		}
 	}
+
 	return res, nil
 }
 
@@ -100,11 +173,17 @@ func (r *ProjectRepository) CreateProject(ctx context.Context, args *repository.
 	p := model.Project{
 		ID:            random.UUID(),
 		Name:          args.Name,
+		NameEn:        args.NameEn.ValueOrZero(),
 		Description:   args.Description,
+		DescriptionEn: args.DescriptionEn.ValueOrZero(),
+		Body:          args.Body.ValueOrZero(),
 		SinceYear:     args.SinceYear,
 		SinceSemester: args.SinceSemester,
 		UntilYear:     args.UntilYear,
 		UntilSemester: args.UntilSemester,
+		Visibility:    args.Visibility.ValueOrZero(),
+		Draft:         args.Draft.ValueOrZero(),
+		PublishAt:     args.PublishAt,
 	}
 	p.Link = args.Link.ValueOr(p.Link)
 
@@ -120,18 +199,28 @@ func (r *ProjectRepository) CreateProject(ctx context.Context, args *repository.
 		return nil, err
 	}
 
-	err = r.h.WithContext(ctx).Create(&p).Error
+	err = r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&p).Error; err != nil {
+			return err
+		}
+
+		return recordProjectRevision(tx, p.ID)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	lang := repository.LangFrom(ctx)
 	res := &domain.ProjectDetail{
 		Project: domain.Project{
-			ID:       p.ID,
-			Name:     p.Name,
-			Duration: domain.NewYearWithSemesterDuration(p.SinceYear, p.SinceSemester, p.UntilYear, p.UntilSemester),
+			ID:         p.ID,
+			Name:       lang.Pick(p.Name, p.NameEn),
+			Duration:   domain.NewYearWithSemesterDuration(p.SinceYear, p.SinceSemester, p.UntilYear, p.UntilSemester),
+			Visibility: p.Visibility,
+			Publish:    projectPublishState(&p),
 		},
-		Description: p.Description,
+		Description: lang.Pick(p.Description, p.DescriptionEn),
+		Body:        p.Body,
 		Link:        p.Link,
 	}
 
@@ -143,9 +232,18 @@ func (r *ProjectRepository) UpdateProject(ctx context.Context, projectID uuid.UU
 	if v, ok := args.Name.V(); ok {
 		changes["name"] = v
 	}
+	if v, ok := args.NameEn.V(); ok {
+		changes["name_en"] = v
+	}
 	if v, ok := args.Description.V(); ok {
 		changes["description"] = v
 	}
+	if v, ok := args.DescriptionEn.V(); ok {
+		changes["description_en"] = v
+	}
+	if v, ok := args.Body.V(); ok {
+		changes["body"] = v
+	}
 	if v, ok := args.Link.V(); ok {
 		changes["link"] = v
 	}
@@ -161,17 +259,34 @@ func (r *ProjectRepository) UpdateProject(ctx context.Context, projectID uuid.UU
 			changes["until_semester"] = us
 		}
 	}
+	if v, ok := args.Visibility.V(); ok {
+		changes["visibility"] = v
+	}
 
 	if len(changes) == 0 {
 		return nil
 	}
 
-	err := r.h.
-		WithContext(ctx).
-		Model(&model.Project{}).
-		Where(&model.Project{ID: projectID}).
-		Updates(changes).
-		Error
+	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockVersion(ctx, tx, "projects", projectID); err != nil {
+			return err
+		}
+
+		// 編集履歴導入前のプロジェクトの場合は編集前の状態も記録する
+		if err := recordProjectRevision(tx, projectID); err != nil {
+			return err
+		}
+
+		if err := tx.
+			Model(&model.Project{}).
+			Where(&model.Project{ID: projectID}).
+			Updates(changes).
+			Error; err != nil {
+			return err
+		}
+
+		return recordProjectRevision(tx, projectID)
+	})
 	if err != nil {
 		return err
 	}
@@ -207,7 +322,42 @@ func (r *ProjectRepository) DeleteProject(ctx context.Context, projectID uuid.UU
 		if err != nil {
 			return err
 		}
-		return nil
+
+		return deleteRevisions(tx, domain.RevisionTargetProject, projectID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProjectRepository) PublishProject(ctx context.Context, projectID uuid.UUID, publishAt optional.Of[time.Time]) error {
+	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		project := new(model.Project)
+		err := tx.
+			WithContext(ctx).
+			Where(&model.Project{ID: projectID}).
+			First(project).
+			Error
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if projectPublishState(project).IsPublished(now) {
+			return fmt.Errorf("%w: project has already been published", repository.ErrAlreadyExists)
+		}
+
+		if err := tx.
+			WithContext(ctx).
+			Model(project).
+			Updates(publishChanges(publishAt, now)).
+			Error; err != nil {
+			return err
+		}
+
+		return bumpVersion(tx, "projects", projectID)
 	})
 	if err != nil {
 		return err
@@ -217,18 +367,50 @@ func (r *ProjectRepository) DeleteProject(ctx context.Context, projectID uuid.UU
 }
 
 func (r *ProjectRepository) GetProjectMembers(ctx context.Context, projectID uuid.UUID) ([]*domain.UserWithDuration, error) {
+	project, err := r.getVisibleProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.getProjectMembers(ctx, project)
+}
+
+// getVisibleProject 閲覧者が閲覧できないプロジェクトの場合はErrNotFoundを返す
+func (r *ProjectRepository) getVisibleProject(ctx context.Context, projectID uuid.UUID) (*model.Project, error) {
+	project := new(model.Project)
+	if err := r.h.
+		WithContext(ctx).
+		Where(&model.Project{ID: projectID}).
+		First(project).
+		Error; err != nil {
+		return nil, err
+	}
+
+	if !projectVisibility(project).IsVisible(repository.IsMember(ctx)) {
+		return nil, repository.ErrNotFound
+	}
+
+	return project, nil
+}
+
+// getProjectMembers 閲覧者がメンバーを閲覧できないプロジェクトの場合は空のスライスを返す
+func (r *ProjectRepository) getProjectMembers(ctx context.Context, project *model.Project) ([]*domain.UserWithDuration, error) {
+	if !projectVisibility(project).ShowsMembers(repository.IsMember(ctx)) {
+		return []*domain.UserWithDuration{}, nil
+	}
+
 	members := make([]*model.ProjectMember, 0)
 	err := r.h.
 		WithContext(ctx).
 		Preload("User").
-		Where(&model.ProjectMember{ProjectID: projectID}).
+		Where(&model.ProjectMember{ProjectID: project.ID}).
 		Find(&members).
 		Error
 	if err != nil {
 		return nil, err
 	}
 
-	realNameMap, err := external.GetRealNameMap(r.portal)
+	realNameMap, err := external.GetRealNameMap(r.portal, repository.LangFrom(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -274,26 +456,6 @@ func (r *ProjectRepository) EditProjectMembers(ctx context.Context, projectID uu
 		}
 	}
 
-	currentProjectMembers := make([]*model.ProjectMember, 0, len(projectMembers))
-	err = r.h.
-		WithContext(ctx).
-		Where(&model.ProjectMember{ProjectID: projectID}).
-		Find(&currentProjectMembers).
-		Error
-	if err != nil && err != repository.ErrNotFound {
-		return err
-	}
-
-	currentProjectMembersMap := make(map[uuid.UUID]*model.ProjectMember, len(projectMembers))
-	for _, v := range currentProjectMembers {
-		currentProjectMembersMap[v.UserID] = &model.ProjectMember{
-			SinceYear:     v.SinceYear,
-			SinceSemester: v.SinceSemester,
-			UntilYear:     v.UntilYear,
-			UntilSemester: v.UntilSemester,
-		}
-	}
-
 	members := make([]*model.ProjectMember, 0, len(projectMembers))
 	for _, v := range projectMembers {
 		m := &model.ProjectMember{
@@ -308,6 +470,35 @@ func (r *ProjectRepository) EditProjectMembers(ctx context.Context, projectID uu
 	}
 
 	err = r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockVersion(ctx, tx, "projects", projectID); err != nil {
+			return err
+		}
+
+		if err := recordProjectRevision(tx, projectID); err != nil {
+			return err
+		}
+
+		// 同時に編集されても上書きしないよう、プロジェクトをロックしてから現在のメンバーを取得する
+		currentProjectMembers := make([]*model.ProjectMember, 0, len(projectMembers))
+		err := tx.
+			WithContext(ctx).
+			Where(&model.ProjectMember{ProjectID: projectID}).
+			Find(&currentProjectMembers).
+			Error
+		if err != nil && err != repository.ErrNotFound {
+			return err
+		}
+
+		currentProjectMembersMap := make(map[uuid.UUID]*model.ProjectMember, len(projectMembers))
+		for _, v := range currentProjectMembers {
+			currentProjectMembersMap[v.UserID] = &model.ProjectMember{
+				SinceYear:     v.SinceYear,
+				SinceSemester: v.SinceSemester,
+				UntilYear:     v.UntilYear,
+				UntilSemester: v.UntilSemester,
+			}
+		}
+
 		for _, v := range members {
 			// 既に登録されていたら更新を試し、そうでなければ新規作成
 			if vdb, ok := currentProjectMembersMap[v.UserID]; ok {
@@ -355,7 +546,115 @@ func (r *ProjectRepository) EditProjectMembers(ctx context.Context, projectID uu
 				return err
 			}
 		}
-		return nil
+
+		return recordProjectRevision(tx, projectID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProjectRepository) GetProjectRevisions(ctx context.Context, projectID uuid.UUID) ([]*domain.Revision, error) {
+	if err := checkRevisionAccess(repository.IsMember(ctx)); err != nil {
+		return nil, err
+	}
+
+	tx := r.h.WithContext(ctx)
+	if err := tx.
+		Where(&model.Project{ID: projectID}).
+		First(&model.Project{}).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return getRevisions(tx, domain.RevisionTargetProject, projectID)
+}
+
+func (r *ProjectRepository) GetProjectRevisionDiff(ctx context.Context, projectID uuid.UUID, from int, to int) ([]*domain.RevisionFieldDiff, error) {
+	if err := checkRevisionAccess(repository.IsMember(ctx)); err != nil {
+		return nil, err
+	}
+
+	return getRevisionDiff(r.h.WithContext(ctx), domain.RevisionTargetProject, projectID, from, to)
+}
+
+func (r *ProjectRepository) RestoreProjectRevision(ctx context.Context, projectID uuid.UUID, revision int) error {
+	if err := checkRevisionAccess(repository.IsMember(ctx)); err != nil {
+		return err
+	}
+
+	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var s projectSnapshot
+		if err := getRevisionSnapshot(tx, domain.RevisionTargetProject, projectID, revision, &s); err != nil {
+			return err
+		}
+
+		// 復元後の名前が他のプロジェクトと重複しないか
+		err := tx.
+			Where(&model.Project{Name: s.Name}).
+			Where("`projects`.`id` <> ?", projectID).
+			First(&model.Project{}).
+			Error
+		if err == nil {
+			return repository.ErrAlreadyExists
+		} else if !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+
+		if err := recordProjectRevision(tx, projectID); err != nil {
+			return err
+		}
+
+		if err := bumpVersion(tx, "projects", projectID); err != nil {
+			return err
+		}
+
+		if err := tx.
+			Model(&model.Project{}).
+			Where(&model.Project{ID: projectID}).
+			Updates(map[string]interface{}{
+				"name":           s.Name,
+				"name_en":        s.NameEn,
+				"description":    s.Description,
+				"description_en": s.DescriptionEn,
+				"body":           s.Body,
+				"link":           s.Link,
+				"since_year":     s.SinceYear,
+				"since_semester": s.SinceSemester,
+				"until_year":     s.UntilYear,
+				"until_semester": s.UntilSemester,
+				"visibility":     s.Visibility,
+			}).
+			Error; err != nil {
+			return err
+		}
+
+		if err := tx.
+			Where(&model.ProjectMember{ProjectID: projectID}).
+			Delete(&model.ProjectMember{}).
+			Error; err != nil {
+			return err
+		}
+		if len(s.Members) > 0 {
+			members := make([]*model.ProjectMember, len(s.Members))
+			for i, m := range s.Members {
+				members[i] = &model.ProjectMember{
+					ProjectID:     projectID,
+					UserID:        m.UserID,
+					SinceYear:     m.SinceYear,
+					SinceSemester: m.SinceSemester,
+					UntilYear:     m.UntilYear,
+					UntilSemester: m.UntilSemester,
+				}
+			}
+			if err := tx.Create(&members).Error; err != nil {
+				return err
+			}
+		}
+
+		return recordProjectRevision(tx, projectID)
 	})
 	if err != nil {
 		return err
@@ -364,6 +663,33 @@ func (r *ProjectRepository) EditProjectMembers(ctx context.Context, projectID uu
 	return nil
 }
 
+// GetFeedProjects 公開されているプロジェクトを追加日時の新しい順に返す
+func (r *ProjectRepository) GetFeedProjects(ctx context.Context, limit int) ([]*domain.FeedProject, error) {
+	projects := make([]*model.Project, 0)
+	err := wherePublic(r.h.WithContext(ctx), "projects").
+		Order("`projects`.`created_at` DESC").
+		Limit(limit).
+		Find(&projects).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	lang := repository.LangFrom(ctx)
+	res := make([]*domain.FeedProject, len(projects))
+	for i, v := range projects {
+		res[i] = &domain.FeedProject{
+			ID:          v.ID,
+			Name:        lang.Pick(v.Name, v.NameEn),
+			Description: lang.Pick(v.Description, v.DescriptionEn),
+			CreatedAt:   v.CreatedAt,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	}
+
+	return res, nil
+}
+
 // Interface guards
 var (
 	_ repository.ProjectRepository = (*ProjectRepository)(nil)
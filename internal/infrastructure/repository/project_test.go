@@ -3,11 +3,14 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	urepository "github.com/traPtitech/traPortfolio/internal/usecases/repository"
 
+	"github.com/gofrs/uuid"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/external/mock_external_e2e"
@@ -60,6 +63,237 @@ func TestProjectRepository_GetProject(t *testing.T) {
 	}
 }
 
+func TestProjectRepository_GetProjectDetails(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	repo := NewProjectRepository(db, mock_external_e2e.NewMockPortalAPI())
+
+	projectNum := 4
+	var projects []*domain.ProjectDetail
+	for range projectNum {
+		projects = append(projects, mustMakeProjectDetail(t, repo, nil))
+	}
+
+	ids := lo.Map(projects, func(p *domain.ProjectDetail, _ int) uuid.UUID { return p.ID })
+	got, err := repo.GetProjectDetails(context.Background(), append(ids, random.UUID()))
+	assert.NoError(t, err)
+
+	opts := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b *domain.ProjectDetail) bool { return a.ID.String() < b.ID.String() }),
+		cmp.AllowUnexported(optional.Of[domain.YearWithSemester]{}),
+	}
+	if diff := cmp.Diff(projects, got, opts...); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestProjectRepository_Visibility(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	repo := NewProjectRepository(db, mock_external_e2e.NewMockPortalAPI())
+
+	projects := make(map[domain.Visibility]*domain.ProjectDetail)
+	for v := domain.VisibilityPublic; v < domain.VisibilityLimit; v++ {
+		args := random.CreateProjectArgs()
+		args.Visibility = optional.From(v)
+		projects[v] = mustMakeProjectDetail(t, repo, args)
+	}
+
+	t.Run("outsider", func(t *testing.T) {
+		ctx := context.Background()
+
+		got, err := repo.GetProjects(ctx, &urepository.GetProjectsArgs{})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []*domain.Project{&projects[domain.VisibilityPublic].Project, &projects[domain.VisibilityAnonymous].Project}, got)
+
+		_, err = repo.GetProject(ctx, projects[domain.VisibilityPrivate].ID)
+		assert.ErrorIs(t, err, urepository.ErrNotFound)
+
+		_, err = repo.GetProjectMembers(ctx, projects[domain.VisibilityPrivate].ID)
+		assert.ErrorIs(t, err, urepository.ErrNotFound)
+	})
+
+	t.Run("member", func(t *testing.T) {
+		ctx := urepository.WithMember(context.Background())
+
+		got, err := repo.GetProjects(ctx, &urepository.GetProjectsArgs{})
+		assert.NoError(t, err)
+		assert.Len(t, got, len(projects))
+
+		p, err := repo.GetProject(ctx, projects[domain.VisibilityPrivate].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.VisibilityPrivate, p.Visibility)
+	})
+}
+
+func TestProjectRepository_Draft(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	repo := NewProjectRepository(db, mock_external_e2e.NewMockPortalAPI())
+
+	published := mustMakeProjectDetail(t, repo, random.CreateProjectArgs())
+
+	draftArgs := random.CreateProjectArgs()
+	draftArgs.Draft = optional.From(true)
+	draft := mustMakeProjectDetail(t, repo, draftArgs)
+
+	scheduledArgs := random.CreateProjectArgs()
+	scheduledArgs.Draft = optional.From(true)
+	scheduledArgs.PublishAt = optional.From(time.Now().Add(-time.Minute))
+	scheduled := mustMakeProjectDetail(t, repo, scheduledArgs)
+
+	t.Run("outsider", func(t *testing.T) {
+		ctx := context.Background()
+
+		got, err := repo.GetProjects(ctx, &urepository.GetProjectsArgs{})
+		assert.NoError(t, err)
+		ids := lo.Map(got, func(p *domain.Project, _ int) uuid.UUID { return p.ID })
+		assert.ElementsMatch(t, []uuid.UUID{published.ID, scheduled.ID}, ids)
+
+		_, err = repo.GetProject(ctx, draft.ID)
+		assert.ErrorIs(t, err, urepository.ErrNotFound)
+	})
+
+	t.Run("member", func(t *testing.T) {
+		ctx := urepository.WithMember(context.Background())
+
+		p, err := repo.GetProject(ctx, draft.ID)
+		assert.NoError(t, err)
+		assert.True(t, p.Publish.Draft)
+	})
+
+	t.Run("publish", func(t *testing.T) {
+		ctx := context.Background()
+
+		err := repo.PublishProject(ctx, draft.ID, optional.Of[time.Time]{})
+		assert.NoError(t, err)
+
+		p, err := repo.GetProject(ctx, draft.ID)
+		assert.NoError(t, err)
+		assert.True(t, p.Publish.IsPublished(time.Now()))
+
+		err = repo.PublishProject(ctx, draft.ID, optional.Of[time.Time]{})
+		assert.ErrorIs(t, err, urepository.ErrAlreadyExists)
+	})
+}
+
+func TestProjectRepository_GetFeedProjects(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	repo := NewProjectRepository(db, mock_external_e2e.NewMockPortalAPI())
+
+	older := mustMakeProjectDetail(t, repo, random.CreateProjectArgs())
+
+	privateArgs := random.CreateProjectArgs()
+	privateArgs.Visibility = optional.From(domain.VisibilityPrivate)
+	mustMakeProjectDetail(t, repo, privateArgs)
+
+	draftArgs := random.CreateProjectArgs()
+	draftArgs.Draft = optional.From(true)
+	mustMakeProjectDetail(t, repo, draftArgs)
+
+	newer := mustMakeProjectDetail(t, repo, random.CreateProjectArgs())
+
+	// メンバーからのアクセスでも非公開や下書きのものは含めない
+	ctx := urepository.WithMember(context.Background())
+
+	got, err := repo.GetFeedProjects(ctx, 10)
+	assert.NoError(t, err)
+	ids := lo.Map(got, func(p *domain.FeedProject, _ int) uuid.UUID { return p.ID })
+	assert.Equal(t, []uuid.UUID{newer.ID, older.ID}, ids)
+	assert.Equal(t, newer.Name, got[0].Name)
+	assert.Equal(t, newer.Description, got[0].Description)
+	assert.False(t, got[0].CreatedAt.IsZero())
+
+	got, err = repo.GetFeedProjects(ctx, 1)
+	assert.NoError(t, err)
+	ids = lo.Map(got, func(p *domain.FeedProject, _ int) uuid.UUID { return p.ID })
+	assert.Equal(t, []uuid.UUID{newer.ID}, ids)
+}
+
+func TestProjectRepository_Revisions(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	repo := NewProjectRepository(db, mock_external_e2e.NewMockPortalAPI())
+
+	project := mustMakeProjectDetail(t, repo, random.CreateProjectArgs())
+	other := mustMakeProjectDetail(t, repo, random.CreateProjectArgs())
+	ctx := urepository.WithMember(context.Background())
+
+	newName := random.AlphaNumeric()
+	err := repo.UpdateProject(ctx, project.ID, &urepository.UpdateProjectArgs{Name: optional.From(newName)})
+	assert.NoError(t, err)
+
+	t.Run("outsider", func(t *testing.T) {
+		_, err := repo.GetProjectRevisions(context.Background(), project.ID)
+		assert.ErrorIs(t, err, urepository.ErrForbidden)
+	})
+
+	t.Run("revisions and diff", func(t *testing.T) {
+		revisions, err := repo.GetProjectRevisions(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, lo.Map(revisions, func(r *domain.Revision, _ int) int { return r.Number }))
+
+		diffs, err := repo.GetProjectRevisionDiff(ctx, project.ID, 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []*domain.RevisionFieldDiff{{Field: "name", From: project.Name, To: newName}}, diffs)
+
+		_, err = repo.GetProjectRevisionDiff(ctx, project.ID, 1, 3)
+		assert.ErrorIs(t, err, urepository.ErrNotFound)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		err := repo.RestoreProjectRevision(ctx, project.ID, 1)
+		assert.NoError(t, err)
+
+		got, err := repo.GetProject(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, project.Name, got.Name)
+
+		revisions, err := repo.GetProjectRevisions(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Len(t, revisions, 3)
+	})
+
+	t.Run("restore duplicated name", func(t *testing.T) {
+		err := repo.UpdateProject(ctx, other.ID, &urepository.UpdateProjectArgs{Name: optional.From(random.AlphaNumeric())})
+		assert.NoError(t, err)
+		err = repo.UpdateProject(ctx, project.ID, &urepository.UpdateProjectArgs{Name: optional.From(other.Name)})
+		assert.NoError(t, err)
+
+		err = repo.RestoreProjectRevision(ctx, other.ID, 1)
+		assert.ErrorIs(t, err, urepository.ErrAlreadyExists)
+	})
+}
+
+func TestProjectRepository_Lang(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	repo := NewProjectRepository(db, mock_external_e2e.NewMockPortalAPI())
+
+	args := random.CreateProjectArgs()
+	args.NameEn = optional.From(random.AlphaNumeric())
+	args.DescriptionEn = optional.From(random.AlphaNumeric())
+	project := mustMakeProjectDetail(t, repo, args)
+
+	ja, err := repo.GetProject(context.Background(), project.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, args.Name, ja.Name)
+	assert.Equal(t, args.Description, ja.Description)
+
+	en, err := repo.GetProject(urepository.WithLang(context.Background(), domain.LangEn), project.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, args.NameEn.ValueOrZero(), en.Name)
+	assert.Equal(t, args.DescriptionEn.ValueOrZero(), en.Description)
+}
+
 func TestProjectRepository_CreateProject(t *testing.T) {
 	t.Parallel()
 
@@ -136,7 +370,12 @@ func TestProjectRepository_UpdateProject(t *testing.T) {
 			mustMakeProjectDetail(t, repo, nil)
 
 			arg1 := tt.args
+			opts := []cmp.Option{
+				cmpopts.EquateEmpty(),
+				cmp.AllowUnexported(optional.Of[domain.YearWithSemester]{}),
+			}
 
+			before := *project1
 			project1.Name = arg1.Name.ValueOr(project1.Name)
 			project1.Description = arg1.Description.ValueOr(project1.Description)
 			project1.Link = arg1.Link.ValueOr(project1.Link)
@@ -155,16 +394,17 @@ func TestProjectRepository_UpdateProject(t *testing.T) {
 				}
 			}
 
+			// 変更がある場合だけ版番号が増える
+			if !cmp.Equal(&before, project1, opts...) {
+				project1.Version++
+			}
+
 			err := repo.UpdateProject(tt.ctx, project1.ID, arg1)
 			assert.NoError(t, err)
 
 			got, err := repo.GetProject(tt.ctx, project1.ID)
 			assert.NoError(t, err)
 
-			opts := []cmp.Option{
-				cmpopts.EquateEmpty(),
-				cmp.AllowUnexported(optional.Of[domain.YearWithSemester]{}),
-			}
 			if diff := cmp.Diff(project1, got, opts...); diff != "" {
 				t.Error(diff)
 			}
@@ -401,3 +641,56 @@ func TestProjectRepository_EditProjectMembers(t *testing.T) {
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, expected2, users2)
 }
+
+func TestProjectRepository_Version(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	err := mockdata.InsertSampleDataToDB(db)
+	assert.NoError(t, err)
+	repo := NewProjectRepository(db, mock_external_e2e.NewMockPortalAPI())
+
+	ctx := context.Background()
+	project := mustMakeProjectDetail(t, repo, nil)
+	assert.Equal(t, 0, project.Version)
+
+	// 編集前の版番号を指定すると編集できる
+	err = repo.UpdateProject(urepository.WithVersions(ctx, 0), project.ID, &urepository.UpdateProjectArgs{
+		Name: optional.From(random.AlphaNumeric()),
+	})
+	assert.NoError(t, err)
+
+	got, err := repo.GetProject(ctx, project.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.Version)
+
+	// 古い版番号を指定すると編集されない
+	err = repo.UpdateProject(urepository.WithVersions(ctx, 0), project.ID, &urepository.UpdateProjectArgs{
+		Name: optional.From(random.AlphaNumeric()),
+	})
+	assert.ErrorIs(t, err, urepository.ErrPreconditionFailed)
+
+	notUpdated, err := repo.GetProject(ctx, project.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, got.Name, notUpdated.Name)
+	assert.Equal(t, 1, notUpdated.Version)
+
+	// メンバーの編集でもプロジェクトの版番号が増える
+	err = repo.EditProjectMembers(urepository.WithVersions(ctx, 1), project.ID, []*urepository.EditProjectMemberArgs{
+		{
+			UserID:        mockdata.MockUsers[0].ID,
+			SinceYear:     project.Duration.Since.Year,
+			SinceSemester: project.Duration.Since.Semester,
+			UntilYear:     project.Duration.Until.ValueOrZero().Year,
+			UntilSemester: project.Duration.Until.ValueOrZero().Semester,
+		},
+	})
+	assert.NoError(t, err)
+
+	got, err = repo.GetProject(ctx, project.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, got.Version)
+
+	err = repo.EditProjectMembers(urepository.WithVersions(ctx, 1), project.ID, []*urepository.EditProjectMemberArgs{})
+	assert.ErrorIs(t, err, urepository.ErrPreconditionFailed)
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/repository/model"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"gorm.io/gorm"
+)
+
+func projectPublishState(p *model.Project) domain.PublishState {
+	return domain.PublishState{Draft: p.Draft, PublishAt: p.PublishAt}
+}
+
+func contestPublishState(c *model.Contest) domain.PublishState {
+	return domain.PublishState{Draft: c.Draft, PublishAt: c.PublishAt}
+}
+
+// projectVisibility 公開前のプロジェクトを外部に非公開として扱った公開範囲
+func projectVisibility(p *model.Project) domain.Visibility {
+	return projectPublishState(p).Restrict(p.Visibility, time.Now())
+}
+
+// contestVisibility 公開前のコンテストを外部に非公開として扱った公開範囲
+func contestVisibility(c *model.Contest) domain.Visibility {
+	return contestPublishState(c).Restrict(c.Visibility, time.Now())
+}
+
+// wherePublished tableのうち公開前の下書きを除外する
+// 公開予定日時を過ぎた下書きは公開済みとして扱う
+func wherePublished(tx *gorm.DB, table string) *gorm.DB {
+	return tx.Where(fmt.Sprintf("(`%[1]s`.`draft` = FALSE OR `%[1]s`.`publish_at` <= ?)", table), time.Now())
+}
+
+// wherePublic tableのうちメンバー以外にも公開されているもの(非公開でも公開前の下書きでもないもの)だけに絞る
+func wherePublic(tx *gorm.DB, table string) *gorm.DB {
+	tx = tx.Where(fmt.Sprintf("`%s`.`visibility` <> ?", table), domain.VisibilityPrivate)
+	return wherePublished(tx, table)
+}
+
+// publishChanges 下書きを公開する際の変更
+// publishAtが未来の日時であれば公開を予約し、そうでなければ即時に公開する
+func publishChanges(publishAt optional.Of[time.Time], now time.Time) map[string]interface{} {
+	if t, ok := publishAt.V(); ok && t.After(now) {
+		return map[string]interface{}{"draft": true, "publish_at": t}
+	}
+
+	return map[string]interface{}{"draft": false, "publish_at": now}
+}
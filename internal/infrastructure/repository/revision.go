@@ -0,0 +1,342 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/infrastructure/repository/model"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 編集履歴の版として保存する状態
+// 公開状態(下書き、公開予約)は編集履歴の対象外とする
+
+type projectSnapshot struct {
+	Name          string                  `json:"name"`
+	NameEn        string                  `json:"nameEn"`
+	Description   string                  `json:"description"`
+	DescriptionEn string                  `json:"descriptionEn"`
+	Body          string                  `json:"body"`
+	Link          string                  `json:"link"`
+	SinceYear     int                     `json:"sinceYear"`
+	SinceSemester int                     `json:"sinceSemester"`
+	UntilYear     int                     `json:"untilYear"`
+	UntilSemester int                     `json:"untilSemester"`
+	Visibility    domain.Visibility       `json:"visibility"`
+	Members       []projectMemberSnapshot `json:"members"`
+}
+
+type projectMemberSnapshot struct {
+	UserID        uuid.UUID `json:"userId"`
+	SinceYear     int       `json:"sinceYear"`
+	SinceSemester int       `json:"sinceSemester"`
+	UntilYear     int       `json:"untilYear"`
+	UntilSemester int       `json:"untilSemester"`
+}
+
+type contestSnapshot struct {
+	Name          string            `json:"name"`
+	NameEn        string            `json:"nameEn"`
+	Description   string            `json:"description"`
+	DescriptionEn string            `json:"descriptionEn"`
+	Body          string            `json:"body"`
+	Link          string            `json:"link"`
+	Since         time.Time         `json:"since"`
+	Until         time.Time         `json:"until"`
+	Visibility    domain.Visibility `json:"visibility"`
+}
+
+type contestTeamSnapshot struct {
+	Name          string            `json:"name"`
+	NameEn        string            `json:"nameEn"`
+	Description   string            `json:"description"`
+	DescriptionEn string            `json:"descriptionEn"`
+	Body          string            `json:"body"`
+	Result        string            `json:"result"`
+	Link          string            `json:"link"`
+	Visibility    domain.Visibility `json:"visibility"`
+	Members       []uuid.UUID       `json:"members"`
+}
+
+func loadProjectSnapshot(tx *gorm.DB, projectID uuid.UUID) (*projectSnapshot, error) {
+	p := new(model.Project)
+	if err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(&model.Project{ID: projectID}).
+		First(p).
+		Error; err != nil {
+		return nil, err
+	}
+
+	members := make([]*model.ProjectMember, 0)
+	if err := tx.
+		Where(&model.ProjectMember{ProjectID: projectID}).
+		Order("`project_members`.`user_id`").
+		Find(&members).
+		Error; err != nil {
+		return nil, err
+	}
+
+	s := &projectSnapshot{
+		Name:          p.Name,
+		NameEn:        p.NameEn,
+		Description:   p.Description,
+		DescriptionEn: p.DescriptionEn,
+		Body:          p.Body,
+		Link:          p.Link,
+		SinceYear:     p.SinceYear,
+		SinceSemester: p.SinceSemester,
+		UntilYear:     p.UntilYear,
+		UntilSemester: p.UntilSemester,
+		Visibility:    p.Visibility,
+		Members:       make([]projectMemberSnapshot, len(members)),
+	}
+	for i, m := range members {
+		s.Members[i] = projectMemberSnapshot{
+			UserID:        m.UserID,
+			SinceYear:     m.SinceYear,
+			SinceSemester: m.SinceSemester,
+			UntilYear:     m.UntilYear,
+			UntilSemester: m.UntilSemester,
+		}
+	}
+
+	return s, nil
+}
+
+func loadContestSnapshot(tx *gorm.DB, contestID uuid.UUID) (*contestSnapshot, error) {
+	c := new(model.Contest)
+	if err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(&model.Contest{ID: contestID}).
+		First(c).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return &contestSnapshot{
+		Name:          c.Name,
+		NameEn:        c.NameEn,
+		Description:   c.Description,
+		DescriptionEn: c.DescriptionEn,
+		Body:          c.Body,
+		Link:          c.Link,
+		Since:         c.Since.UTC(),
+		Until:         c.Until.UTC(),
+		Visibility:    c.Visibility,
+	}, nil
+}
+
+func loadContestTeamSnapshot(tx *gorm.DB, teamID uuid.UUID) (*contestTeamSnapshot, error) {
+	t := new(model.ContestTeam)
+	if err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(&model.ContestTeam{ID: teamID}).
+		First(t).
+		Error; err != nil {
+		return nil, err
+	}
+
+	belongings := make([]*model.ContestTeamUserBelonging, 0)
+	if err := tx.
+		Where(&model.ContestTeamUserBelonging{TeamID: teamID}).
+		Order("`contest_team_user_belongings`.`user_id`").
+		Find(&belongings).
+		Error; err != nil {
+		return nil, err
+	}
+
+	s := &contestTeamSnapshot{
+		Name:          t.Name,
+		NameEn:        t.NameEn,
+		Description:   t.Description,
+		DescriptionEn: t.DescriptionEn,
+		Body:          t.Body,
+		Result:        t.Result,
+		Link:          t.Link,
+		Visibility:    t.Visibility,
+		Members:       make([]uuid.UUID, len(belongings)),
+	}
+	for i, b := range belongings {
+		s.Members[i] = b.UserID
+	}
+
+	return s, nil
+}
+
+func recordProjectRevision(tx *gorm.DB, projectID uuid.UUID) error {
+	s, err := loadProjectSnapshot(tx, projectID)
+	if err != nil {
+		return err
+	}
+
+	return saveRevision(tx, domain.RevisionTargetProject, projectID, s)
+}
+
+func recordContestRevision(tx *gorm.DB, contestID uuid.UUID) error {
+	s, err := loadContestSnapshot(tx, contestID)
+	if err != nil {
+		return err
+	}
+
+	return saveRevision(tx, domain.RevisionTargetContest, contestID, s)
+}
+
+func recordContestTeamRevision(tx *gorm.DB, teamID uuid.UUID) error {
+	s, err := loadContestTeamSnapshot(tx, teamID)
+	if err != nil {
+		return err
+	}
+
+	return saveRevision(tx, domain.RevisionTargetContestTeam, teamID, s)
+}
+
+// whereRevisionTarget TargetTypeのゼロ値も条件に含めるため構造体ではなく文字列で条件を指定する
+func whereRevisionTarget(tx *gorm.DB, target domain.RevisionTarget, targetID uuid.UUID) *gorm.DB {
+	return tx.Where("`revisions`.`target_type` = ? AND `revisions`.`target_id` = ?", target, targetID)
+}
+
+// saveRevision 現在の状態を新しい版として記録する
+// 最新の版と同じ状態の場合は記録しないため、編集前に呼ぶことで編集履歴導入前のデータの状態も記録できる
+// 同時に編集されても版番号が重複しないよう、load*Snapshotで対象の行をロックしてから呼ぶ
+// 最新の版もロックして読み、トランザクション開始後に他の編集で追加された版を見落とさないようにする
+func saveRevision(tx *gorm.DB, target domain.RevisionTarget, targetID uuid.UUID, snapshot interface{}) error {
+	b, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	latest := make([]*model.Revision, 0, 1)
+	if err := whereRevisionTarget(tx.Clauses(clause.Locking{Strength: "UPDATE"}), target, targetID).
+		Order("`revisions`.`number` DESC").
+		Limit(1).
+		Find(&latest).
+		Error; err != nil {
+		return err
+	}
+
+	number := 1
+	if len(latest) > 0 {
+		if latest[0].Snapshot == string(b) {
+			return nil
+		}
+		number = latest[0].Number + 1
+	}
+
+	return tx.Create(&model.Revision{
+		TargetType: target,
+		TargetID:   targetID,
+		Number:     number,
+		Snapshot:   string(b),
+	}).Error
+}
+
+func deleteRevisions(tx *gorm.DB, target domain.RevisionTarget, targetIDs ...uuid.UUID) error {
+	if len(targetIDs) == 0 {
+		return nil
+	}
+
+	return tx.
+		Where("`revisions`.`target_type` = ? AND `revisions`.`target_id` IN (?)", target, targetIDs).
+		Delete(&model.Revision{}).
+		Error
+}
+
+func getRevisions(tx *gorm.DB, target domain.RevisionTarget, targetID uuid.UUID) ([]*domain.Revision, error) {
+	revisions := make([]*model.Revision, 0)
+	if err := whereRevisionTarget(tx, target, targetID).
+		Order("`revisions`.`number`").
+		Find(&revisions).
+		Error; err != nil {
+		return nil, err
+	}
+
+	res := make([]*domain.Revision, len(revisions))
+	for i, v := range revisions {
+		res[i] = &domain.Revision{
+			Number:    v.Number,
+			CreatedAt: v.CreatedAt,
+		}
+	}
+
+	return res, nil
+}
+
+func getRevision(tx *gorm.DB, target domain.RevisionTarget, targetID uuid.UUID, number int) (*model.Revision, error) {
+	revision := new(model.Revision)
+	if err := whereRevisionTarget(tx, target, targetID).
+		Where("`revisions`.`number` = ?", number).
+		First(revision).
+		Error; err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
+
+// getRevisionSnapshot 版の状態を読み出す
+func getRevisionSnapshot(tx *gorm.DB, target domain.RevisionTarget, targetID uuid.UUID, number int, snapshot interface{}) error {
+	revision, err := getRevision(tx, target, targetID, number)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal([]byte(revision.Snapshot), snapshot)
+}
+
+func getRevisionDiff(tx *gorm.DB, target domain.RevisionTarget, targetID uuid.UUID, from int, to int) ([]*domain.RevisionFieldDiff, error) {
+	fromRevision, err := getRevision(tx, target, targetID, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := getRevision(tx, target, targetID, to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromFields, err := snapshotFields(fromRevision.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := snapshotFields(toRevision.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DiffRevisionFields(fromFields, toFields), nil
+}
+
+// snapshotFields 版の状態をフィールド名と値の組に変換する
+// 文字列以外の値はJSONとして表現する
+func snapshotFields(snapshot string) (map[string]string, error) {
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(snapshot), &raw); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string, len(raw))
+	for k, v := range raw {
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			fields[k] = s
+		} else {
+			fields[k] = string(v)
+		}
+	}
+
+	return fields, nil
+}
+
+// checkRevisionAccess 編集履歴はメンバーのみが閲覧、復元できる
+func checkRevisionAccess(isMember bool) error {
+	if !isMember {
+		return fmt.Errorf("%w: revisions are only available to members", repository.ErrForbidden)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+// syncEach itemsを順に外部サービスと同期する
+// 一部の項目で外部サービスからの取得に失敗しても(fetchErr)残りの同期は続け、失敗はまとめて返す
+// DBへの書き込みなどのそれ以外のエラー(err)やctxのキャンセルでは直ちに中断する
+func syncEach[T any](ctx context.Context, items []T, sync func(item T) (fetchErr error, err error)) error {
+	errs := make([]error, 0)
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		fetchErr, err := sync(item)
+		if err != nil {
+			return err
+		}
+		if fetchErr != nil {
+			errs = append(errs, fetchErr)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -36,8 +36,12 @@ func (r *UserRepository) GetUsers(ctx context.Context, args *repository.GetUsers
 	name, nok := args.Name.V()
 	if iok && nok {
 		return nil, fmt.Errorf("%w: you must not specify both includeSuspended and name", repository.ErrInvalidArg)
+	} else if len(args.IDs) > 0 && (iok || nok) {
+		return nil, fmt.Errorf("%w: you must not specify ids with includeSuspended or name", repository.ErrInvalidArg)
 	} else if nok {
 		tx = tx.Where(&model.User{Name: name})
+	} else if len(args.IDs) > 0 {
+		tx = tx.Where("`users`.`id` IN ?", args.IDs)
 	} else if !(iok && includeSuspended) {
 		tx = tx.Where(&model.User{State: domain.TraqStateActive})
 	}
@@ -59,12 +63,12 @@ func (r *UserRepository) GetUsers(ctx context.Context, args *repository.GetUsers
 			domain.NewUser(
 				users[0].ID,
 				users[0].Name,
-				portalUser.RealName,
+				portalUser.RealNameIn(repository.LangFrom(ctx)),
 				users[0].Check,
 			),
 		}, nil
 	} else {
-		realNameMap, err := external.GetRealNameMap(r.portal)
+		realNameMap, err := external.GetRealNameMap(r.portal, repository.LangFrom(ctx))
 		if err != nil {
 			return nil, err
 		}
@@ -101,11 +105,17 @@ func (r *UserRepository) SyncUsers(ctx context.Context) error {
 		}, true
 	})
 
+	// 名前か状態が変わった場合のみ版番号を増やす
+	// MySQLは代入を順に評価するため、名前と状態を更新する前に比べる
+	bump := clause.Assignment{
+		Column: clause.Column{Name: "version"},
+		Value:  gorm.Expr("IF(`name` <> VALUES(`name`) OR `state` <> VALUES(`state`), `version` + 1, `version`)"),
+	}
 	err = r.h.
 		WithContext(ctx).
 		Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"name", "state", "updated_at"}),
+			DoUpdates: append(clause.Set{bump}, clause.AssignmentColumns([]string{"name", "state", "updated_at"})...),
 		}).
 		Create(&users).
 		Error
@@ -128,34 +138,156 @@ func (r *UserRepository) GetUser(ctx context.Context, userID uuid.UUID) (*domain
 		return nil, err
 	}
 
+	featured, err := r.getFeaturedItems(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	portalUser, err := r.portal.GetUserByTraqID(user.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	lang := repository.LangFrom(ctx)
+	result := newUserDetail(lang, user, portalUser.RealNameIn(lang))
+	result.Featured = featured
+
+	return result, nil
+}
+
+func (r *UserRepository) GetUserDetails(ctx context.Context, userIDs []uuid.UUID) ([]*domain.UserDetail, error) {
+	if len(userIDs) == 0 {
+		return []*domain.UserDetail{}, nil
+	}
+
+	users := make([]*model.User, 0, len(userIDs))
+	err := r.h.
+		WithContext(ctx).
+		Preload("Accounts").
+		Where("`users`.`id` IN (?)", userIDs).
+		Find(&users).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	realNameMap, err := external.GetRealNameMap(r.portal, repository.LangFrom(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	lang := repository.LangFrom(ctx)
+	result := make([]*domain.UserDetail, len(users))
+	for i, v := range users {
+		result[i] = newUserDetail(lang, v, realNameMap[v.Name])
+	}
+
+	return result, nil
+}
+
+// newUserDetail Accountsをpreloadしたユーザーから、固定表示する項目以外の詳細情報を作る
+func newUserDetail(lang domain.Lang, user *model.User, realName string) *domain.UserDetail {
 	accounts := make([]*domain.Account, 0, len(user.Accounts))
 	for _, v := range user.Accounts {
 		accounts = append(accounts, &domain.Account{
 			ID:          v.ID,
 			DisplayName: v.Name,
 			Type:        domain.AccountType(v.Type),
+			Handle:      v.Handle,
 			URL:         v.URL,
 		})
 	}
 
-	portalUser, err := r.portal.GetUserByTraqID(user.Name)
+	return &domain.UserDetail{
+		User:     *domain.NewUser(user.ID, user.Name, realName, user.Check),
+		State:    user.State,
+		Bio:      lang.Pick(user.Description, user.DescriptionEn),
+		Accounts: accounts,
+		Version:  user.Version,
+	}
+}
+
+// getFeaturedItems 固定表示するプロジェクトやコンテストへの参加を表示順に取得する
+// 固定表示した後に所属から外れたものや、閲覧者にメンバーを伏せているものは除外する
+func (r *UserRepository) getFeaturedItems(ctx context.Context, userID uuid.UUID) ([]*domain.UserFeaturedItem, error) {
+	items := make([]*model.UserFeaturedItem, 0)
+	err := r.h.
+		WithContext(ctx).
+		Where(&model.UserFeaturedItem{UserID: userID}).
+		Order("position").
+		Find(&items).
+		Error
 	if err != nil {
 		return nil, err
 	}
 
-	result := domain.UserDetail{
-		User: *domain.NewUser(
-			user.ID,
-			user.Name,
-			portalUser.RealName,
-			user.Check,
-		),
-		State:    user.State,
-		Bio:      user.Description,
-		Accounts: accounts,
+	if len(items) == 0 {
+		return []*domain.UserFeaturedItem{}, nil
 	}
 
-	return &result, nil
+	projectMembers := make([]*model.ProjectMember, 0)
+	err = r.h.
+		WithContext(ctx).
+		Preload("Project").
+		Where(&model.ProjectMember{UserID: userID}).
+		Find(&projectMembers).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make(map[uuid.UUID]*model.Project, len(projectMembers))
+	for _, v := range projectMembers {
+		projects[v.ProjectID] = &v.Project
+	}
+
+	belongings := make([]*model.ContestTeamUserBelonging, 0)
+	err = r.h.
+		WithContext(ctx).
+		Preload("ContestTeam.Contest").
+		Where(&model.ContestTeamUserBelonging{UserID: userID}).
+		Find(&belongings).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make(map[uuid.UUID]*model.ContestTeam, len(belongings))
+	for _, v := range belongings {
+		teams[v.TeamID] = &v.ContestTeam
+	}
+
+	isMember := repository.IsMember(ctx)
+	lang := repository.LangFrom(ctx)
+	result := make([]*domain.UserFeaturedItem, 0, len(items))
+	for _, v := range items {
+		switch v.Type {
+		case domain.FeaturedItemProject:
+			p, ok := projects[v.ItemID]
+			if !ok || !projectVisibility(p).ShowsMembers(isMember) {
+				continue
+			}
+			result = append(result, &domain.UserFeaturedItem{
+				Type: v.Type,
+				ID:   p.ID,
+				Name: lang.Pick(p.Name, p.NameEn),
+			})
+		case domain.FeaturedItemContestTeam:
+			ct, ok := teams[v.ItemID]
+			if !ok || !contestVisibility(&ct.Contest).Restrict(ct.Visibility).ShowsMembers(isMember) {
+				continue
+			}
+			result = append(result, &domain.UserFeaturedItem{
+				Type:      v.Type,
+				ID:        ct.ID,
+				Name:      lang.Pick(ct.Contest.Name, ct.Contest.NameEn),
+				ContestID: ct.ContestID,
+				TeamName:  lang.Pick(ct.Name, ct.NameEn),
+			})
+		}
+	}
+
+	return result, nil
 }
 
 func (r *UserRepository) UpdateUser(ctx context.Context, userID uuid.UUID, args *repository.UpdateUserArgs) error {
@@ -163,6 +295,9 @@ func (r *UserRepository) UpdateUser(ctx context.Context, userID uuid.UUID, args
 	if v, ok := args.Description.V(); ok {
 		changes["description"] = v
 	}
+	if v, ok := args.DescriptionEn.V(); ok {
+		changes["description_en"] = v
+	}
 	if v, ok := args.Check.V(); ok {
 		changes["check"] = v
 	}
@@ -172,16 +307,11 @@ func (r *UserRepository) UpdateUser(ctx context.Context, userID uuid.UUID, args
 	}
 
 	err := r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		user := new(model.User)
-		err := tx.
-			WithContext(ctx).Where(&model.User{ID: userID}).
-			First(user).
-			Error
-		if err != nil {
+		if err := lockVersion(ctx, tx, "users", userID); err != nil {
 			return err
 		}
 
-		err = tx.WithContext(ctx).Model(user).Updates(changes).Error
+		err := tx.WithContext(ctx).Model(&model.User{ID: userID}).Updates(changes).Error
 		if err != nil {
 			return err
 		}
@@ -220,6 +350,7 @@ func (r *UserRepository) GetAccounts(ctx context.Context, userID uuid.UUID) ([]*
 			ID:          v.ID,
 			Type:        domain.AccountType(v.Type),
 			DisplayName: v.Name,
+			Handle:      v.Handle,
 			URL:         v.URL,
 		})
 	}
@@ -241,6 +372,7 @@ func (r *UserRepository) GetAccount(ctx context.Context, userID uuid.UUID, accou
 		ID:          account.ID,
 		Type:        domain.AccountType(account.Type),
 		DisplayName: account.Name,
+		Handle:      account.Handle,
 		URL:         account.URL,
 	}
 
@@ -248,8 +380,9 @@ func (r *UserRepository) GetAccount(ctx context.Context, userID uuid.UUID, accou
 }
 
 func (r *UserRepository) CreateAccount(ctx context.Context, userID uuid.UUID, args *repository.CreateAccountArgs) (*domain.Account, error) {
-	if !domain.IsValidAccountURL(args.Type, args.URL) {
-		return nil, repository.ErrInvalidArg
+	handle, url, err := domain.NormalizeAccountURL(args.Type, args.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", repository.ErrInvalidArg, err)
 	}
 
 	if err := r.h.
@@ -265,10 +398,17 @@ func (r *UserRepository) CreateAccount(ctx context.Context, userID uuid.UUID, ar
 		ID:     uuid.Must(uuid.NewV4()),
 		Type:   uint8(args.Type),
 		Name:   args.DisplayName,
-		URL:    args.URL,
+		Handle: handle,
+		URL:    url,
 		UserID: userID,
 	}
-	err := r.h.WithContext(ctx).Create(&account).Error
+	err = r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&account).Error; err != nil {
+			return err
+		}
+
+		return bumpVersion(tx, "users", userID)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -286,6 +426,7 @@ func (r *UserRepository) CreateAccount(ctx context.Context, userID uuid.UUID, ar
 		ID:          ver.ID,
 		DisplayName: ver.Name,
 		Type:        domain.AccountType(ver.Type),
+		Handle:      ver.Handle,
 		URL:         ver.URL,
 	}, nil
 }
@@ -295,14 +436,13 @@ func (r *UserRepository) UpdateAccount(ctx context.Context, userID uuid.UUID, ac
 	if v, ok := args.DisplayName.V(); ok {
 		changes["name"] = v
 	}
-	if v, ok := args.URL.V(); ok {
-		changes["url"] = v
-	}
 	if v, ok := args.Type.V(); ok {
 		changes["type"] = v
 	}
+	// URLは正規化してから変更する
+	_, urlChanged := args.URL.V()
 
-	if len(changes) == 0 {
+	if len(changes) == 0 && !urlChanged {
 		return nil
 	}
 
@@ -330,20 +470,27 @@ func (r *UserRepository) UpdateAccount(ctx context.Context, userID uuid.UUID, ac
 			}
 		}
 
-		// URLのvalidation
-		tv, tok := args.Type.V()
-		uv, uok := args.URL.V()
-		if tok && uok {
-			if !domain.IsValidAccountURL(domain.AccountType(tv), uv) {
-				return repository.ErrInvalidArg
+		// 種類かURLが変わる場合はURLを正規化し直す
+		if aok || urlChanged {
+			handle, url, err := domain.NormalizeAccountURL(
+				args.Type.ValueOr(domain.AccountType(account.Type)),
+				args.URL.ValueOr(account.URL),
+			)
+			if err != nil {
+				return fmt.Errorf("%w: %w", repository.ErrInvalidArg, err)
 			}
-		} else if !tok && uok {
-			if !domain.IsValidAccountURL(domain.AccountType(account.Type), uv) {
-				return repository.ErrInvalidArg
-			}
-		} else if tok && !uok {
-			if !domain.IsValidAccountURL(tv, account.URL) {
-				return repository.ErrInvalidArg
+			changes["handle"] = handle
+			changes["url"] = url
+
+			// 別のアカウントの成績になるため、これまでに取得した成績は削除する
+			if handle != account.Handle || uint8(args.Type.ValueOr(domain.AccountType(account.Type))) != account.Type {
+				if err := tx.
+					WithContext(ctx).
+					Where(&model.AccountStats{AccountID: accountID}).
+					Delete(&model.AccountStats{}).
+					Error; err != nil {
+					return err
+				}
 			}
 		}
 
@@ -351,7 +498,8 @@ func (r *UserRepository) UpdateAccount(ctx context.Context, userID uuid.UUID, ac
 		if err != nil {
 			return err
 		}
-		return nil
+
+		return bumpVersion(tx, "users", userID)
 	})
 	return err
 }
@@ -372,7 +520,7 @@ func (r *UserRepository) DeleteAccount(ctx context.Context, userID uuid.UUID, ac
 			return err
 		}
 
-		return nil
+		return bumpVersion(tx, "users", userID)
 	}); err != nil {
 		return err
 	}
@@ -393,20 +541,27 @@ func (r *UserRepository) GetProjects(ctx context.Context, userID uuid.UUID) ([]*
 	projects := make([]*model.ProjectMember, 0)
 	err = r.h.
 		WithContext(ctx).
-		Preload("Project").
+		Joins("Project").
 		Where(&model.ProjectMember{UserID: userID}).
+		Order("`Project`.`since_year` DESC, `Project`.`since_semester` DESC, `Project`.`id`").
 		Find(&projects).
 		Error
 	if err != nil {
 		return nil, err
 	}
 
+	isMember := repository.IsMember(ctx)
+	lang := repository.LangFrom(ctx)
 	res := make([]*domain.UserProject, 0, len(projects))
 	for _, v := range projects {
 		p := v.Project
+		// メンバーを伏せているプロジェクトも所属が分かってしまうため除外する
+		if !projectVisibility(&p).ShowsMembers(isMember) {
+			continue
+		}
 		res = append(res, &domain.UserProject{
 			ID:           v.Project.ID,
-			Name:         v.Project.Name,
+			Name:         lang.Pick(p.Name, p.NameEn),
 			Duration:     domain.NewYearWithSemesterDuration(p.SinceYear, p.SinceSemester, p.UntilYear, p.UntilSemester),
 			UserDuration: domain.NewYearWithSemesterDuration(v.SinceYear, v.SinceSemester, v.UntilYear, v.UntilSemester),
 		})
@@ -435,12 +590,13 @@ func (r *UserRepository) GetGroupsByUserID(ctx context.Context, userID uuid.UUID
 		return nil, err
 	}
 
+	lang := repository.LangFrom(ctx)
 	result := make([]*domain.UserGroup, 0, len(groups))
 	for _, v := range groups {
 		gr := v.Group
 		result = append(result, &domain.UserGroup{
 			ID:   gr.GroupID,
-			Name: gr.Name,
+			Name: lang.Pick(gr.Name, gr.NameEn),
 			Duration: domain.NewYearWithSemesterDuration(
 				v.SinceYear,
 				v.SinceSemester,
@@ -466,20 +622,33 @@ func (r *UserRepository) GetContests(ctx context.Context, userID uuid.UUID) ([]*
 	err = r.h.
 		WithContext(ctx).
 		Preload("ContestTeam.Contest").
+		Joins("JOIN `contest_teams` ON `contest_teams`.`id` = `contest_team_user_belongings`.`team_id`").
+		Joins("JOIN `contests` ON `contests`.`id` = `contest_teams`.`contest_id`").
 		Where(&model.ContestTeamUserBelonging{UserID: userID}).
+		Order("`contests`.`since` DESC, `contests`.`id`, `contest_teams`.`created_at`").
 		Find(&contestTeamUserBelongings).
 		Error
 	if err != nil {
 		return nil, err
 	}
 
+	// メンバーを伏せているコンテストチームも所属が分かってしまうため除外する
+	isMember := repository.IsMember(ctx)
+	contestTeamUserBelongings = lo.Filter(contestTeamUserBelongings, func(v *model.ContestTeamUserBelonging, _ int) bool {
+		ct := v.ContestTeam
+		return contestVisibility(&ct.Contest).Restrict(ct.Visibility).ShowsMembers(isMember)
+	})
+
+	lang := repository.LangFrom(ctx)
 	contestsMap := make(map[uuid.UUID]*domain.UserContest)
+	contestIDs := make([]uuid.UUID, 0, len(contestTeamUserBelongings))
 	for _, v := range contestTeamUserBelongings {
 		ct := v.ContestTeam
 		if _, ok := contestsMap[ct.ContestID]; !ok {
+			contestIDs = append(contestIDs, ct.ContestID)
 			contestsMap[ct.ContestID] = &domain.UserContest{
 				ID:        ct.Contest.ID,
-				Name:      ct.Contest.Name,
+				Name:      lang.Pick(ct.Contest.Name, ct.Contest.NameEn),
 				TimeStart: ct.Contest.Since,
 				TimeEnd:   ct.Contest.Until,
 				Teams:     []*domain.ContestTeamWithoutMembers{},
@@ -491,22 +660,120 @@ func (r *UserRepository) GetContests(ctx context.Context, userID uuid.UUID) ([]*
 		if userID == v.UserID {
 			ct := v.ContestTeam
 			contestsMap[ct.ContestID].Teams = append(contestsMap[ct.ContestID].Teams, &domain.ContestTeamWithoutMembers{
-				ID:        ct.ID,
-				ContestID: ct.ContestID,
-				Name:      ct.Name,
-				Result:    ct.Result,
+				ID:         ct.ID,
+				ContestID:  ct.ContestID,
+				Name:       lang.Pick(ct.Name, ct.NameEn),
+				Result:     ct.Result,
+				Visibility: ct.Visibility,
 			})
 		}
 	}
 
-	res := make([]*domain.UserContest, 0, len(contestTeamUserBelongings))
-	for _, v := range contestsMap {
-		res = append(res, v)
+	res := make([]*domain.UserContest, 0, len(contestIDs))
+	for _, id := range contestIDs {
+		res = append(res, contestsMap[id])
 	}
 
 	return res, nil
 }
 
+func (r *UserRepository) EditUserFeaturedItems(ctx context.Context, userID uuid.UUID, args []*repository.EditUserFeaturedItemArgs) error {
+	if len(args) > domain.UserFeaturedItemsLimit {
+		return fmt.Errorf("%w: too many featured items(max: %d)", repository.ErrInvalidArg, domain.UserFeaturedItemsLimit)
+	}
+
+	err := r.h.
+		WithContext(ctx).
+		Where(&model.User{ID: userID}).
+		First(&model.User{}).
+		Error
+	if err != nil {
+		return err
+	}
+
+	projectIDs := make([]uuid.UUID, 0, len(args))
+	teamIDs := make([]uuid.UUID, 0, len(args))
+	items := make([]*model.UserFeaturedItem, 0, len(args))
+	for i, v := range args {
+		switch v.Type {
+		case domain.FeaturedItemProject:
+			projectIDs = append(projectIDs, v.ID)
+		case domain.FeaturedItemContestTeam:
+			teamIDs = append(teamIDs, v.ID)
+		default:
+			return fmt.Errorf("%w: invalid featured item type(%d)", repository.ErrInvalidArg, v.Type)
+		}
+		items = append(items, &model.UserFeaturedItem{
+			UserID:   userID,
+			Type:     v.Type,
+			ItemID:   v.ID,
+			Position: i,
+		})
+	}
+
+	if len(lo.Uniq(projectIDs)) != len(projectIDs) || len(lo.Uniq(teamIDs)) != len(teamIDs) {
+		return fmt.Errorf("%w: duplicated featured items", repository.ErrInvalidArg)
+	}
+
+	// 所属していないプロジェクトやコンテストチームは固定表示できない
+	if len(projectIDs) > 0 {
+		var count int64
+		err := r.h.
+			WithContext(ctx).
+			Model(&model.ProjectMember{}).
+			Where("`project_members`.`user_id` = ? AND `project_members`.`project_id` IN (?)", userID, projectIDs).
+			Count(&count).
+			Error
+		if err != nil {
+			return err
+		}
+		if int(count) != len(projectIDs) {
+			return fmt.Errorf("%w: user does not belong to some projects", repository.ErrInvalidArg)
+		}
+	}
+	if len(teamIDs) > 0 {
+		var count int64
+		err := r.h.
+			WithContext(ctx).
+			Model(&model.ContestTeamUserBelonging{}).
+			Where("`contest_team_user_belongings`.`user_id` = ? AND `contest_team_user_belongings`.`team_id` IN (?)", userID, teamIDs).
+			Count(&count).
+			Error
+		if err != nil {
+			return err
+		}
+		if int(count) != len(teamIDs) {
+			return fmt.Errorf("%w: user does not belong to some contest teams", repository.ErrInvalidArg)
+		}
+	}
+
+	err = r.h.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockVersion(ctx, tx, "users", userID); err != nil {
+			return err
+		}
+
+		err := tx.
+			WithContext(ctx).
+			Where(&model.UserFeaturedItem{UserID: userID}).
+			Delete(&model.UserFeaturedItem{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if len(items) == 0 {
+			return nil
+		}
+
+		return tx.WithContext(ctx).Create(&items).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // Interface guards
 var (
 	_ repository.UserRepository = (*UserRepository)(nil)
@@ -146,6 +146,7 @@ func TestUserRepository_GetUser(t *testing.T) {
 				State:    mockdata.MockTraQUsers[2].State,
 				Bio:      mockdata.MockUsers[2].Description,
 				Accounts: []*domain.Account{},
+				Featured: []*domain.UserFeaturedItem{},
 			},
 			assertion: assert.NoError,
 		},
@@ -168,9 +169,11 @@ func TestUserRepository_GetUser(t *testing.T) {
 						ID:          mockdata.MockAccounts[0].ID,
 						DisplayName: mockdata.MockAccounts[0].Name,
 						Type:        domain.AccountType(mockdata.MockAccounts[0].Type),
+						Handle:      mockdata.MockAccounts[0].Handle,
 						URL:         mockdata.MockAccounts[0].URL,
 					},
 				},
+				Featured: []*domain.UserFeaturedItem{},
 			},
 			assertion: assert.NoError,
 		},
@@ -193,6 +196,28 @@ func TestUserRepository_GetUser(t *testing.T) {
 	}
 }
 
+func TestUserRepository_GetUserDetails(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	err := mockdata.InsertSampleDataToDB(db)
+	assert.NoError(t, err)
+	repo := NewUserRepository(db, mock_external_e2e.NewMockPortalAPI(), mock_external_e2e.NewMockTraQAPI())
+
+	ids := []uuid.UUID{mockdata.MockUsers[0].ID, mockdata.MockUsers[2].ID}
+	expected := make([]*domain.UserDetail, len(ids))
+	for i, id := range ids {
+		u, err := repo.GetUser(context.Background(), id)
+		assert.NoError(t, err)
+		u.Featured = nil
+		expected[i] = u
+	}
+
+	got, err := repo.GetUserDetails(context.Background(), append(ids, random.UUID()))
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, expected, got)
+}
+
 func TestUserRepository_UpdateUser(t *testing.T) {
 	t.Parallel()
 
@@ -232,6 +257,13 @@ func TestUserRepository_UpdateUser(t *testing.T) {
 
 			bio := args.Description.ValueOr(user.Description)
 			check := args.Check.ValueOr(user.Check)
+			// 変更がある場合だけ版番号が増える
+			version := 0
+			_, descriptionChanged := args.Description.V()
+			_, checkChanged := args.Check.V()
+			if descriptionChanged || checkChanged {
+				version = 1
+			}
 
 			expected := &domain.UserDetail{
 				User: *domain.NewUser(
@@ -243,6 +275,8 @@ func TestUserRepository_UpdateUser(t *testing.T) {
 				State:    traqUser.State,
 				Bio:      bio,
 				Accounts: []*domain.Account{},
+				Featured: []*domain.UserFeaturedItem{},
+				Version:  version,
 			}
 			got, err := repo.GetUser(tt.ctx, user.ID)
 			assert.NoError(t, err)
@@ -252,6 +286,69 @@ func TestUserRepository_UpdateUser(t *testing.T) {
 	}
 }
 
+func TestUserRepository_EditUserFeaturedItems(t *testing.T) {
+	t.Parallel()
+
+	db := SetupTestGormDB(t)
+	err := mockdata.InsertSampleDataToDB(db)
+	assert.NoError(t, err)
+	repo := NewUserRepository(db, mock_external_e2e.NewMockPortalAPI(), mock_external_e2e.NewMockTraQAPI())
+
+	ctx := context.Background()
+	userID := mockdata.UserID1()
+	team := mockdata.MockContestTeams[0]
+	contest := mockdata.MockContests[0]
+	project := mockdata.MockProjects[0]
+
+	// 指定した順に並ぶ
+	err = repo.EditUserFeaturedItems(ctx, userID, []*urepository.EditUserFeaturedItemArgs{
+		{Type: domain.FeaturedItemContestTeam, ID: team.ID},
+		{Type: domain.FeaturedItemProject, ID: project.ID},
+	})
+	assert.NoError(t, err)
+
+	got, err := repo.GetUser(ctx, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.UserFeaturedItem{
+		{
+			Type:      domain.FeaturedItemContestTeam,
+			ID:        team.ID,
+			Name:      contest.Name,
+			ContestID: contest.ID,
+			TeamName:  team.Name,
+		},
+		{
+			Type: domain.FeaturedItemProject,
+			ID:   project.ID,
+			Name: project.Name,
+		},
+	}, got.Featured)
+
+	// 所属していないものは指定できない
+	err = repo.EditUserFeaturedItems(ctx, userID, []*urepository.EditUserFeaturedItemArgs{
+		{Type: domain.FeaturedItemProject, ID: mockdata.ProjectID2()},
+	})
+	assert.ErrorIs(t, err, urepository.ErrInvalidArg)
+
+	// 重複は指定できない
+	err = repo.EditUserFeaturedItems(ctx, userID, []*urepository.EditUserFeaturedItemArgs{
+		{Type: domain.FeaturedItemProject, ID: project.ID},
+		{Type: domain.FeaturedItemProject, ID: project.ID},
+	})
+	assert.ErrorIs(t, err, urepository.ErrInvalidArg)
+
+	// 空にすると固定表示が外れる
+	err = repo.EditUserFeaturedItems(ctx, userID, []*urepository.EditUserFeaturedItemArgs{})
+	assert.NoError(t, err)
+
+	got, err = repo.GetUser(ctx, userID)
+	assert.NoError(t, err)
+	assert.Empty(t, got.Featured)
+
+	err = repo.EditUserFeaturedItems(ctx, random.UUID(), []*urepository.EditUserFeaturedItemArgs{})
+	assert.ErrorIs(t, err, urepository.ErrNotFound)
+}
+
 func TestUserRepository_GetAccounts(t *testing.T) {
 	t.Parallel()
 	db := SetupTestGormDB(t)
@@ -351,7 +448,8 @@ func TestUserRepository_UpdateAccount(t *testing.T) {
 	}
 	account1.DisplayName = args.DisplayName.ValueOr(account1.DisplayName)
 	account1.Type = args.Type.ValueOr(account1.Type)
-	account1.URL = args.URL.ValueOr(account1.URL)
+	account1.Handle, account1.URL, err = domain.NormalizeAccountURL(account1.Type, args.URL.ValueOr(account1.URL))
+	assert.NoError(t, err)
 	err = repo.UpdateAccount(context.Background(), user.ID, account1.ID, args)
 	assert.NoError(t, err)
 
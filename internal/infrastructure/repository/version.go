@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 版番号はGETのレスポンスのETagに使い、レスポンスに含まれる内容を編集するたびに増やす
+// メンバーなどの子リソースの編集でも親の版番号を増やす
+// 更新日時はフィードの日時にも使うため、子リソースの編集では変えない
+
+// lockVersion 編集するtableの行をロックして、ctxに記録されたIf-Matchの版番号と一致するか確かめてから版番号を増やす
+// 一致しない場合はErrPreconditionFailedを返す。同じトランザクションの編集が失敗した場合は版番号も元に戻る
+func lockVersion(ctx context.Context, tx *gorm.DB, table string, id uuid.UUID) error {
+	row := struct{ Version int }{}
+	if err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Table(table).
+		Select("`version`").
+		Where(fmt.Sprintf("`%s`.`id` = ?", table), id).
+		Take(&row).
+		Error; err != nil {
+		return err
+	}
+
+	if err := repository.CheckVersion(ctx, row.Version); err != nil {
+		return err
+	}
+
+	return bumpVersion(tx, table, id)
+}
+
+// bumpVersion tableの行の版番号を増やす
+// 更新日時は変えない
+func bumpVersion(tx *gorm.DB, table string, ids ...uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return tx.
+		Table(table).
+		Where(fmt.Sprintf("`%s`.`id` IN (?)", table), ids).
+		UpdateColumn("version", gorm.Expr("`version` + 1")).
+		Error
+}
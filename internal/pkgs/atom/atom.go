@@ -0,0 +1,105 @@
+// Package atom RFC 4287で定められたAtom形式のフィードを生成する
+package atom
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// Feed 新しい順に並んだ記事の一覧
+type Feed struct {
+	ID      string // フィードを一意に識別するIRI。変更するとフィードリーダーが別のフィードとして扱う
+	Title   string
+	SelfURL string // フィード自体のURL
+	Author  string
+	Entries []*Entry
+}
+
+// Entry 記事
+// IDが同じ記事はUpdatedが変わるとフィードリーダー上で更新される
+type Entry struct {
+	ID        string
+	Title     string
+	Content   string // プレーンテキストの本文
+	Published time.Time
+	Updated   time.Time
+}
+
+type xmlFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  xmlAuthor   `xml:"author"`
+	Links   []xmlLink   `xml:"link"`
+	Entries []*xmlEntry `xml:"entry"`
+}
+
+type xmlAuthor struct {
+	Name string `xml:"name"`
+}
+
+type xmlLink struct {
+	Rel  string `xml:"rel,attr"`
+	Href string `xml:"href,attr"`
+}
+
+type xmlEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Published string     `xml:"published,omitempty"`
+	Updated   string     `xml:"updated"`
+	Content   xmlContent `xml:"content"`
+}
+
+type xmlContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// Encode Atom形式のXMLに変換する
+// フィードの更新日時は最も新しい記事の更新日時とし、記事が無い場合はnowを使う
+func (f *Feed) Encode(now time.Time) ([]byte, error) {
+	updated := time.Time{}
+	entries := make([]*xmlEntry, len(f.Entries))
+	for i, e := range f.Entries {
+		if e.Updated.After(updated) {
+			updated = e.Updated
+		}
+
+		entries[i] = &xmlEntry{
+			ID:      e.ID,
+			Title:   e.Title,
+			Updated: formatTime(e.Updated),
+			Content: xmlContent{Type: "text", Body: e.Content},
+		}
+		if !e.Published.IsZero() {
+			entries[i].Published = formatTime(e.Published)
+		}
+	}
+	if updated.IsZero() {
+		updated = now
+	}
+
+	feed := xmlFeed{
+		ID:      f.ID,
+		Title:   f.Title,
+		Updated: formatTime(updated),
+		Author:  xmlAuthor{Name: f.Author},
+		Entries: entries,
+	}
+	if f.SelfURL != "" {
+		feed.Links = []xmlLink{{Rel: "self", Href: f.SelfURL}}
+	}
+
+	b, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,91 @@
+package atom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeed_Encode(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		feed *Feed
+		want string
+	}{
+		"with entries": {
+			feed: &Feed{
+				ID:      "https://example.com/feeds/projects.atom",
+				Title:   "traP <projects>",
+				SelfURL: "https://example.com/feeds/projects.atom",
+				Author:  "traP",
+				Entries: []*Entry{
+					{
+						ID:        "urn:uuid:11111111-1111-1111-1111-111111111111",
+						Title:     "project2",
+						Content:   "a & b",
+						Published: time.Date(2022, 5, 1, 9, 0, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)),
+						Updated:   time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+					},
+					{
+						ID:      "urn:uuid:22222222-2222-2222-2222-222222222222",
+						Title:   "project1",
+						Updated: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>https://example.com/feeds/projects.atom</id>
+  <title>traP &lt;projects&gt;</title>
+  <updated>2022-06-01T00:00:00Z</updated>
+  <author>
+    <name>traP</name>
+  </author>
+  <link rel="self" href="https://example.com/feeds/projects.atom"></link>
+  <entry>
+    <id>urn:uuid:11111111-1111-1111-1111-111111111111</id>
+    <title>project2</title>
+    <published>2022-05-01T00:00:00Z</published>
+    <updated>2022-06-01T00:00:00Z</updated>
+    <content type="text">a &amp; b</content>
+  </entry>
+  <entry>
+    <id>urn:uuid:22222222-2222-2222-2222-222222222222</id>
+    <title>project1</title>
+    <updated>2022-04-01T00:00:00Z</updated>
+    <content type="text"></content>
+  </entry>
+</feed>`,
+		},
+		"empty": {
+			feed: &Feed{
+				ID:     "urn:example",
+				Title:  "empty",
+				Author: "traP",
+			},
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>urn:example</id>
+  <title>empty</title>
+  <updated>2023-01-01T00:00:00Z</updated>
+  <author>
+    <name>traP</name>
+  </author>
+</feed>`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.feed.Encode(now)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
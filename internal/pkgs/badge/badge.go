@@ -0,0 +1,131 @@
+// Package badge READMEなどに埋め込むshields.io風のSVGバッジを生成する
+package badge
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	height      = 20
+	spacing     = 4 // 複数のバッジを縦に並べるときの間隔
+	textPadding = 6
+	fontSize    = 11
+	fontFamily  = "Verdana,Geneva,DejaVu Sans,sans-serif"
+)
+
+// バッジの色
+const (
+	ColorLabel = "#555"
+	ColorBlue  = "#005bac"
+	ColorGray  = "#9f9f9f"
+)
+
+// Badge 左側にLabel、右側にMessageを表示するバッジ
+// Labelが空の場合はMessageだけを表示する
+type Badge struct {
+	Label   string
+	Message string
+	Color   string // Messageの背景色
+}
+
+// measureFace 文字列の幅を見積もるためのフォント
+// 表示するフォントは閲覧者の環境によって異なるため、textLengthで見積もった幅に合わせて描画させる
+var measureFace font.Face
+
+func init() {
+	f, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		panic(err)
+	}
+
+	measureFace, err = opentype.NewFace(f, &opentype.FaceOptions{Size: fontSize, DPI: 72})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// textWidth フォントに無い文字(日本語など)は全角幅として見積もる
+func textWidth(s string) int {
+	var w fixed.Int26_6
+	for _, r := range s {
+		a, ok := measureFace.GlyphAdvance(r)
+		if !ok {
+			a = fixed.I(fontSize)
+		}
+		w += a
+	}
+
+	return w.Ceil()
+}
+
+// Render バッジを縦に並べたSVG画像を生成する
+func Render(badges ...Badge) []byte {
+	type part struct {
+		text    string
+		color   string
+		x, w, t int // 左端、幅、文字列の幅
+	}
+
+	widths := make([]int, len(badges))
+	rows := make([][]part, len(badges))
+	totalWidth := 0
+	for i, b := range badges {
+		x := 0
+		if b.Label != "" {
+			t := textWidth(b.Label)
+			rows[i] = append(rows[i], part{b.Label, ColorLabel, x, t + textPadding*2, t})
+			x += t + textPadding*2
+		}
+		t := textWidth(b.Message)
+		rows[i] = append(rows[i], part{b.Message, b.Color, x, t + textPadding*2, t})
+		widths[i] = x + t + textPadding*2
+		totalWidth = max(totalWidth, widths[i])
+	}
+
+	totalHeight := len(badges)*(height+spacing) - spacing
+	if len(badges) == 0 {
+		totalHeight = 0
+	}
+
+	titles := make([]string, len(badges))
+	for i, b := range badges {
+		titles[i] = b.Message
+		if b.Label != "" {
+			titles[i] = b.Label + ": " + b.Message
+		}
+	}
+	title := html.EscapeString(strings.Join(titles, ", "))
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" role="img" aria-label="%s">`, totalWidth, totalHeight, title)
+	fmt.Fprintf(&buf, `<title>%s</title>`, title)
+	buf.WriteString(`<linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	for i, row := range rows {
+		fmt.Fprintf(&buf, `<g transform="translate(0,%d)">`, i*(height+spacing))
+		fmt.Fprintf(&buf, `<clipPath id="r%d"><rect width="%d" height="%d" rx="3" fill="#fff"/></clipPath>`, i, widths[i], height)
+		fmt.Fprintf(&buf, `<g clip-path="url(#r%d)">`, i)
+		for _, p := range row {
+			fmt.Fprintf(&buf, `<rect x="%d" width="%d" height="%d" fill="%s"/>`, p.x, p.w, height, html.EscapeString(p.color))
+		}
+		fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="url(#s)"/></g>`, widths[i], height)
+		fmt.Fprintf(&buf, `<g fill="#fff" text-anchor="middle" font-family="%s" font-size="%d">`, fontFamily, fontSize)
+		for _, p := range row {
+			text := html.EscapeString(p.text)
+			cx := p.x + p.w/2
+			fmt.Fprintf(&buf, `<text x="%d" y="15" fill="#010101" fill-opacity=".3" textLength="%d" lengthAdjust="spacingAndGlyphs">%s</text>`, cx, p.t, text)
+			fmt.Fprintf(&buf, `<text x="%d" y="14" textLength="%d" lengthAdjust="spacingAndGlyphs">%s</text>`, cx, p.t, text)
+		}
+		buf.WriteString(`</g></g>`)
+	}
+	buf.WriteString(`</svg>`)
+
+	return buf.Bytes()
+}
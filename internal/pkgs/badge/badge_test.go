@@ -0,0 +1,66 @@
+package badge
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type svg struct {
+	Width  int      `xml:"width,attr"`
+	Height int      `xml:"height,attr"`
+	Title  string   `xml:"title"`
+	Texts  []string `xml:"g>g>text"`
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		badges []Badge
+		title  string
+		texts  []string
+		height int
+	}{
+		"label and message": {
+			badges: []Badge{{Label: "traP", Message: "12 projects", Color: ColorBlue}},
+			title:  "traP: 12 projects",
+			texts:  []string{"traP", "traP", "12 projects", "12 projects"},
+			height: height,
+		},
+		"stacked and escaped": {
+			badges: []Badge{
+				{Label: "ICPC <Asia>", Message: "Finalist", Color: ColorBlue},
+				{Message: "優勝 & 準優勝", Color: ColorGray},
+			},
+			title:  "ICPC <Asia>: Finalist, 優勝 & 準優勝",
+			texts:  []string{"ICPC <Asia>", "ICPC <Asia>", "Finalist", "Finalist", "優勝 & 準優勝", "優勝 & 準優勝"},
+			height: height*2 + spacing,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b := Render(tt.badges...)
+
+			var got svg
+			assert.NoError(t, xml.Unmarshal(b, &got))
+			assert.Equal(t, tt.title, got.Title)
+			assert.Equal(t, tt.texts, got.Texts)
+			assert.Equal(t, tt.height, got.Height)
+			assert.Positive(t, got.Width)
+		})
+	}
+}
+
+func Test_textWidth(t *testing.T) {
+	t.Parallel()
+
+	assert.Less(t, textWidth("ab"), textWidth("abc"))
+	// フォントに無い文字は全角幅として見積もる
+	assert.Equal(t, fontSize*2, textWidth("優勝"))
+	assert.Equal(t, 0, textWidth(""))
+}
@@ -28,6 +28,14 @@ type (
 		Traq   TraqConfig
 		Knoq   APIConfig
 		Portal APIConfig
+
+		Competitive CompetitiveConfig
+		GitHub      GitHubConfig
+		OGP         OGPConfig
+		Snapshot    SnapshotConfig
+
+		// 組み込みの外部アカウントの種類に追加する種類、または上書きする種類
+		AccountTypes []AccountTypeConfig
 	}
 
 	SQLConfig struct {
@@ -47,6 +55,40 @@ type (
 	TraqConfig struct {
 		AccessToken string
 	}
+
+	// CompetitiveConfig 競技プログラミングなどのサービスから成績を取得する設定
+	CompetitiveConfig struct {
+		AtCoderEndpoint string
+		SyncInterval    time.Duration // 0の場合は定期的に取得しない
+	}
+
+	// GitHubConfig プロジェクトのリンク先のGitHubリポジトリの情報を取得する設定
+	GitHubConfig struct {
+		APIEndpoint  string
+		AccessToken  string        // 空の場合は認証せずに取得する
+		SyncInterval time.Duration // 0の場合は定期的に取得しない
+	}
+
+	// OGPConfig リンクのプレビュー画像とoEmbedの設定
+	OGPConfig struct {
+		FrontendURL string // oEmbedで受け付けるURLのオリジン。空の場合はオリジンを確認しない
+		FontPath    string // 日本語などを描画するためのフォントファイル。空の場合は埋め込みのM PLUS 1pを使う
+	}
+
+	// SnapshotConfig 公開されている情報を静的なファイルとして書き出す設定
+	SnapshotConfig struct {
+		Dir  string // 書き出し先のディレクトリ。空でない場合はサーバーを起動せず、書き出して終了する
+		HTML bool   // JSONに加えてユーザーとプロジェクトのページをHTMLでも書き出すかどうか
+	}
+
+	AccountTypeConfig struct {
+		ID            uint8
+		Label         string
+		URLPattern    string
+		HandlePattern string // 空の場合はハンドルを持たない
+		URLTemplate   string // {handle}がハンドルに置き換わる
+		Icon          string
+	}
 )
 
 func init() {
@@ -95,6 +137,33 @@ func init() {
 	pflag.String("portal-api-endpoint", "", "portal api endpoint")
 	viper.BindPFlag("portal.apiEndpoint", pflag.Lookup("portal-api-endpoint"))
 
+	pflag.String("atcoder-endpoint", "https://atcoder.jp", "atcoder endpoint")
+	viper.BindPFlag("competitive.atCoderEndpoint", pflag.Lookup("atcoder-endpoint"))
+
+	pflag.Duration("competitive-sync-interval", 24*time.Hour, "interval to fetch competitive programming stats")
+	viper.BindPFlag("competitive.syncInterval", pflag.Lookup("competitive-sync-interval"))
+
+	pflag.String("github-api-endpoint", "https://api.github.com", "github api endpoint")
+	viper.BindPFlag("github.apiEndpoint", pflag.Lookup("github-api-endpoint"))
+
+	pflag.String("github-access-token", "", "github access token")
+	viper.BindPFlag("github.accessToken", pflag.Lookup("github-access-token"))
+
+	pflag.Duration("github-sync-interval", 6*time.Hour, "interval to fetch github repositories of projects")
+	viper.BindPFlag("github.syncInterval", pflag.Lookup("github-sync-interval"))
+
+	pflag.String("frontend-url", "", "origin of the frontend urls accepted by oembed")
+	viper.BindPFlag("ogp.frontendURL", pflag.Lookup("frontend-url"))
+
+	pflag.String("ogp-font-path", "", "font file to render non-Latin characters on ogp images instead of the embedded Japanese font")
+	viper.BindPFlag("ogp.fontPath", pflag.Lookup("ogp-font-path"))
+
+	pflag.String("snapshot-dir", "", "write a static snapshot of the public api to the directory (not start server)")
+	viper.BindPFlag("snapshot.dir", pflag.Lookup("snapshot-dir"))
+
+	pflag.Bool("snapshot-html", false, "also write html pages of users and projects to the snapshot")
+	viper.BindPFlag("snapshot.html", pflag.Lookup("snapshot-html"))
+
 	pflag.StringP("config", "c", "", "config file path")
 
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
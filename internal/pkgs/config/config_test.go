@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
@@ -36,6 +37,15 @@ func TestLoad(t *testing.T) {
 			Cookie:      "",
 			APIEndpoint: "",
 		},
+		Competitive: config.CompetitiveConfig{
+			AtCoderEndpoint: "https://atcoder.jp",
+			SyncInterval:    24 * time.Hour,
+		},
+		GitHub: config.GitHubConfig{
+			APIEndpoint:  "https://api.github.com",
+			AccessToken:  "",
+			SyncInterval: 6 * time.Hour,
+		},
 	}
 
 	t.Run("default", func(t *testing.T) {
@@ -91,6 +101,50 @@ port: 8000`
 		assert.Equal(t, &expected, got)
 	})
 
+	t.Run("account types from file", func(t *testing.T) {
+		yaml := `
+accountTypes:
+  - id: 14
+    label: Codeforces
+    urlPattern: ^https://codeforces\.com/profile/[a-zA-Z0-9_.-]+$
+    handlePattern: ^https://codeforces\.com/profile/([a-zA-Z0-9_.-]+)$
+    urlTemplate: https://codeforces.com/profile/{handle}
+    icon: codeforces`
+		configPath := filepath.Join(t.TempDir(), "config.yaml")
+		os.Create(configPath)
+		os.WriteFile(configPath, []byte(yaml), 0644)
+		t.Setenv("TPF_CONFIG", configPath)
+
+		expected := []config.AccountTypeConfig{
+			{
+				ID:            14,
+				Label:         "Codeforces",
+				URLPattern:    `^https://codeforces\.com/profile/[a-zA-Z0-9_.-]+$`,
+				HandlePattern: `^https://codeforces\.com/profile/([a-zA-Z0-9_.-]+)$`,
+				URLTemplate:   "https://codeforces.com/profile/{handle}",
+				Icon:          "codeforces",
+			},
+		}
+
+		got, err := config.Load(config.LoadOpts{})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got.AccountTypes)
+	})
+
+	t.Run("competitive from env", func(t *testing.T) {
+		t.Setenv("TPF_COMPETITIVE_ATCODERENDPOINT", "http://localhost:8080")
+		t.Setenv("TPF_COMPETITIVE_SYNCINTERVAL", "30m")
+
+		expected := config.CompetitiveConfig{
+			AtCoderEndpoint: "http://localhost:8080",
+			SyncInterval:    30 * time.Minute,
+		}
+
+		got, err := config.Load(config.LoadOpts{})
+		assert.NoError(t, err)
+		assert.Equal(t, expected, got.Competitive)
+	})
+
 	t.Run("priority order is flag, env, file, then default", func(t *testing.T) {
 		t.Skip("It fails if flag is set twice")
 
@@ -0,0 +1,14 @@
+// Package font PDFや画像の生成に使う日本語を含むフォントを埋め込む
+//
+// M PLUS 1p (https://fonts.google.com/specimen/M+PLUS+1p) をSIL Open Font License 1.1の下で同梱している
+// ライセンスはOFL.txtを参照
+package font
+
+import (
+	_ "embed"
+)
+
+// MPlus1pRegular M PLUS 1p RegularのTrueTypeフォント
+//
+//go:embed MPLUS1p-Regular.ttf
+var MPlus1pRegular []byte
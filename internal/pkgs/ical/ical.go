@@ -0,0 +1,103 @@
+// Package ical RFC 5545で定められたiCalendar形式のカレンダーを生成する
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	prodID = "-//traP//traPortfolio//JA"
+	// maxLineOctets 1行の長さの上限(改行を除く)。これを超える行は折り返す
+	maxLineOctets = 75
+	timeFormat    = "20060102T150405Z"
+)
+
+// Calendar 予定の一覧
+type Calendar struct {
+	Name   string // カレンダーアプリでの表示名
+	Events []*Event
+}
+
+// Event 予定
+// UIDは予定ごとに固定の値にすることで、カレンダーアプリが予定を重複させずに更新できる
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	Start       time.Time
+	End         time.Time
+}
+
+// Encode iCalendar形式に変換する。nowは全ての予定の作成日時(DTSTAMP)として使われる
+func (c *Calendar) Encode(now time.Time) []byte {
+	var b bytes.Buffer
+	w := func(name string, value string) {
+		writeLine(&b, name+":"+value)
+	}
+
+	w("BEGIN", "VCALENDAR")
+	w("VERSION", "2.0")
+	w("PRODID", prodID)
+	w("CALSCALE", "GREGORIAN")
+	w("METHOD", "PUBLISH")
+	if c.Name != "" {
+		w("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	for _, e := range c.Events {
+		w("BEGIN", "VEVENT")
+		w("UID", e.UID)
+		w("DTSTAMP", now.UTC().Format(timeFormat))
+		w("DTSTART", e.Start.UTC().Format(timeFormat))
+		w("DTEND", e.End.UTC().Format(timeFormat))
+		w("SUMMARY", escapeText(e.Summary))
+		if e.Description != "" {
+			w("DESCRIPTION", escapeText(e.Description))
+		}
+		if e.URL != "" {
+			w("URL", e.URL)
+		}
+		w("END", "VEVENT")
+	}
+
+	w("END", "VCALENDAR")
+
+	return b.Bytes()
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeText TEXT型の値で特別な意味を持つ文字をエスケープする
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// writeLine 長い行はマルチバイト文字の途中で分割しないように折り返す
+// 折り返した行は空白で始める
+func writeLine(b *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		b.WriteString(line[:i])
+		b.WriteString("\r\n ")
+		line = line[i:]
+		// 先頭の空白の分だけ短くする
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,91 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendar_Encode(t *testing.T) {
+	t.Parallel()
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	c := &Calendar{
+		Name: "traPortfolio",
+		Events: []*Event{
+			{
+				UID:         "contest-1@traportfolio",
+				Summary:     "ISUCON, 本選",
+				Description: "1行目\n2行目",
+				URL:         "https://example.com",
+				Start:       time.Date(2022, 8, 1, 10, 0, 0, 0, jst),
+				End:         time.Date(2022, 8, 1, 18, 0, 0, 0, jst),
+			},
+		},
+	}
+	now := time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//traP//traPortfolio//JA",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:traPortfolio",
+		"BEGIN:VEVENT",
+		"UID:contest-1@traportfolio",
+		"DTSTAMP:20220701T000000Z",
+		"DTSTART:20220801T010000Z",
+		"DTEND:20220801T090000Z",
+		`SUMMARY:ISUCON\, 本選`,
+		`DESCRIPTION:1行目\n2行目`,
+		"URL:https://example.com",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	assert.Equal(t, want, string(c.Encode(now)))
+}
+
+func Test_escapeText(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `a\\b\;c\,d\ne\nf`, escapeText("a\\b;c,d\r\ne\nf"))
+}
+
+func Test_writeLine(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		line string
+	}{
+		"short":     {"SUMMARY:short"},
+		"ascii":     {"SUMMARY:" + strings.Repeat("a", 200)},
+		"multibyte": {"SUMMARY:" + strings.Repeat("あ", 100)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+			writeLine(&b, tt.line)
+
+			lines := strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n")
+			var unfolded strings.Builder
+			for i, l := range lines {
+				assert.LessOrEqual(t, len(l), maxLineOctets)
+				if i > 0 {
+					assert.True(t, strings.HasPrefix(l, " "))
+					l = l[1:]
+				}
+				unfolded.WriteString(l)
+			}
+			assert.Equal(t, tt.line, unfolded.String())
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Package markdown Markdownで書かれた本文をHTMLに変換する
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+var (
+	md = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+	)
+
+	// policy ユーザー投稿向けのポリシーに加え、リンクにはnofollowとnoopenerを付与する
+	policy = bluemonday.UGCPolicy().
+		RequireNoFollowOnLinks(true).
+		AddTargetBlankToFullyQualifiedLinks(true)
+)
+
+// ToHTML MarkdownをサニタイズされたHTMLに変換する
+// scriptタグやjavascript:スキームのリンクなどは取り除かれる
+func ToHTML(src string) (string, error) {
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(src), &buf); err != nil {
+		return "", err
+	}
+
+	return policy.Sanitize(buf.String()), nil
+}
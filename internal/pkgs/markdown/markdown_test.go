@@ -0,0 +1,61 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHTML(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src         string
+		contains    []string
+		notContains []string
+	}{
+		"empty": {
+			src: "",
+		},
+		"heading and list": {
+			src:      "# title\n\n- a\n- b\n",
+			contains: []string{"<h1>title</h1>", "<li>a</li>", "<li>b</li>"},
+		},
+		"table": {
+			src:      "| a | b |\n| - | - |\n| 1 | 2 |\n",
+			contains: []string{"<table>", "<td>1</td>"},
+		},
+		"raw script is removed": {
+			src:         "<script>alert(1)</script>\n\ntext",
+			contains:    []string{"<p>text</p>"},
+			notContains: []string{"<script", "alert(1)"},
+		},
+		"javascript link is removed": {
+			src:         "[click](javascript:alert(1))",
+			notContains: []string{"javascript:"},
+		},
+		"external link gets rel": {
+			src:      "[traP](https://trap.jp)",
+			contains: []string{`href="https://trap.jp"`, `rel="nofollow noopener"`, `target="_blank"`},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ToHTML(tt.src)
+			assert.NoError(t, err)
+			if len(tt.contains) == 0 && len(tt.notContains) == 0 {
+				assert.Empty(t, strings.TrimSpace(got))
+			}
+			for _, s := range tt.contains {
+				assert.Contains(t, got, s)
+			}
+			for _, s := range tt.notContains {
+				assert.NotContains(t, got, s)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ var (
 	MockKnoqEvents  = CloneMockKnoqEvents()
 	MockPortalUsers = CloneMockPortalUsers()
 	MockTraQUsers   = CloneMockTraQUsers()
+
+	MockCompetitiveStats = CloneMockCompetitiveStats()
+	MockGitHubRepository = CloneMockGitHubRepository()
 )
 
 func CloneMockKnoqEvents() []*external.EventResponse {
@@ -107,3 +110,22 @@ func CloneMockTraQUsers() []*external.TraQUserResponse {
 		},
 	}
 }
+
+func CloneMockCompetitiveStats() *external.CompetitiveStatsResponse {
+	return &external.CompetitiveStatsResponse{
+		Rating:        1234,
+		MaxRating:     1500,
+		Color:         "cyan",
+		RatedContests: 20,
+	}
+}
+
+func CloneMockGitHubRepository() *external.GitHubRepositoryResponse {
+	return &external.GitHubRepositoryResponse{
+		Description: "sample_repository_description",
+		Languages:   []string{"Go", "TypeScript"},
+		Stars:       42,
+		License:     "MIT",
+		PushedAt:    time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
@@ -384,6 +384,7 @@ func CloneHandlerMockUserDetails() []schema.UserDetail {
 		hUsers[i] = schema.UserDetail{
 			Accounts: hAccounts[mu.ID],
 			Bio:      mu.Description,
+			Featured: []schema.UserFeaturedItem{},
 			Id:       mu.ID,
 			Name:     mu.Name,
 			RealName: portalUsers[i].RealName,
@@ -406,6 +407,7 @@ func CloneHandlerMockUserAccountsByID() map[uuid.UUID][]schema.Account {
 			Id:          a.ID,
 			Type:        schema.AccountType(a.Type),
 			Url:         a.URL,
+			Handle:      a.Handle,
 		})
 	}
 
@@ -55,6 +55,7 @@ func CloneMockAccounts() []model.Account {
 			Type:   2,
 			Name:   "sample_account_display_name",
 			URL:    "https://twitter.com/sample_account",
+			Handle: "sample_account",
 			UserID: UserID1(),
 		},
 	}
@@ -0,0 +1,292 @@
+// Package ogp SNSなどでリンクのプレビューに使われるOpen Graph画像を生成する
+package ogp
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+
+	embedfont "github.com/traPtitech/traPortfolio/internal/pkgs/font"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Open Graph画像として推奨される大きさ
+const (
+	Width  = 1200
+	Height = 630
+)
+
+const (
+	padding       = 80
+	accentWidth   = 24
+	maxItems      = 3
+	badgeHeight   = 52
+	badgePadding  = 24
+	badgeSpacing  = 16
+	ellipsis      = "…"
+	titleSize     = 64
+	subtitleSize  = 36
+	itemSize      = 32
+	badgeSize     = 26
+	footerSize    = 26
+	itemLineSpace = 52
+)
+
+var (
+	colorBackground = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	colorAccent     = color.RGBA{0x00, 0x5b, 0xac, 0xff}
+	colorText       = color.RGBA{0x1f, 0x23, 0x28, 0xff}
+	colorSubText    = color.RGBA{0x65, 0x6d, 0x76, 0xff}
+	colorBadge      = color.RGBA{0xe7, 0xf0, 0xfa, 0xff}
+)
+
+// Card 画像に描画する内容
+// 幅に収まらない文字列は末尾を省略して描画する
+type Card struct {
+	Title    string   // 大きく表示する名前
+	Subtitle string   // 名前の下に表示する補足
+	Items    []string // 代表的なプロジェクトなど、箇条書きで表示する項目。先頭から3件まで表示する
+	Badges   []string // コンテストの成績などを下部に並べて表示する。幅に収まる分だけ表示する
+	Footer   string   // 右下に表示するサイト名
+}
+
+// Renderer 埋め込みのフォントでCardをPNG画像にする
+type Renderer struct {
+	regular  *opentype.Font
+	bold     *opentype.Font
+	fallback *opentype.Font // Goフォントに無い日本語などの文字を描画するフォント
+}
+
+// NewRenderer 英数字はGoフォントで、それ以外の文字はfallbackのTrueType/OpenTypeフォントで描画する
+// fallbackがnilの場合は埋め込みの日本語フォント(M PLUS 1p)を使う
+func NewRenderer(fallback []byte) (*Renderer, error) {
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, err
+	}
+
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, err
+	}
+
+	if fallback == nil {
+		fallback = embedfont.MPlus1pRegular
+	}
+
+	r := &Renderer{regular: regular, bold: bold}
+	r.fallback, err = parseFont(fallback)
+	if err != nil {
+		return nil, fmt.Errorf("parse fallback font: %w", err)
+	}
+
+	return r, nil
+}
+
+// parseFont フォントコレクション(.ttc)の場合は最初のフォントを使う
+func parseFont(b []byte) (*opentype.Font, error) {
+	if f, err := opentype.Parse(b); err == nil {
+		return f, nil
+	}
+
+	c, err := opentype.ParseCollection(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Font(0)
+}
+
+// newFace opentype.Faceは並行に使えないため描画ごとに作る
+func (r *Renderer) newFace(f *opentype.Font, size float64) (font.Face, error) {
+	opts := &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull}
+	primary, err := opentype.NewFace(f, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	fallback, err := opentype.NewFace(r.fallback, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fallbackFace{primary: primary, fallback: fallback}, nil
+}
+
+// Render CardをWidth x HeightのPNG画像にする
+func (r *Renderer) Render(card *Card) ([]byte, error) {
+	titleFace, err := r.newFace(r.bold, titleSize)
+	if err != nil {
+		return nil, err
+	}
+	subtitleFace, err := r.newFace(r.regular, subtitleSize)
+	if err != nil {
+		return nil, err
+	}
+	itemFace, err := r.newFace(r.regular, itemSize)
+	if err != nil {
+		return nil, err
+	}
+	badgeFace, err := r.newFace(r.bold, badgeSize)
+	if err != nil {
+		return nil, err
+	}
+	footerFace, err := r.newFace(r.bold, footerSize)
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, Width, Height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(colorBackground), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 0, accentWidth, Height), image.NewUniform(colorAccent), image.Point{}, draw.Src)
+
+	left := accentWidth + padding
+	maxWidth := fixed.I(Width - left - padding)
+
+	y := padding + titleSize
+	drawText(img, titleFace, colorText, left, y, truncate(titleFace, card.Title, maxWidth))
+
+	if card.Subtitle != "" {
+		y += subtitleSize + 24
+		drawText(img, subtitleFace, colorSubText, left, y, truncate(subtitleFace, card.Subtitle, maxWidth))
+	}
+
+	y += 40
+	for i, item := range card.Items {
+		if i >= maxItems {
+			break
+		}
+		y += itemLineSpace
+		drawText(img, itemFace, colorText, left, y, truncate(itemFace, "• "+item, maxWidth))
+	}
+
+	badgeTop := Height - padding - badgeHeight - footerSize
+	x := left
+	for _, b := range card.Badges {
+		text := truncate(badgeFace, b, maxWidth-fixed.I(badgePadding*2))
+		w := font.MeasureString(badgeFace, text).Ceil() + badgePadding*2
+		if x+w > Width-padding {
+			break
+		}
+
+		fillRoundedRect(img, image.Rect(x, badgeTop, x+w, badgeTop+badgeHeight), badgeHeight/2, colorBadge)
+		drawText(img, badgeFace, colorAccent, x+badgePadding, badgeTop+(badgeHeight+badgeSize)/2-3, text)
+		x += w + badgeSpacing
+	}
+
+	if card.Footer != "" {
+		w := font.MeasureString(footerFace, card.Footer).Ceil()
+		drawText(img, footerFace, colorAccent, Width-padding-w, Height-padding/2, card.Footer)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// drawText yはベースラインの位置
+func drawText(dst draw.Image, face font.Face, c color.Color, x int, y int, s string) {
+	d := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(s)
+}
+
+// truncate 幅がmaxWidthを超える場合は末尾を…に置き換える
+func truncate(face font.Face, s string, maxWidth fixed.Int26_6) string {
+	if font.MeasureString(face, s) <= maxWidth {
+		return s
+	}
+
+	runes := []rune(s)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		t := string(runes) + ellipsis
+		if font.MeasureString(face, t) <= maxWidth {
+			return t
+		}
+	}
+
+	return ellipsis
+}
+
+// fillRoundedRect 角の半径がradiusの長方形を塗りつぶす
+func fillRoundedRect(dst *image.RGBA, r image.Rectangle, radius int, c color.Color) {
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			// 最も近い角の円の中心からの距離で角の外側かどうかを判定する
+			cx := min(max(x, r.Min.X+radius), r.Max.X-radius-1)
+			cy := min(max(y, r.Min.Y+radius), r.Max.Y-radius-1)
+			dx, dy := x-cx, y-cy
+			if dx*dx+dy*dy <= radius*radius {
+				dst.Set(x, y, c)
+			}
+		}
+	}
+}
+
+// fallbackFace primaryに無い文字だけをfallbackで描画する
+type fallbackFace struct {
+	primary  font.Face
+	fallback font.Face
+}
+
+var _ font.Face = (*fallbackFace)(nil)
+
+func (f *fallbackFace) faceFor(r rune) font.Face {
+	if _, ok := f.primary.GlyphAdvance(r); ok {
+		return f.primary
+	}
+	if _, ok := f.fallback.GlyphAdvance(r); ok {
+		return f.fallback
+	}
+
+	return f.primary
+}
+
+func (f *fallbackFace) Close() error {
+	if err := f.primary.Close(); err != nil {
+		return err
+	}
+
+	return f.fallback.Close()
+}
+
+func (f *fallbackFace) Glyph(dot fixed.Point26_6, r rune) (image.Rectangle, image.Image, image.Point, fixed.Int26_6, bool) {
+	return f.faceFor(r).Glyph(dot, r)
+}
+
+func (f *fallbackFace) GlyphBounds(r rune) (fixed.Rectangle26_6, fixed.Int26_6, bool) {
+	return f.faceFor(r).GlyphBounds(r)
+}
+
+func (f *fallbackFace) GlyphAdvance(r rune) (fixed.Int26_6, bool) {
+	return f.faceFor(r).GlyphAdvance(r)
+}
+
+// Kern 異なるフォントの文字の間ではカーニングしない
+func (f *fallbackFace) Kern(r0 rune, r1 rune) fixed.Int26_6 {
+	face := f.faceFor(r0)
+	if face != f.faceFor(r1) {
+		return 0
+	}
+
+	return face.Kern(r0, r1)
+}
+
+func (f *fallbackFace) Metrics() font.Metrics {
+	return f.primary.Metrics()
+}
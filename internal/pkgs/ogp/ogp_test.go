@@ -0,0 +1,115 @@
+package ogp
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewRenderer(nil)
+	assert.NoError(t, err)
+
+	tests := map[string]*Card{
+		"full": {
+			Title:    "@user1",
+			Subtitle: "User One",
+			Items:    []string{"project1", "project2", "project3", "project4"},
+			Badges:   []string{"ISUCON 1st", "ICPC 2nd", strings.Repeat("long badge ", 20)},
+			Footer:   "traPortfolio",
+		},
+		"title only": {
+			Title: strings.Repeat("very long title ", 20),
+		},
+	}
+
+	for name, card := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := r.Render(card)
+			assert.NoError(t, err)
+
+			img, err := png.Decode(bytes.NewReader(b))
+			assert.NoError(t, err)
+			assert.Equal(t, Width, img.Bounds().Dx())
+			assert.Equal(t, Height, img.Bounds().Dy())
+		})
+	}
+}
+
+func TestNewRenderer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("embedded japanese font by default", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := NewRenderer(nil)
+		assert.NoError(t, err)
+
+		face, err := r.newFace(r.regular, 32)
+		assert.NoError(t, err)
+
+		ff, ok := face.(*fallbackFace)
+		assert.True(t, ok)
+		assert.Equal(t, ff.primary, ff.faceFor('a'))
+		assert.Equal(t, ff.fallback, ff.faceFor('あ'))
+	})
+
+	t.Run("with fallback font", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := NewRenderer(gomono.TTF)
+		assert.NoError(t, err)
+		assert.NotNil(t, r.fallback)
+	})
+
+	t.Run("invalid fallback font", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewRenderer([]byte("invalid"))
+		assert.Error(t, err)
+	})
+}
+
+func TestFallbackFace(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewRenderer(gomono.TTF)
+	assert.NoError(t, err)
+
+	face, err := r.newFace(r.regular, 32)
+	assert.NoError(t, err)
+
+	ff, ok := face.(*fallbackFace)
+	assert.True(t, ok)
+	assert.Equal(t, ff.primary, ff.faceFor('a'))
+	// どちらのフォントにも無い文字はprimaryの豆腐で描画する
+	assert.Equal(t, ff.primary, ff.faceFor('あ'))
+}
+
+func Test_truncate(t *testing.T) {
+	t.Parallel()
+
+	r, err := NewRenderer(nil)
+	assert.NoError(t, err)
+
+	face, err := r.newFace(r.regular, 32)
+	assert.NoError(t, err)
+
+	width := font.MeasureString(face, "abc")
+
+	assert.Equal(t, "abc", truncate(face, "abc", width))
+	got := truncate(face, "abcdefghijklmnop", width)
+	assert.True(t, strings.HasSuffix(got, ellipsis))
+	assert.LessOrEqual(t, font.MeasureString(face, got), width)
+	assert.Equal(t, ellipsis, truncate(face, "abc", fixed.I(1)))
+}
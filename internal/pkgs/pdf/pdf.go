@@ -0,0 +1,207 @@
+// Package pdf 文字と罫線だけの簡単な文書のPDFを生成する
+//
+// 日本語を表示するため、埋め込みのフォント(M PLUS 1p)のうち文書で使った文字だけをPDFに埋め込む
+package pdf
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/font"
+)
+
+// A4の大きさと余白(pt)
+const (
+	pageWidth    = 595.28
+	pageHeight   = 841.89
+	marginX      = 50
+	marginTop    = 50
+	marginBottom = 50
+	lineSpacing  = 1.45 // 文字の大きさに対する行の高さの比
+	noteSize     = 8    // ページ数の上限を超えたときに書く注記の文字の大きさ
+)
+
+const fontFamily = "MPLUS1p"
+
+// Document 上から順に段落を並べていく文書
+// 幅に収まらない行は折り返し、ページに収まらない場合は改ページする
+type Document struct {
+	pdf       *fpdf.Fpdf
+	y         float64 // 次に書き込む位置(ページ上端からの距離)
+	maxPages  int
+	note      string
+	truncated bool
+}
+
+// NewDocument maxPagesページを超える内容は書き込まずに省略し、最後のページの末尾にnoteを書く
+// maxPagesが0以下の場合はページ数を制限しない
+func NewDocument(title string, maxPages int, note string) *Document {
+	p := fpdf.New(fpdf.OrientationPortrait, fpdf.UnitPoint, fpdf.PageSizeA4, "")
+	p.SetTitle(title, true)
+	p.SetProducer("traPortfolio", true)
+	p.SetAutoPageBreak(false, 0)
+	p.SetMargins(marginX, marginTop, marginX)
+	p.AddUTF8FontFromBytes(fontFamily, "", font.MPlus1pRegular)
+
+	d := &Document{pdf: p, maxPages: maxPages, note: note}
+	d.addPage()
+
+	return d
+}
+
+func (d *Document) addPage() {
+	d.pdf.AddPage()
+	d.y = marginTop
+}
+
+// bottom 書き込める範囲の下端。最後のページでは注記を書く分を空けておく
+func (d *Document) bottom() float64 {
+	b := pageHeight - marginBottom
+	if d.maxPages > 0 && d.note != "" && d.pdf.PageNo() >= d.maxPages {
+		b -= noteSize * lineSpacing
+	}
+
+	return b
+}
+
+// ensure 残りの高さが足りない場合は改ページする
+// ページ数の上限に達している場合はfalseを返し、以降の内容は省略する
+func (d *Document) ensure(height float64) bool {
+	if d.truncated {
+		return false
+	}
+	if d.y+height <= d.bottom() {
+		return true
+	}
+	if d.maxPages > 0 && d.pdf.PageNo() >= d.maxPages {
+		d.truncated = true
+		return false
+	}
+
+	d.addPage()
+
+	return true
+}
+
+// Text 段落を追加する。改行を含む場合は複数の段落として扱う
+func (d *Document) Text(text string, size float64, indent float64) {
+	d.pdf.SetFont(fontFamily, "", size)
+	lineHeight := size * lineSpacing
+	width := func(r rune) float64 { return d.pdf.GetStringWidth(string(r)) }
+	for _, paragraph := range strings.Split(text, "\n") {
+		for _, line := range wrap(paragraph, pageWidth-2*marginX-indent, width) {
+			if !d.ensure(lineHeight) {
+				return
+			}
+			d.pdf.SetXY(marginX+indent, d.y)
+			d.pdf.CellFormat(0, lineHeight, line, "", 0, "LM", false, 0, "")
+			d.y += lineHeight
+		}
+	}
+}
+
+// Heading 見出しと下線を追加する。見出しだけがページの末尾に残らないよう、続く数行分の余裕がなければ改ページする
+func (d *Document) Heading(text string, size float64) {
+	if !d.ensure(size*lineSpacing*4 + size) {
+		return
+	}
+	d.Space(size / 2)
+	d.Text(text, size, 0)
+	d.Rule()
+	d.Space(size / 4)
+}
+
+// Space 空白を追加する
+func (d *Document) Space(height float64) {
+	if d.truncated {
+		return
+	}
+	if d.y+height > d.bottom() {
+		d.ensure(height)
+		return
+	}
+	d.y += height
+}
+
+// Rule 本文の幅いっぱいに罫線を引く
+func (d *Document) Rule() {
+	if !d.ensure(2) {
+		return
+	}
+	d.y += 2
+	d.pdf.SetDrawColor(153, 153, 153)
+	d.pdf.SetLineWidth(0.5)
+	d.pdf.Line(marginX, d.y, pageWidth-marginX, d.y)
+}
+
+// PageCount ページ数
+func (d *Document) PageCount() int {
+	return d.pdf.PageCount()
+}
+
+// Truncated ページ数の上限を超えたため省略した内容があるかどうか
+func (d *Document) Truncated() bool {
+	return d.truncated
+}
+
+// Bytes PDFとして出力する。出力した後は文書に書き込めない
+func (d *Document) Bytes() ([]byte, error) {
+	if d.truncated && d.note != "" {
+		d.pdf.SetFont(fontFamily, "", noteSize)
+		d.pdf.SetTextColor(101, 109, 118)
+		d.pdf.SetXY(marginX, pageHeight-marginBottom-noteSize*lineSpacing)
+		d.pdf.CellFormat(0, noteSize*lineSpacing, d.note, "", 0, "RM", false, 0, "")
+	}
+
+	var buf bytes.Buffer
+	if err := d.pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// wrap widthで測った幅がmaxWidthに収まるように折り返す
+// 英単語の途中ではなるべく折り返さない
+func wrap(text string, maxWidth float64, width func(r rune) float64) []string {
+	runes := []rune(text)
+	lines := make([]string, 0, 1)
+	start := 0
+	lineWidth := 0.0
+	lastSpace := -1
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == ' ' {
+			lastSpace = i
+		}
+
+		w := width(r)
+		if lineWidth+w > maxWidth && i > start {
+			end := i
+			if lastSpace > start && runes[i] != ' ' && isASCIIWordRune(runes[i-1]) {
+				end = lastSpace
+			}
+			lines = append(lines, strings.TrimRight(string(runes[start:end]), " "))
+
+			start = end
+			for start < len(runes) && runes[start] == ' ' {
+				start++
+			}
+			i = start - 1
+			lineWidth = 0
+			lastSpace = -1
+			continue
+		}
+		lineWidth += w
+	}
+	if start < len(runes) || len(lines) == 0 {
+		lines = append(lines, string(runes[start:]))
+	}
+
+	return lines
+}
+
+func isASCIIWordRune(r rune) bool {
+	return r > ' ' && r <= 0x7e
+}
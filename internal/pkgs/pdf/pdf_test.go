@@ -0,0 +1,89 @@
+package pdf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument_Bytes(t *testing.T) {
+	t.Parallel()
+
+	d := NewDocument("履歴書", 0, "")
+	d.Heading("プロフィール", 14)
+	d.Text("traP 太郎", 10, 0)
+	b, err := d.Bytes()
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(b, []byte("%PDF-")))
+	assert.Contains(t, string(b), "/Count 1")
+	// フォントの使った文字だけがPDFに埋め込まれている
+	assert.Contains(t, string(b), "/FontFile2")
+	assert.Contains(t, string(b), "/CIDFontType2")
+	assert.Less(t, len(b), 100*1024)
+}
+
+func TestDocument_PageBreak(t *testing.T) {
+	t.Parallel()
+
+	d := NewDocument("", 0, "")
+	for range 120 {
+		d.Text("line", 10, 0)
+	}
+
+	assert.Equal(t, 3, d.PageCount())
+	assert.False(t, d.Truncated())
+	b, err := d.Bytes()
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "/Count 3")
+}
+
+func TestDocument_MaxPages(t *testing.T) {
+	t.Parallel()
+
+	d := NewDocument("", 2, "省略しました")
+	for range 200 {
+		d.Heading("見出し", 13)
+		d.Text("line", 10, 0)
+	}
+
+	assert.Equal(t, 2, d.PageCount())
+	assert.True(t, d.Truncated())
+	b, err := d.Bytes()
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "/Count 2")
+}
+
+func Test_wrap(t *testing.T) {
+	t.Parallel()
+
+	// 半角文字は0.5、それ以外は1の幅として測る
+	width := func(r rune) float64 {
+		if r < 0x80 {
+			return 0.5
+		}
+		return 1
+	}
+
+	tests := map[string]struct {
+		text     string
+		maxWidth float64
+		want     []string
+	}{
+		"empty":          {"", 10, []string{""}},
+		"fits":           {"abc", 10, []string{"abc"}},
+		"japanese":       {"あいうえおかきくけこ", 4, []string{"あいうえ", "おかきく", "けこ"}},
+		"break at space": {"hello world foo", 3, []string{"hello", "world", "foo"}},
+		"long word":      {"abcdefghij", 2, []string{"abcd", "efgh", "ij"}},
+		"mixed":          {"traPの部員", 3, []string{"traPの", "部員"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, wrap(tt.text, tt.maxWidth, width))
+		})
+	}
+}
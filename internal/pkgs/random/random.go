@@ -5,6 +5,7 @@ import (
 	"math/rand/v2"
 	"net/url"
 	"sort"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -116,9 +117,9 @@ func AccountURLString(accountType domain.AccountType) string {
 			"https://qiita.com/5XcnQ8fyze",
 		},
 		domain.ZENN: {
-			"https://zenn.dev/2Kl1M.I3MO",
-			"https://zenn.dev/we.Xh9Sg2k",
-			"https://zenn.dev/ygZsTx1Pjf",
+			"https://zenn.dev/2kl1m_i3mo",
+			"https://zenn.dev/we_xh9sg2k",
+			"https://zenn.dev/ygzstx1pjf",
 		},
 		domain.ATCODER: {
 			"https://atcoder.jp/users/Ib_ucf2TjO",
@@ -152,7 +153,8 @@ func AccountURLString(accountType domain.AccountType) string {
 		},
 	}
 	if accountType == domain.HOMEPAGE || accountType == domain.BLOG {
-		return fmt.Sprintf("https://%s", AlphaNumeric())
+		// 正規化後のURLと一致するようにホスト名は小文字にする
+		return fmt.Sprintf("https://%s", strings.ToLower(AlphaNumeric()))
 	}
 	return AccountURLs[accountType][rand.IntN(3)]
 }
@@ -0,0 +1,17 @@
+//go:generate go run go.uber.org/mock/mockgen@latest -typed -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package repository
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+type AccountStatsRepository interface {
+	// GetAccountStats 最後に取得した成績を返す
+	GetAccountStats(ctx context.Context, userID uuid.UUID, accountID uuid.UUID) (*domain.AccountStats, error)
+	// SyncAccountStats 成績を取得できる全てのアカウントについて外部サービスから成績を取得して記録する
+	SyncAccountStats(ctx context.Context) error
+}
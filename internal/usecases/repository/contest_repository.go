@@ -15,49 +15,97 @@ import (
 
 type GetContestsArgs struct {
 	Limit optional.Of[int]
+	IDs   []uuid.UUID // 指定した場合はいずれかのIDのコンテストに絞り込む
 }
 
 type CreateContestArgs struct {
-	Name        string
-	Description string
-	Link        optional.Of[string]
-	Since       time.Time
-	Until       optional.Of[time.Time]
+	Name          string
+	NameEn        optional.Of[string] // 英語のコンテスト名
+	Description   string
+	DescriptionEn optional.Of[string] // 英語のコンテスト説明
+	Body          optional.Of[string]
+	Link          optional.Of[string]
+	Since         time.Time
+	Until         optional.Of[time.Time]
+	Visibility    optional.Of[domain.Visibility]
+	Draft         optional.Of[bool]      // 下書きとして作成するかどうか
+	PublishAt     optional.Of[time.Time] // 下書きの公開予定日時
 }
 
 type UpdateContestArgs struct {
-	Name        optional.Of[string]
-	Description optional.Of[string]
-	Link        optional.Of[string]
-	Since       optional.Of[time.Time]
-	Until       optional.Of[time.Time]
+	Name          optional.Of[string]
+	NameEn        optional.Of[string]
+	Description   optional.Of[string]
+	DescriptionEn optional.Of[string]
+	Body          optional.Of[string]
+	Link          optional.Of[string]
+	Since         optional.Of[time.Time]
+	Until         optional.Of[time.Time]
+	Visibility    optional.Of[domain.Visibility]
 }
 
 type CreateContestTeamArgs struct {
-	Name        string
-	Result      optional.Of[string]
-	Link        optional.Of[string]
-	Description string
+	Name          string
+	NameEn        optional.Of[string] // 英語のチーム名
+	Result        optional.Of[string]
+	Link          optional.Of[string]
+	Description   string
+	DescriptionEn optional.Of[string] // 英語のチーム情報
+	Body          optional.Of[string]
+	Visibility    optional.Of[domain.Visibility]
 }
 
 type UpdateContestTeamArgs struct {
-	Name        optional.Of[string]
+	Name          optional.Of[string]
+	NameEn        optional.Of[string]
+	Result        optional.Of[string]
+	Link          optional.Of[string]
+	Description   optional.Of[string]
+	DescriptionEn optional.Of[string]
+	Body          optional.Of[string]
+	Visibility    optional.Of[domain.Visibility]
+}
+
+// ImportContestTeamArgs コンテストチームの一括登録の1行
+// 同じ名前のチームが既にある場合は指定された項目だけを更新する
+type ImportContestTeamArgs struct {
+	Name        string
 	Result      optional.Of[string]
 	Link        optional.Of[string]
 	Description optional.Of[string]
+	Members     optional.Of[[]string] // メンバーのtraQ ID。指定した場合は所属するメンバーを置き換える
 }
 
 type ContestRepository interface {
 	GetContests(ctx context.Context, args *GetContestsArgs) ([]*domain.Contest, error)
 	GetContest(ctx context.Context, contestID uuid.UUID) (*domain.ContestDetail, error)
+	// GetContestDetails 複数のコンテストの詳細情報をチームも含めてまとめて取得する
+	// 存在しないか閲覧できないコンテストのIDは結果に含めない
+	GetContestDetails(ctx context.Context, contestIDs []uuid.UUID) ([]*domain.ContestDetail, error)
 	CreateContest(ctx context.Context, args *CreateContestArgs) (*domain.ContestDetail, error)
 	UpdateContest(ctx context.Context, contestID uuid.UUID, args *UpdateContestArgs) error
 	DeleteContest(ctx context.Context, contestID uuid.UUID) error
+	PublishContest(ctx context.Context, contestID uuid.UUID, publishAt optional.Of[time.Time]) error
 	GetContestTeams(ctx context.Context, contestID uuid.UUID) ([]*domain.ContestTeam, error)
+	// GetContestTeamsByContestIDs 閲覧者が閲覧できるコンテストについて、コンテストIDごとのチームをまとめて取得する
+	// 存在しないか閲覧できないコンテストのIDは結果に含めない
+	GetContestTeamsByContestIDs(ctx context.Context, contestIDs []uuid.UUID) (map[uuid.UUID][]*domain.ContestTeam, error)
 	GetContestTeam(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID) (*domain.ContestTeamDetail, error)
 	CreateContestTeam(ctx context.Context, contestID uuid.UUID, args *CreateContestTeamArgs) (*domain.ContestTeamDetail, error)
 	UpdateContestTeam(ctx context.Context, teamID uuid.UUID, args *UpdateContestTeamArgs) error
 	DeleteContestTeam(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID) error
 	GetContestTeamMembers(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID) ([]*domain.User, error)
 	EditContestTeamMembers(ctx context.Context, teamID uuid.UUID, memberIDs []uuid.UUID) error
+	GetContestRevisions(ctx context.Context, contestID uuid.UUID) ([]*domain.Revision, error)
+	GetContestRevisionDiff(ctx context.Context, contestID uuid.UUID, from int, to int) ([]*domain.RevisionFieldDiff, error)
+	RestoreContestRevision(ctx context.Context, contestID uuid.UUID, revision int) error
+	GetContestTeamRevisions(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID) ([]*domain.Revision, error)
+	GetContestTeamRevisionDiff(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID, from int, to int) ([]*domain.RevisionFieldDiff, error)
+	RestoreContestTeamRevision(ctx context.Context, contestID uuid.UUID, teamID uuid.UUID, revision int) error
+	// ImportContestTeams 全ての行を検証し、エラーが無くdryRunでもない場合のみ1つのトランザクションで適用する
+	ImportContestTeams(ctx context.Context, contestID uuid.UUID, rows []*ImportContestTeamArgs, dryRun bool) ([]*domain.ContestTeamImportResult, error)
+	// GetFeedContests 公開されているコンテストを新しく追加された順にlimit件取得する
+	GetFeedContests(ctx context.Context, limit int) ([]*domain.FeedContest, error)
+	// GetFeedContestResults 公開されているコンテストチームのうち結果が登録されているものを新しく更新された順にlimit件取得する
+	GetFeedContestResults(ctx context.Context, limit int) ([]*domain.FeedContestResult, error)
 }
@@ -17,6 +17,8 @@ var (
 	ErrForbidden = errors.New("forbidden")
 	// ErrAlreadyExists already exists
 	ErrAlreadyExists = errors.New("already exists")
+	// ErrPreconditionFailed resource has been changed since it was fetched
+	ErrPreconditionFailed = errors.New("precondition failed")
 	// ErrInvalidArg argument error
 	ErrInvalidArg = errors.New("argument error")
 	// ErrDBInternal database internal error
@@ -12,9 +12,11 @@ package mock_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	domain "github.com/traPtitech/traPortfolio/internal/domain"
+	optional "github.com/traPtitech/traPortfolio/internal/pkgs/optional"
 	repository "github.com/traPtitech/traPortfolio/internal/usecases/repository"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -274,6 +276,123 @@ func (c *MockContestRepositoryGetContestCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// GetContestDetails mocks base method.
+func (m *MockContestRepository) GetContestDetails(ctx context.Context, contestIDs []uuid.UUID) ([]*domain.ContestDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContestDetails", ctx, contestIDs)
+	ret0, _ := ret[0].([]*domain.ContestDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContestDetails indicates an expected call of GetContestDetails.
+func (mr *MockContestRepositoryMockRecorder) GetContestDetails(ctx, contestIDs any) *MockContestRepositoryGetContestDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContestDetails", reflect.TypeOf((*MockContestRepository)(nil).GetContestDetails), ctx, contestIDs)
+	return &MockContestRepositoryGetContestDetailsCall{Call: call}
+}
+
+// MockContestRepositoryGetContestDetailsCall wrap *gomock.Call
+type MockContestRepositoryGetContestDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryGetContestDetailsCall) Return(arg0 []*domain.ContestDetail, arg1 error) *MockContestRepositoryGetContestDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryGetContestDetailsCall) Do(f func(context.Context, []uuid.UUID) ([]*domain.ContestDetail, error)) *MockContestRepositoryGetContestDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryGetContestDetailsCall) DoAndReturn(f func(context.Context, []uuid.UUID) ([]*domain.ContestDetail, error)) *MockContestRepositoryGetContestDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetContestRevisionDiff mocks base method.
+func (m *MockContestRepository) GetContestRevisionDiff(ctx context.Context, contestID uuid.UUID, from, to int) ([]*domain.RevisionFieldDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContestRevisionDiff", ctx, contestID, from, to)
+	ret0, _ := ret[0].([]*domain.RevisionFieldDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContestRevisionDiff indicates an expected call of GetContestRevisionDiff.
+func (mr *MockContestRepositoryMockRecorder) GetContestRevisionDiff(ctx, contestID, from, to any) *MockContestRepositoryGetContestRevisionDiffCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContestRevisionDiff", reflect.TypeOf((*MockContestRepository)(nil).GetContestRevisionDiff), ctx, contestID, from, to)
+	return &MockContestRepositoryGetContestRevisionDiffCall{Call: call}
+}
+
+// MockContestRepositoryGetContestRevisionDiffCall wrap *gomock.Call
+type MockContestRepositoryGetContestRevisionDiffCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryGetContestRevisionDiffCall) Return(arg0 []*domain.RevisionFieldDiff, arg1 error) *MockContestRepositoryGetContestRevisionDiffCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryGetContestRevisionDiffCall) Do(f func(context.Context, uuid.UUID, int, int) ([]*domain.RevisionFieldDiff, error)) *MockContestRepositoryGetContestRevisionDiffCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryGetContestRevisionDiffCall) DoAndReturn(f func(context.Context, uuid.UUID, int, int) ([]*domain.RevisionFieldDiff, error)) *MockContestRepositoryGetContestRevisionDiffCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetContestRevisions mocks base method.
+func (m *MockContestRepository) GetContestRevisions(ctx context.Context, contestID uuid.UUID) ([]*domain.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContestRevisions", ctx, contestID)
+	ret0, _ := ret[0].([]*domain.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContestRevisions indicates an expected call of GetContestRevisions.
+func (mr *MockContestRepositoryMockRecorder) GetContestRevisions(ctx, contestID any) *MockContestRepositoryGetContestRevisionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContestRevisions", reflect.TypeOf((*MockContestRepository)(nil).GetContestRevisions), ctx, contestID)
+	return &MockContestRepositoryGetContestRevisionsCall{Call: call}
+}
+
+// MockContestRepositoryGetContestRevisionsCall wrap *gomock.Call
+type MockContestRepositoryGetContestRevisionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryGetContestRevisionsCall) Return(arg0 []*domain.Revision, arg1 error) *MockContestRepositoryGetContestRevisionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryGetContestRevisionsCall) Do(f func(context.Context, uuid.UUID) ([]*domain.Revision, error)) *MockContestRepositoryGetContestRevisionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryGetContestRevisionsCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]*domain.Revision, error)) *MockContestRepositoryGetContestRevisionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetContestTeam mocks base method.
 func (m *MockContestRepository) GetContestTeam(ctx context.Context, contestID, teamID uuid.UUID) (*domain.ContestTeamDetail, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +471,84 @@ func (c *MockContestRepositoryGetContestTeamMembersCall) DoAndReturn(f func(cont
 	return c
 }
 
+// GetContestTeamRevisionDiff mocks base method.
+func (m *MockContestRepository) GetContestTeamRevisionDiff(ctx context.Context, contestID, teamID uuid.UUID, from, to int) ([]*domain.RevisionFieldDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContestTeamRevisionDiff", ctx, contestID, teamID, from, to)
+	ret0, _ := ret[0].([]*domain.RevisionFieldDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContestTeamRevisionDiff indicates an expected call of GetContestTeamRevisionDiff.
+func (mr *MockContestRepositoryMockRecorder) GetContestTeamRevisionDiff(ctx, contestID, teamID, from, to any) *MockContestRepositoryGetContestTeamRevisionDiffCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContestTeamRevisionDiff", reflect.TypeOf((*MockContestRepository)(nil).GetContestTeamRevisionDiff), ctx, contestID, teamID, from, to)
+	return &MockContestRepositoryGetContestTeamRevisionDiffCall{Call: call}
+}
+
+// MockContestRepositoryGetContestTeamRevisionDiffCall wrap *gomock.Call
+type MockContestRepositoryGetContestTeamRevisionDiffCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryGetContestTeamRevisionDiffCall) Return(arg0 []*domain.RevisionFieldDiff, arg1 error) *MockContestRepositoryGetContestTeamRevisionDiffCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryGetContestTeamRevisionDiffCall) Do(f func(context.Context, uuid.UUID, uuid.UUID, int, int) ([]*domain.RevisionFieldDiff, error)) *MockContestRepositoryGetContestTeamRevisionDiffCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryGetContestTeamRevisionDiffCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID, int, int) ([]*domain.RevisionFieldDiff, error)) *MockContestRepositoryGetContestTeamRevisionDiffCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetContestTeamRevisions mocks base method.
+func (m *MockContestRepository) GetContestTeamRevisions(ctx context.Context, contestID, teamID uuid.UUID) ([]*domain.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContestTeamRevisions", ctx, contestID, teamID)
+	ret0, _ := ret[0].([]*domain.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContestTeamRevisions indicates an expected call of GetContestTeamRevisions.
+func (mr *MockContestRepositoryMockRecorder) GetContestTeamRevisions(ctx, contestID, teamID any) *MockContestRepositoryGetContestTeamRevisionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContestTeamRevisions", reflect.TypeOf((*MockContestRepository)(nil).GetContestTeamRevisions), ctx, contestID, teamID)
+	return &MockContestRepositoryGetContestTeamRevisionsCall{Call: call}
+}
+
+// MockContestRepositoryGetContestTeamRevisionsCall wrap *gomock.Call
+type MockContestRepositoryGetContestTeamRevisionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryGetContestTeamRevisionsCall) Return(arg0 []*domain.Revision, arg1 error) *MockContestRepositoryGetContestTeamRevisionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryGetContestTeamRevisionsCall) Do(f func(context.Context, uuid.UUID, uuid.UUID) ([]*domain.Revision, error)) *MockContestRepositoryGetContestTeamRevisionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryGetContestTeamRevisionsCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID) ([]*domain.Revision, error)) *MockContestRepositoryGetContestTeamRevisionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetContestTeams mocks base method.
 func (m *MockContestRepository) GetContestTeams(ctx context.Context, contestID uuid.UUID) ([]*domain.ContestTeam, error) {
 	m.ctrl.T.Helper()
@@ -391,6 +588,45 @@ func (c *MockContestRepositoryGetContestTeamsCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// GetContestTeamsByContestIDs mocks base method.
+func (m *MockContestRepository) GetContestTeamsByContestIDs(ctx context.Context, contestIDs []uuid.UUID) (map[uuid.UUID][]*domain.ContestTeam, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContestTeamsByContestIDs", ctx, contestIDs)
+	ret0, _ := ret[0].(map[uuid.UUID][]*domain.ContestTeam)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContestTeamsByContestIDs indicates an expected call of GetContestTeamsByContestIDs.
+func (mr *MockContestRepositoryMockRecorder) GetContestTeamsByContestIDs(ctx, contestIDs any) *MockContestRepositoryGetContestTeamsByContestIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContestTeamsByContestIDs", reflect.TypeOf((*MockContestRepository)(nil).GetContestTeamsByContestIDs), ctx, contestIDs)
+	return &MockContestRepositoryGetContestTeamsByContestIDsCall{Call: call}
+}
+
+// MockContestRepositoryGetContestTeamsByContestIDsCall wrap *gomock.Call
+type MockContestRepositoryGetContestTeamsByContestIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryGetContestTeamsByContestIDsCall) Return(arg0 map[uuid.UUID][]*domain.ContestTeam, arg1 error) *MockContestRepositoryGetContestTeamsByContestIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryGetContestTeamsByContestIDsCall) Do(f func(context.Context, []uuid.UUID) (map[uuid.UUID][]*domain.ContestTeam, error)) *MockContestRepositoryGetContestTeamsByContestIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryGetContestTeamsByContestIDsCall) DoAndReturn(f func(context.Context, []uuid.UUID) (map[uuid.UUID][]*domain.ContestTeam, error)) *MockContestRepositoryGetContestTeamsByContestIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetContests mocks base method.
 func (m *MockContestRepository) GetContests(ctx context.Context, args *repository.GetContestsArgs) ([]*domain.Contest, error) {
 	m.ctrl.T.Helper()
@@ -419,13 +655,244 @@ func (c *MockContestRepositoryGetContestsCall) Return(arg0 []*domain.Contest, ar
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockContestRepositoryGetContestsCall) Do(f func(context.Context) ([]*domain.Contest, error)) *MockContestRepositoryGetContestsCall {
+func (c *MockContestRepositoryGetContestsCall) Do(f func(context.Context, *repository.GetContestsArgs) ([]*domain.Contest, error)) *MockContestRepositoryGetContestsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryGetContestsCall) DoAndReturn(f func(context.Context, *repository.GetContestsArgs) ([]*domain.Contest, error)) *MockContestRepositoryGetContestsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetFeedContestResults mocks base method.
+func (m *MockContestRepository) GetFeedContestResults(ctx context.Context, limit int) ([]*domain.FeedContestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedContestResults", ctx, limit)
+	ret0, _ := ret[0].([]*domain.FeedContestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedContestResults indicates an expected call of GetFeedContestResults.
+func (mr *MockContestRepositoryMockRecorder) GetFeedContestResults(ctx, limit any) *MockContestRepositoryGetFeedContestResultsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedContestResults", reflect.TypeOf((*MockContestRepository)(nil).GetFeedContestResults), ctx, limit)
+	return &MockContestRepositoryGetFeedContestResultsCall{Call: call}
+}
+
+// MockContestRepositoryGetFeedContestResultsCall wrap *gomock.Call
+type MockContestRepositoryGetFeedContestResultsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryGetFeedContestResultsCall) Return(arg0 []*domain.FeedContestResult, arg1 error) *MockContestRepositoryGetFeedContestResultsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryGetFeedContestResultsCall) Do(f func(context.Context, int) ([]*domain.FeedContestResult, error)) *MockContestRepositoryGetFeedContestResultsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryGetFeedContestResultsCall) DoAndReturn(f func(context.Context, int) ([]*domain.FeedContestResult, error)) *MockContestRepositoryGetFeedContestResultsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetFeedContests mocks base method.
+func (m *MockContestRepository) GetFeedContests(ctx context.Context, limit int) ([]*domain.FeedContest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedContests", ctx, limit)
+	ret0, _ := ret[0].([]*domain.FeedContest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedContests indicates an expected call of GetFeedContests.
+func (mr *MockContestRepositoryMockRecorder) GetFeedContests(ctx, limit any) *MockContestRepositoryGetFeedContestsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedContests", reflect.TypeOf((*MockContestRepository)(nil).GetFeedContests), ctx, limit)
+	return &MockContestRepositoryGetFeedContestsCall{Call: call}
+}
+
+// MockContestRepositoryGetFeedContestsCall wrap *gomock.Call
+type MockContestRepositoryGetFeedContestsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryGetFeedContestsCall) Return(arg0 []*domain.FeedContest, arg1 error) *MockContestRepositoryGetFeedContestsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryGetFeedContestsCall) Do(f func(context.Context, int) ([]*domain.FeedContest, error)) *MockContestRepositoryGetFeedContestsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryGetFeedContestsCall) DoAndReturn(f func(context.Context, int) ([]*domain.FeedContest, error)) *MockContestRepositoryGetFeedContestsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportContestTeams mocks base method.
+func (m *MockContestRepository) ImportContestTeams(ctx context.Context, contestID uuid.UUID, rows []*repository.ImportContestTeamArgs, dryRun bool) ([]*domain.ContestTeamImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportContestTeams", ctx, contestID, rows, dryRun)
+	ret0, _ := ret[0].([]*domain.ContestTeamImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportContestTeams indicates an expected call of ImportContestTeams.
+func (mr *MockContestRepositoryMockRecorder) ImportContestTeams(ctx, contestID, rows, dryRun any) *MockContestRepositoryImportContestTeamsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportContestTeams", reflect.TypeOf((*MockContestRepository)(nil).ImportContestTeams), ctx, contestID, rows, dryRun)
+	return &MockContestRepositoryImportContestTeamsCall{Call: call}
+}
+
+// MockContestRepositoryImportContestTeamsCall wrap *gomock.Call
+type MockContestRepositoryImportContestTeamsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryImportContestTeamsCall) Return(arg0 []*domain.ContestTeamImportResult, arg1 error) *MockContestRepositoryImportContestTeamsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryImportContestTeamsCall) Do(f func(context.Context, uuid.UUID, []*repository.ImportContestTeamArgs, bool) ([]*domain.ContestTeamImportResult, error)) *MockContestRepositoryImportContestTeamsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryImportContestTeamsCall) DoAndReturn(f func(context.Context, uuid.UUID, []*repository.ImportContestTeamArgs, bool) ([]*domain.ContestTeamImportResult, error)) *MockContestRepositoryImportContestTeamsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PublishContest mocks base method.
+func (m *MockContestRepository) PublishContest(ctx context.Context, contestID uuid.UUID, publishAt optional.Of[time.Time]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishContest", ctx, contestID, publishAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishContest indicates an expected call of PublishContest.
+func (mr *MockContestRepositoryMockRecorder) PublishContest(ctx, contestID, publishAt any) *MockContestRepositoryPublishContestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishContest", reflect.TypeOf((*MockContestRepository)(nil).PublishContest), ctx, contestID, publishAt)
+	return &MockContestRepositoryPublishContestCall{Call: call}
+}
+
+// MockContestRepositoryPublishContestCall wrap *gomock.Call
+type MockContestRepositoryPublishContestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryPublishContestCall) Return(arg0 error) *MockContestRepositoryPublishContestCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryPublishContestCall) Do(f func(context.Context, uuid.UUID, optional.Of[time.Time]) error) *MockContestRepositoryPublishContestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryPublishContestCall) DoAndReturn(f func(context.Context, uuid.UUID, optional.Of[time.Time]) error) *MockContestRepositoryPublishContestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RestoreContestRevision mocks base method.
+func (m *MockContestRepository) RestoreContestRevision(ctx context.Context, contestID uuid.UUID, revision int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreContestRevision", ctx, contestID, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreContestRevision indicates an expected call of RestoreContestRevision.
+func (mr *MockContestRepositoryMockRecorder) RestoreContestRevision(ctx, contestID, revision any) *MockContestRepositoryRestoreContestRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreContestRevision", reflect.TypeOf((*MockContestRepository)(nil).RestoreContestRevision), ctx, contestID, revision)
+	return &MockContestRepositoryRestoreContestRevisionCall{Call: call}
+}
+
+// MockContestRepositoryRestoreContestRevisionCall wrap *gomock.Call
+type MockContestRepositoryRestoreContestRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryRestoreContestRevisionCall) Return(arg0 error) *MockContestRepositoryRestoreContestRevisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryRestoreContestRevisionCall) Do(f func(context.Context, uuid.UUID, int) error) *MockContestRepositoryRestoreContestRevisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContestRepositoryRestoreContestRevisionCall) DoAndReturn(f func(context.Context, uuid.UUID, int) error) *MockContestRepositoryRestoreContestRevisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RestoreContestTeamRevision mocks base method.
+func (m *MockContestRepository) RestoreContestTeamRevision(ctx context.Context, contestID, teamID uuid.UUID, revision int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreContestTeamRevision", ctx, contestID, teamID, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreContestTeamRevision indicates an expected call of RestoreContestTeamRevision.
+func (mr *MockContestRepositoryMockRecorder) RestoreContestTeamRevision(ctx, contestID, teamID, revision any) *MockContestRepositoryRestoreContestTeamRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreContestTeamRevision", reflect.TypeOf((*MockContestRepository)(nil).RestoreContestTeamRevision), ctx, contestID, teamID, revision)
+	return &MockContestRepositoryRestoreContestTeamRevisionCall{Call: call}
+}
+
+// MockContestRepositoryRestoreContestTeamRevisionCall wrap *gomock.Call
+type MockContestRepositoryRestoreContestTeamRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContestRepositoryRestoreContestTeamRevisionCall) Return(arg0 error) *MockContestRepositoryRestoreContestTeamRevisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContestRepositoryRestoreContestTeamRevisionCall) Do(f func(context.Context, uuid.UUID, uuid.UUID, int) error) *MockContestRepositoryRestoreContestTeamRevisionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockContestRepositoryGetContestsCall) DoAndReturn(f func(context.Context) ([]*domain.Contest, error)) *MockContestRepositoryGetContestsCall {
+func (c *MockContestRepositoryRestoreContestTeamRevisionCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID, int) error) *MockContestRepositoryRestoreContestTeamRevisionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -110,13 +110,13 @@ func (c *MockGroupRepositoryGetGroupsCall) Return(arg0 []*domain.Group, arg1 err
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockGroupRepositoryGetGroupsCall) Do(f func(context.Context) ([]*domain.Group, error)) *MockGroupRepositoryGetGroupsCall {
+func (c *MockGroupRepositoryGetGroupsCall) Do(f func(context.Context, *repository.GetGroupsArgs) ([]*domain.Group, error)) *MockGroupRepositoryGetGroupsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockGroupRepositoryGetGroupsCall) DoAndReturn(f func(context.Context) ([]*domain.Group, error)) *MockGroupRepositoryGetGroupsCall {
+func (c *MockGroupRepositoryGetGroupsCall) DoAndReturn(f func(context.Context, *repository.GetGroupsArgs) ([]*domain.Group, error)) *MockGroupRepositoryGetGroupsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
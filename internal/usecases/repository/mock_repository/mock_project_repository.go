@@ -12,9 +12,11 @@ package mock_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/gofrs/uuid"
 	domain "github.com/traPtitech/traPortfolio/internal/domain"
+	optional "github.com/traPtitech/traPortfolio/internal/pkgs/optional"
 	repository "github.com/traPtitech/traPortfolio/internal/usecases/repository"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -158,6 +160,45 @@ func (c *MockProjectRepositoryEditProjectMembersCall) DoAndReturn(f func(context
 	return c
 }
 
+// GetFeedProjects mocks base method.
+func (m *MockProjectRepository) GetFeedProjects(ctx context.Context, limit int) ([]*domain.FeedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedProjects", ctx, limit)
+	ret0, _ := ret[0].([]*domain.FeedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedProjects indicates an expected call of GetFeedProjects.
+func (mr *MockProjectRepositoryMockRecorder) GetFeedProjects(ctx, limit any) *MockProjectRepositoryGetFeedProjectsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedProjects", reflect.TypeOf((*MockProjectRepository)(nil).GetFeedProjects), ctx, limit)
+	return &MockProjectRepositoryGetFeedProjectsCall{Call: call}
+}
+
+// MockProjectRepositoryGetFeedProjectsCall wrap *gomock.Call
+type MockProjectRepositoryGetFeedProjectsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectRepositoryGetFeedProjectsCall) Return(arg0 []*domain.FeedProject, arg1 error) *MockProjectRepositoryGetFeedProjectsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectRepositoryGetFeedProjectsCall) Do(f func(context.Context, int) ([]*domain.FeedProject, error)) *MockProjectRepositoryGetFeedProjectsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectRepositoryGetFeedProjectsCall) DoAndReturn(f func(context.Context, int) ([]*domain.FeedProject, error)) *MockProjectRepositoryGetFeedProjectsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetProject mocks base method.
 func (m *MockProjectRepository) GetProject(ctx context.Context, projectID uuid.UUID) (*domain.ProjectDetail, error) {
 	m.ctrl.T.Helper()
@@ -197,6 +238,45 @@ func (c *MockProjectRepositoryGetProjectCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// GetProjectDetails mocks base method.
+func (m *MockProjectRepository) GetProjectDetails(ctx context.Context, projectIDs []uuid.UUID) ([]*domain.ProjectDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectDetails", ctx, projectIDs)
+	ret0, _ := ret[0].([]*domain.ProjectDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectDetails indicates an expected call of GetProjectDetails.
+func (mr *MockProjectRepositoryMockRecorder) GetProjectDetails(ctx, projectIDs any) *MockProjectRepositoryGetProjectDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDetails", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectDetails), ctx, projectIDs)
+	return &MockProjectRepositoryGetProjectDetailsCall{Call: call}
+}
+
+// MockProjectRepositoryGetProjectDetailsCall wrap *gomock.Call
+type MockProjectRepositoryGetProjectDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectRepositoryGetProjectDetailsCall) Return(arg0 []*domain.ProjectDetail, arg1 error) *MockProjectRepositoryGetProjectDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectRepositoryGetProjectDetailsCall) Do(f func(context.Context, []uuid.UUID) ([]*domain.ProjectDetail, error)) *MockProjectRepositoryGetProjectDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectRepositoryGetProjectDetailsCall) DoAndReturn(f func(context.Context, []uuid.UUID) ([]*domain.ProjectDetail, error)) *MockProjectRepositoryGetProjectDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetProjectMembers mocks base method.
 func (m *MockProjectRepository) GetProjectMembers(ctx context.Context, projectID uuid.UUID) ([]*domain.UserWithDuration, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +316,84 @@ func (c *MockProjectRepositoryGetProjectMembersCall) DoAndReturn(f func(context.
 	return c
 }
 
+// GetProjectRevisionDiff mocks base method.
+func (m *MockProjectRepository) GetProjectRevisionDiff(ctx context.Context, projectID uuid.UUID, from, to int) ([]*domain.RevisionFieldDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectRevisionDiff", ctx, projectID, from, to)
+	ret0, _ := ret[0].([]*domain.RevisionFieldDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectRevisionDiff indicates an expected call of GetProjectRevisionDiff.
+func (mr *MockProjectRepositoryMockRecorder) GetProjectRevisionDiff(ctx, projectID, from, to any) *MockProjectRepositoryGetProjectRevisionDiffCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectRevisionDiff", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectRevisionDiff), ctx, projectID, from, to)
+	return &MockProjectRepositoryGetProjectRevisionDiffCall{Call: call}
+}
+
+// MockProjectRepositoryGetProjectRevisionDiffCall wrap *gomock.Call
+type MockProjectRepositoryGetProjectRevisionDiffCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectRepositoryGetProjectRevisionDiffCall) Return(arg0 []*domain.RevisionFieldDiff, arg1 error) *MockProjectRepositoryGetProjectRevisionDiffCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectRepositoryGetProjectRevisionDiffCall) Do(f func(context.Context, uuid.UUID, int, int) ([]*domain.RevisionFieldDiff, error)) *MockProjectRepositoryGetProjectRevisionDiffCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectRepositoryGetProjectRevisionDiffCall) DoAndReturn(f func(context.Context, uuid.UUID, int, int) ([]*domain.RevisionFieldDiff, error)) *MockProjectRepositoryGetProjectRevisionDiffCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetProjectRevisions mocks base method.
+func (m *MockProjectRepository) GetProjectRevisions(ctx context.Context, projectID uuid.UUID) ([]*domain.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectRevisions", ctx, projectID)
+	ret0, _ := ret[0].([]*domain.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectRevisions indicates an expected call of GetProjectRevisions.
+func (mr *MockProjectRepositoryMockRecorder) GetProjectRevisions(ctx, projectID any) *MockProjectRepositoryGetProjectRevisionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectRevisions", reflect.TypeOf((*MockProjectRepository)(nil).GetProjectRevisions), ctx, projectID)
+	return &MockProjectRepositoryGetProjectRevisionsCall{Call: call}
+}
+
+// MockProjectRepositoryGetProjectRevisionsCall wrap *gomock.Call
+type MockProjectRepositoryGetProjectRevisionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectRepositoryGetProjectRevisionsCall) Return(arg0 []*domain.Revision, arg1 error) *MockProjectRepositoryGetProjectRevisionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectRepositoryGetProjectRevisionsCall) Do(f func(context.Context, uuid.UUID) ([]*domain.Revision, error)) *MockProjectRepositoryGetProjectRevisionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectRepositoryGetProjectRevisionsCall) DoAndReturn(f func(context.Context, uuid.UUID) ([]*domain.Revision, error)) *MockProjectRepositoryGetProjectRevisionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetProjects mocks base method.
 func (m *MockProjectRepository) GetProjects(ctx context.Context, args *repository.GetProjectsArgs) ([]*domain.Project, error) {
 	m.ctrl.T.Helper()
@@ -264,13 +422,89 @@ func (c *MockProjectRepositoryGetProjectsCall) Return(arg0 []*domain.Project, ar
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockProjectRepositoryGetProjectsCall) Do(f func(context.Context) ([]*domain.Project, error)) *MockProjectRepositoryGetProjectsCall {
+func (c *MockProjectRepositoryGetProjectsCall) Do(f func(context.Context, *repository.GetProjectsArgs) ([]*domain.Project, error)) *MockProjectRepositoryGetProjectsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectRepositoryGetProjectsCall) DoAndReturn(f func(context.Context, *repository.GetProjectsArgs) ([]*domain.Project, error)) *MockProjectRepositoryGetProjectsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PublishProject mocks base method.
+func (m *MockProjectRepository) PublishProject(ctx context.Context, projectID uuid.UUID, publishAt optional.Of[time.Time]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishProject", ctx, projectID, publishAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishProject indicates an expected call of PublishProject.
+func (mr *MockProjectRepositoryMockRecorder) PublishProject(ctx, projectID, publishAt any) *MockProjectRepositoryPublishProjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProject", reflect.TypeOf((*MockProjectRepository)(nil).PublishProject), ctx, projectID, publishAt)
+	return &MockProjectRepositoryPublishProjectCall{Call: call}
+}
+
+// MockProjectRepositoryPublishProjectCall wrap *gomock.Call
+type MockProjectRepositoryPublishProjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectRepositoryPublishProjectCall) Return(arg0 error) *MockProjectRepositoryPublishProjectCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectRepositoryPublishProjectCall) Do(f func(context.Context, uuid.UUID, optional.Of[time.Time]) error) *MockProjectRepositoryPublishProjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProjectRepositoryPublishProjectCall) DoAndReturn(f func(context.Context, uuid.UUID, optional.Of[time.Time]) error) *MockProjectRepositoryPublishProjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RestoreProjectRevision mocks base method.
+func (m *MockProjectRepository) RestoreProjectRevision(ctx context.Context, projectID uuid.UUID, revision int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProjectRevision", ctx, projectID, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreProjectRevision indicates an expected call of RestoreProjectRevision.
+func (mr *MockProjectRepositoryMockRecorder) RestoreProjectRevision(ctx, projectID, revision any) *MockProjectRepositoryRestoreProjectRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProjectRevision", reflect.TypeOf((*MockProjectRepository)(nil).RestoreProjectRevision), ctx, projectID, revision)
+	return &MockProjectRepositoryRestoreProjectRevisionCall{Call: call}
+}
+
+// MockProjectRepositoryRestoreProjectRevisionCall wrap *gomock.Call
+type MockProjectRepositoryRestoreProjectRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProjectRepositoryRestoreProjectRevisionCall) Return(arg0 error) *MockProjectRepositoryRestoreProjectRevisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProjectRepositoryRestoreProjectRevisionCall) Do(f func(context.Context, uuid.UUID, int) error) *MockProjectRepositoryRestoreProjectRevisionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockProjectRepositoryGetProjectsCall) DoAndReturn(f func(context.Context) ([]*domain.Project, error)) *MockProjectRepositoryGetProjectsCall {
+func (c *MockProjectRepositoryRestoreProjectRevisionCall) DoAndReturn(f func(context.Context, uuid.UUID, int) error) *MockProjectRepositoryRestoreProjectRevisionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -120,6 +120,44 @@ func (c *MockUserRepositoryDeleteAccountCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// EditUserFeaturedItems mocks base method.
+func (m *MockUserRepository) EditUserFeaturedItems(ctx context.Context, userID uuid.UUID, args []*repository.EditUserFeaturedItemArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditUserFeaturedItems", ctx, userID, args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditUserFeaturedItems indicates an expected call of EditUserFeaturedItems.
+func (mr *MockUserRepositoryMockRecorder) EditUserFeaturedItems(ctx, userID, args any) *MockUserRepositoryEditUserFeaturedItemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditUserFeaturedItems", reflect.TypeOf((*MockUserRepository)(nil).EditUserFeaturedItems), ctx, userID, args)
+	return &MockUserRepositoryEditUserFeaturedItemsCall{Call: call}
+}
+
+// MockUserRepositoryEditUserFeaturedItemsCall wrap *gomock.Call
+type MockUserRepositoryEditUserFeaturedItemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserRepositoryEditUserFeaturedItemsCall) Return(arg0 error) *MockUserRepositoryEditUserFeaturedItemsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserRepositoryEditUserFeaturedItemsCall) Do(f func(context.Context, uuid.UUID, []*repository.EditUserFeaturedItemArgs) error) *MockUserRepositoryEditUserFeaturedItemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserRepositoryEditUserFeaturedItemsCall) DoAndReturn(f func(context.Context, uuid.UUID, []*repository.EditUserFeaturedItemArgs) error) *MockUserRepositoryEditUserFeaturedItemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetAccount mocks base method.
 func (m *MockUserRepository) GetAccount(ctx context.Context, userID, accountID uuid.UUID) (*domain.Account, error) {
 	m.ctrl.T.Helper()
@@ -354,6 +392,45 @@ func (c *MockUserRepositoryGetUserCall) DoAndReturn(f func(context.Context, uuid
 	return c
 }
 
+// GetUserDetails mocks base method.
+func (m *MockUserRepository) GetUserDetails(ctx context.Context, userIDs []uuid.UUID) ([]*domain.UserDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDetails", ctx, userIDs)
+	ret0, _ := ret[0].([]*domain.UserDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDetails indicates an expected call of GetUserDetails.
+func (mr *MockUserRepositoryMockRecorder) GetUserDetails(ctx, userIDs any) *MockUserRepositoryGetUserDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDetails", reflect.TypeOf((*MockUserRepository)(nil).GetUserDetails), ctx, userIDs)
+	return &MockUserRepositoryGetUserDetailsCall{Call: call}
+}
+
+// MockUserRepositoryGetUserDetailsCall wrap *gomock.Call
+type MockUserRepositoryGetUserDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserRepositoryGetUserDetailsCall) Return(arg0 []*domain.UserDetail, arg1 error) *MockUserRepositoryGetUserDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserRepositoryGetUserDetailsCall) Do(f func(context.Context, []uuid.UUID) ([]*domain.UserDetail, error)) *MockUserRepositoryGetUserDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserRepositoryGetUserDetailsCall) DoAndReturn(f func(context.Context, []uuid.UUID) ([]*domain.UserDetail, error)) *MockUserRepositoryGetUserDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUsers mocks base method.
 func (m *MockUserRepository) GetUsers(ctx context.Context, args *repository.GetUsersArgs) ([]*domain.User, error) {
 	m.ctrl.T.Helper()
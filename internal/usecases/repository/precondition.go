@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"slices"
+)
+
+type versionKey struct{}
+
+// WithVersions 編集前のリソースの版番号がversionsのいずれかと一致する場合のみ編集させることをctxに記録します
+// If-Matchに対応し、versionsが空の場合はどの版でも編集させません
+func WithVersions(ctx context.Context, versions ...int) context.Context {
+	return context.WithValue(ctx, versionKey{}, versions)
+}
+
+// CheckVersion 編集するリソースの現在の版番号がctxに記録された条件を満たすか確かめます
+// 満たさない場合はErrPreconditionFailedを返し、記録されていない場合は常にnilを返します
+func CheckVersion(ctx context.Context, version int) error {
+	versions, ok := ctx.Value(versionKey{}).([]int)
+	if !ok || slices.Contains(versions, version) {
+		return nil
+	}
+
+	return ErrPreconditionFailed
+}
@@ -0,0 +1,12 @@
+//go:generate go run go.uber.org/mock/mockgen@latest -typed -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package repository
+
+import (
+	"context"
+)
+
+type ProjectGitHubRepository interface {
+	// SyncProjectGitHubRepositories リンク先がGitHubのリポジトリである全てのプロジェクトについて、リポジトリの情報を取得して保存する
+	SyncProjectGitHubRepositories(ctx context.Context) error
+}
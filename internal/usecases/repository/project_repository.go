@@ -4,6 +4,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/traPtitech/traPortfolio/internal/domain"
@@ -12,26 +13,37 @@ import (
 
 type GetProjectsArgs struct {
 	Limit optional.Of[int]
+	IDs   []uuid.UUID // 指定した場合はいずれかのIDのプロジェクトに絞り込む
 }
 
 type CreateProjectArgs struct {
 	Name          string
+	NameEn        optional.Of[string] // 英語のプロジェクト名
 	Description   string
+	DescriptionEn optional.Of[string] // 英語のプロジェクト説明
+	Body          optional.Of[string]
 	Link          optional.Of[string]
 	SinceYear     int
 	SinceSemester int
 	UntilYear     int
 	UntilSemester int
+	Visibility    optional.Of[domain.Visibility]
+	Draft         optional.Of[bool]      // 下書きとして作成するかどうか
+	PublishAt     optional.Of[time.Time] // 下書きの公開予定日時
 }
 
 type UpdateProjectArgs struct {
 	Name          optional.Of[string]
+	NameEn        optional.Of[string]
 	Description   optional.Of[string]
+	DescriptionEn optional.Of[string]
+	Body          optional.Of[string]
 	Link          optional.Of[string]
 	SinceYear     optional.Of[int64]
 	SinceSemester optional.Of[int64]
 	UntilYear     optional.Of[int64]
 	UntilSemester optional.Of[int64]
+	Visibility    optional.Of[domain.Visibility]
 }
 
 type EditProjectMemberArgs struct {
@@ -45,9 +57,18 @@ type EditProjectMemberArgs struct {
 type ProjectRepository interface {
 	GetProjects(ctx context.Context, args *GetProjectsArgs) ([]*domain.Project, error)
 	GetProject(ctx context.Context, projectID uuid.UUID) (*domain.ProjectDetail, error)
+	// GetProjectDetails 複数のプロジェクトの詳細情報をメンバーも含めてまとめて取得する
+	// 存在しないか閲覧できないプロジェクトのIDは結果に含めない。GitHubリポジトリの情報は取得しない
+	GetProjectDetails(ctx context.Context, projectIDs []uuid.UUID) ([]*domain.ProjectDetail, error)
 	CreateProject(ctx context.Context, args *CreateProjectArgs) (*domain.ProjectDetail, error)
 	UpdateProject(ctx context.Context, projectID uuid.UUID, args *UpdateProjectArgs) error
 	DeleteProject(ctx context.Context, projectID uuid.UUID) error
+	PublishProject(ctx context.Context, projectID uuid.UUID, publishAt optional.Of[time.Time]) error
 	GetProjectMembers(ctx context.Context, projectID uuid.UUID) ([]*domain.UserWithDuration, error)
 	EditProjectMembers(ctx context.Context, projectID uuid.UUID, args []*EditProjectMemberArgs) error
+	GetProjectRevisions(ctx context.Context, projectID uuid.UUID) ([]*domain.Revision, error)
+	GetProjectRevisionDiff(ctx context.Context, projectID uuid.UUID, from int, to int) ([]*domain.RevisionFieldDiff, error)
+	RestoreProjectRevision(ctx context.Context, projectID uuid.UUID, revision int) error
+	// GetFeedProjects 公開されているプロジェクトを新しく追加された順にlimit件取得する
+	GetFeedProjects(ctx context.Context, limit int) ([]*domain.FeedProject, error)
 }
@@ -14,11 +14,13 @@ type GetUsersArgs struct {
 	IncludeSuspended optional.Of[bool]
 	Name             optional.Of[string]
 	Limit            optional.Of[int]
+	IDs              []uuid.UUID // 指定した場合はいずれかのIDのユーザーに絞り込む (非アクティブユーザーも含む)
 }
 
 type UpdateUserArgs struct {
-	Description optional.Of[string]
-	Check       optional.Of[bool]
+	Description   optional.Of[string]
+	DescriptionEn optional.Of[string] // 英語の自己紹介
+	Check         optional.Of[bool]
 }
 
 type CreateAccountArgs struct {
@@ -33,17 +35,28 @@ type UpdateAccountArgs struct {
 	URL         optional.Of[string]
 }
 
+type EditUserFeaturedItemArgs struct {
+	Type domain.FeaturedItemType
+	ID   uuid.UUID // プロジェクトUUIDまたはコンテストチームUUID
+}
+
 type UserRepository interface {
 	GetUsers(ctx context.Context, args *GetUsersArgs) ([]*domain.User, error)
 	SyncUsers(ctx context.Context) error
 	GetUser(ctx context.Context, userID uuid.UUID) (*domain.UserDetail, error)
+	// GetUserDetails 複数のユーザーの詳細情報をまとめて取得する
+	// 存在しないユーザーのIDは結果に含めない。固定表示する項目(Featured)は取得しない
+	GetUserDetails(ctx context.Context, userIDs []uuid.UUID) ([]*domain.UserDetail, error)
 	UpdateUser(ctx context.Context, userID uuid.UUID, args *UpdateUserArgs) error
 	GetAccounts(ctx context.Context, userID uuid.UUID) ([]*domain.Account, error)
 	GetAccount(ctx context.Context, userID uuid.UUID, accountID uuid.UUID) (*domain.Account, error)
 	CreateAccount(ctx context.Context, userID uuid.UUID, args *CreateAccountArgs) (*domain.Account, error)
 	UpdateAccount(ctx context.Context, userID uuid.UUID, accountID uuid.UUID, args *UpdateAccountArgs) error
 	DeleteAccount(ctx context.Context, userID uuid.UUID, accountID uuid.UUID) error
+	// GetProjects プロジェクトの期間が新しい順に返す
 	GetProjects(ctx context.Context, userID uuid.UUID) ([]*domain.UserProject, error)
+	// GetContests コンテストの開始日時が新しい順に返す
 	GetContests(ctx context.Context, userID uuid.UUID) ([]*domain.UserContest, error)
 	GetGroupsByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.UserGroup, error)
+	EditUserFeaturedItems(ctx context.Context, userID uuid.UUID, args []*EditUserFeaturedItemArgs) error
 }
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/traPtitech/traPortfolio/internal/domain"
+)
+
+type memberKey struct{}
+
+// WithMember 認証済みのメンバーからのアクセスであることをctxに記録します
+// 記録されていない場合、非公開のプロジェクトやコンテストなどは取得されません
+func WithMember(ctx context.Context) context.Context {
+	return context.WithValue(ctx, memberKey{}, true)
+}
+
+// WithGuest メンバーからのアクセスでも、公開されている情報だけを取得させます
+// 誰にでも共有されるプレビュー画像などを作るときに使います
+func WithGuest(ctx context.Context) context.Context {
+	return context.WithValue(ctx, memberKey{}, false)
+}
+
+// IsMember 認証済みのメンバーからのアクセスかどうか
+func IsMember(ctx context.Context) bool {
+	v, _ := ctx.Value(memberKey{}).(bool)
+	return v
+}
+
+type langKey struct{}
+
+// WithLang 閲覧者が希望するコンテンツの言語をctxに記録します
+func WithLang(ctx context.Context, lang domain.Lang) context.Context {
+	return context.WithValue(ctx, langKey{}, lang)
+}
+
+// LangFrom 閲覧者が希望するコンテンツの言語
+// 記録されていない場合は日本語を返します
+func LangFrom(ctx context.Context) domain.Lang {
+	v, _ := ctx.Value(langKey{}).(domain.Lang)
+	return v
+}
@@ -1,14 +1,18 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/traPortfolio/internal/domain"
 	"github.com/traPtitech/traPortfolio/internal/handler"
 	"github.com/traPtitech/traPortfolio/internal/infrastructure/repository"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/config"
 	"github.com/traPtitech/traPortfolio/internal/pkgs/mockdata"
+	"golang.org/x/net/http2"
 )
 
 func main() {
@@ -17,6 +21,10 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if err := setupAccountTypes(appConf.AccountTypes); err != nil {
+		log.Fatal(err)
+	}
+
 	db, err := repository.NewGormDB(appConf.DB)
 	if err != nil {
 		log.Fatal(err)
@@ -37,16 +45,79 @@ func main() {
 		}
 	}
 
-	api, err := injectIntoAPIServer(appConf, db)
+	accountStatsRepo, err := injectAccountStatsRepository(appConf, db)
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	projectGitHubRepo, err := injectProjectGitHubRepository(appConf, db)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	api, err := injectIntoAPIServer(appConf, db, accountStatsRepo)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if dir := appConf.Snapshot.Dir; dir != "" {
+		opts := handler.SnapshotOptions{HTML: appConf.Snapshot.HTML}
+		if err := handler.WriteSnapshot(context.Background(), api, dir, opts); err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("snapshot written to %s", dir)
+		return
+	}
+
+	if interval := appConf.Competitive.SyncInterval; interval > 0 {
+		go syncPeriodically("account stats", interval, accountStatsRepo.SyncAccountStats)
+	}
+	if interval := appConf.GitHub.SyncInterval; interval > 0 {
+		go syncPeriodically("github repositories of projects", interval, projectGitHubRepo.SyncProjectGitHubRepositories)
+	}
+
 	e := echo.New()
 	if err := handler.Setup(appConf.IsProduction, e, api, handler.WithRequestLogger()); err != nil {
 		log.Fatal(err)
 	}
 
 	// Start server
-	e.Logger.Fatal(e.Start(fmt.Sprintf(":%d", appConf.Port)))
+	// gRPCのクライアントはHTTP/2で接続するため、TLSを使わないHTTP/2(h2c)でも受け付ける
+	e.Logger.Fatal(e.StartH2CServer(fmt.Sprintf(":%d", appConf.Port), &http2.Server{}))
+}
+
+// setupAccountTypes 設定ファイルで定義された外部アカウントの種類を登録する
+func setupAccountTypes(confs []config.AccountTypeConfig) error {
+	defs := make([]*domain.AccountTypeDefinition, len(confs))
+	for i, c := range confs {
+		d, err := domain.NewAccountTypeDefinition(domain.AccountType(c.ID), c.Label, c.URLPattern, c.HandlePattern, c.URLTemplate, c.Icon)
+		if err != nil {
+			return err
+		}
+		defs[i] = d
+	}
+
+	r, err := domain.NewAccountTypeRegistry(defs...)
+	if err != nil {
+		return err
+	}
+	domain.SetAccountTypes(r)
+
+	return nil
+}
+
+// syncPeriodically 外部サービスから情報を定期的に取得する
+// 取得に失敗した場合もログに出力して次の取得を待つ
+func syncPeriodically(name string, interval time.Duration, sync func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := sync(context.Background()); err != nil {
+			log.Printf("failed to sync %s: %v", name, err)
+		}
+
+		<-ticker.C
+	}
 }
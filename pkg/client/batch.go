@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gofrs/uuid"
+)
+
+// BatchMaxIDs 一括取得の1回のリクエストで指定できるUUIDの数の上限
+const BatchMaxIDs = 1000
+
+// BatchGetAllUsers idsをBatchMaxIDs件ずつのPOST /users/batchのリクエストに分けて取得し、結果をidsの順にまとめる
+// 途中のリクエストが失敗した場合は、それまでの結果を捨ててエラーを返す
+func (c *ClientWithResponses) BatchGetAllUsers(ctx context.Context, ids []uuid.UUID, reqEditors ...RequestEditorFn) (*UsersBatch, error) {
+	users, missing, err := batchGetAll(ids, func(chunk []uuid.UUID) ([]User, MissingIds, error) {
+		res, err := c.BatchGetUsersWithResponse(ctx, BatchGetUsersJSONRequestBody{Ids: chunk}, reqEditors...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return res.JSON200.Users, res.JSON200.Missing, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UsersBatch{Users: users, Missing: missing}, nil
+}
+
+// BatchGetAllProjects idsをBatchMaxIDs件ずつのPOST /projects/batchのリクエストに分けて取得し、結果をidsの順にまとめる
+// 途中のリクエストが失敗した場合は、それまでの結果を捨ててエラーを返す
+func (c *ClientWithResponses) BatchGetAllProjects(ctx context.Context, ids []uuid.UUID, reqEditors ...RequestEditorFn) (*ProjectsBatch, error) {
+	projects, missing, err := batchGetAll(ids, func(chunk []uuid.UUID) ([]Project, MissingIds, error) {
+		res, err := c.BatchGetProjectsWithResponse(ctx, BatchGetProjectsJSONRequestBody{Ids: chunk}, reqEditors...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return res.JSON200.Projects, res.JSON200.Missing, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectsBatch{Projects: projects, Missing: missing}, nil
+}
+
+// BatchGetAllContests idsをBatchMaxIDs件ずつのPOST /contests/batchのリクエストに分けて取得し、結果をidsの順にまとめる
+// 途中のリクエストが失敗した場合は、それまでの結果を捨ててエラーを返す
+func (c *ClientWithResponses) BatchGetAllContests(ctx context.Context, ids []uuid.UUID, reqEditors ...RequestEditorFn) (*ContestsBatch, error) {
+	contests, missing, err := batchGetAll(ids, func(chunk []uuid.UUID) ([]Contest, MissingIds, error) {
+		res, err := c.BatchGetContestsWithResponse(ctx, BatchGetContestsJSONRequestBody{Ids: chunk}, reqEditors...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return res.JSON200.Contests, res.JSON200.Missing, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContestsBatch{Contests: contests, Missing: missing}, nil
+}
+
+// batchGetAll APIは1件以上のUUIDを要求するため、idsが空の場合はリクエストしない
+func batchGetAll[T any](ids []uuid.UUID, get func(chunk []uuid.UUID) ([]T, MissingIds, error)) ([]T, MissingIds, error) {
+	items := make([]T, 0, len(ids))
+	missing := make(MissingIds, 0)
+	for chunk := range slices.Chunk(ids, BatchMaxIDs) {
+		v, m, err := get(chunk)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, v...)
+		missing = append(missing, m...)
+	}
+
+	return items, missing, nil
+}
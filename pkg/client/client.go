@@ -0,0 +1,50 @@
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@latest --config .oapi.client.yml ../../docs/swagger/traPortfolio.v1.yaml
+
+// Package client traPortfolioのAPIのクライアント
+// 各操作のメソッドはdocs/swagger/traPortfolio.v1.yamlから生成している
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// New サーバーのURL (例: https://portfolio.trap.jp/api/v1) を指定してクライアントを作る
+// 4xx, 5xxのレスポンスはメソッドの戻り値の*Errorとして返すため、成功したレスポンスのみ値を確かめればよい
+func New(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	return NewClientWithResponses(server, append(opts, withErrorResponse())...)
+}
+
+// WithForwardedUser 認証プロキシを通さずにAPIを呼ぶ場合に、リクエストしたユーザーのtraP IDを指定する
+func WithForwardedUser(name string) ClientOption {
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("X-Forwarded-User", name)
+		return nil
+	})
+}
+
+// WithBearerToken APIの前段の認証プロキシが要求するアクセストークンを指定する
+func WithBearerToken(token string) ClientOption {
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// withErrorResponse ほかのオプションで指定されたHTTPクライアントを包むため、最後に適用する
+func withErrorResponse() ClientOption {
+	return func(c *Client) error {
+		doer := c.Client
+		if doer == nil {
+			doer = http.DefaultClient
+		}
+		c.Client = &errorResponseDoer{doer: doer}
+
+		return nil
+	}
+}
+
+// Ptr 省略可能なパラメーターを指定する
+func Ptr[T any](v T) *T {
+	return &v
+}
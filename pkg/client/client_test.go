@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/traPortfolio/internal/domain"
+	"github.com/traPtitech/traPortfolio/internal/handler"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/optional"
+	"github.com/traPtitech/traPortfolio/internal/pkgs/random"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository"
+	"github.com/traPtitech/traPortfolio/internal/usecases/repository/mock_repository"
+	"go.uber.org/mock/gomock"
+)
+
+// setupServer モックのリポジトリを使うユーザーのAPIのサーバーを立てる
+func setupServer(t *testing.T) (*mock_repository.MockUserRepository, string) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	user := mock_repository.NewMockUserRepository(ctrl)
+	api := handler.NewAPI(nil, handler.NewUserHandler(user, nil, nil), nil, nil, nil, nil, nil, nil, nil)
+
+	e := echo.New()
+	assert.NoError(t, handler.Setup(false, e, api))
+	s := httptest.NewServer(e)
+	t.Cleanup(s.Close)
+
+	return user, s.URL + "/api/v1"
+}
+
+func TestClient_GetUsers(t *testing.T) {
+	t.Parallel()
+
+	user, url := setupServer(t)
+	u := domain.NewUser(random.UUID(), "user1", "User One", true)
+	user.EXPECT().GetUsers(gomock.Any(), &repository.GetUsersArgs{Limit: optional.From(1)}).Return([]*domain.User{u}, nil)
+
+	c, err := New(url)
+	assert.NoError(t, err)
+
+	res, err := c.GetUsersWithResponse(context.Background(), &GetUsersParams{Limit: Ptr(1)})
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, res.StatusCode())
+		assert.Equal(t, []User{{Id: u.ID, Name: "user1", RealName: "User One"}}, *res.JSON200)
+	}
+}
+
+func TestClient_Error(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		repoErr    error
+		statusCode int
+		want       error
+	}{
+		{"not found", repository.ErrNotFound, http.StatusNotFound, ErrNotFound},
+		{"invalid arg", repository.ErrValidate, http.StatusBadRequest, ErrInvalidArg},
+		{"forbidden", repository.ErrForbidden, http.StatusForbidden, ErrForbidden},
+		{"already exists", repository.ErrAlreadyExists, http.StatusConflict, ErrAlreadyExists},
+		{"precondition failed", repository.ErrPreconditionFailed, http.StatusPreconditionFailed, ErrPreconditionFailed},
+		{"internal", errors.New("internal error"), http.StatusInternalServerError, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			user, url := setupServer(t)
+			userID := random.UUID()
+			user.EXPECT().GetUser(gomock.Any(), userID).Return(nil, tt.repoErr)
+
+			c, err := New(url)
+			assert.NoError(t, err)
+
+			res, err := c.GetUserWithResponse(context.Background(), userID, nil)
+			assert.Nil(t, res)
+
+			var e *Error
+			if assert.ErrorAs(t, err, &e) {
+				assert.Equal(t, tt.statusCode, e.StatusCode)
+				assert.NotEmpty(t, e.Message)
+			}
+			if tt.want != nil {
+				assert.ErrorIs(t, err, tt.want)
+			} else {
+				assert.NoError(t, e.Unwrap())
+			}
+		})
+	}
+}
+
+func TestClient_Auth(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "user1", r.Header.Get("X-Forwarded-User"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	}))
+	t.Cleanup(s.Close)
+
+	c, err := New(s.URL, WithForwardedUser("user1"), WithBearerToken("token"), WithHTTPClient(s.Client()))
+	assert.NoError(t, err)
+
+	_, err = c.GetMeWithResponse(context.Background())
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	var e *Error
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, "unauthorized\n", e.Message)
+	}
+}
+
+func TestClient_BatchGetAllUsers(t *testing.T) {
+	t.Parallel()
+
+	user, url := setupServer(t)
+	ids := make([]uuid.UUID, BatchMaxIDs+1)
+	for i := range ids {
+		ids[i] = random.UUID()
+	}
+	missingID := ids[len(ids)-1]
+	// BatchMaxIDs件ずつ2回に分けてリクエストされる
+	user.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, args *repository.GetUsersArgs) ([]*domain.User, error) {
+		assert.LessOrEqual(t, len(args.IDs), BatchMaxIDs)
+		users := make([]*domain.User, 0, len(args.IDs))
+		for _, id := range args.IDs {
+			if id != missingID {
+				users = append(users, domain.NewUser(id, "user", "User", true))
+			}
+		}
+		return users, nil
+	})
+
+	c, err := New(url)
+	assert.NoError(t, err)
+
+	res, err := c.BatchGetAllUsers(context.Background(), ids)
+	if assert.NoError(t, err) {
+		got := make([]uuid.UUID, len(res.Users))
+		for i, u := range res.Users {
+			got[i] = u.Id
+		}
+		assert.Equal(t, ids[:len(ids)-1], got)
+		assert.Equal(t, MissingIds{missingID}, res.Missing)
+	}
+}
+
+func TestClient_BatchGetAllUsers_Empty(t *testing.T) {
+	t.Parallel()
+
+	_, url := setupServer(t)
+	c, err := New(url)
+	assert.NoError(t, err)
+
+	res, err := c.BatchGetAllUsers(context.Background(), nil)
+	if assert.NoError(t, err) {
+		assert.Empty(t, res.Users)
+		assert.Empty(t, res.Missing)
+	}
+}
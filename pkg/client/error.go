@@ -0,0 +1,78 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// レスポンスのステータスコードに対応するエラー
+// errors.Isで*Errorがどのステータスコードのエラーかを確かめられる
+var (
+	ErrInvalidArg         = errors.New("invalid argument")    // 400
+	ErrUnauthorized       = errors.New("unauthorized")        // 401
+	ErrForbidden          = errors.New("forbidden")           // 403
+	ErrNotFound           = errors.New("not found")           // 404
+	ErrAlreadyExists      = errors.New("already exists")      // 409
+	ErrPreconditionFailed = errors.New("precondition failed") // 412
+)
+
+// Error 4xx, 5xxのレスポンス
+type Error struct {
+	StatusCode int
+	Message    string // レスポンスのmessage。JSONでない場合はレスポンスボディ
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("traPortfolio: %d %s", e.StatusCode, e.Message)
+}
+
+// Unwrap ステータスコードに対応するエラー
+// 500などの対応するエラーがないステータスコードの場合はnil
+func (e *Error) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return ErrInvalidArg
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrAlreadyExists
+	case http.StatusPreconditionFailed:
+		return ErrPreconditionFailed
+	default:
+		return nil
+	}
+}
+
+type errorResponseDoer struct {
+	doer HttpRequestDoer
+}
+
+func (d *errorResponseDoer) Do(req *http.Request) (*http.Response, error) {
+	res, err := d.doer.Do(req)
+	if err != nil || res.StatusCode < http.StatusBadRequest {
+		return res, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read error response: %w", err)
+	}
+
+	e := &Error{StatusCode: res.StatusCode, Message: string(body)}
+	var msg struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &msg) == nil && msg.Message != "" {
+		e.Message = msg.Message
+	}
+
+	return nil, e
+}
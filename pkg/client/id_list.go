@@ -0,0 +1,20 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid"
+)
+
+// IDList カンマ区切りのUUIDのクエリパラメーター
+type IDList []uuid.UUID
+
+// MarshalText クエリパラメーターの組み立てで使われる
+func (l IDList) MarshalText() ([]byte, error) {
+	parts := make([]string, len(l))
+	for i, id := range l {
+		parts[i] = id.String()
+	}
+
+	return []byte(strings.Join(parts, ",")), nil
+}